@@ -120,6 +120,11 @@ type (
 		DisableDelegateEndorsement              bool
 		RefactorFreshAccountConversion          bool
 		SuicideTxLogMismatchPanic               bool
+		EnableCancunEVM                         bool
+		EnableStakingPrecompile                 bool
+		EnableBLSEndorsement                    bool
+		EnableDelegateGovernance                bool
+		EnableDoubleSignSlashing                bool
 	}
 
 	// FeatureWithHeightCtx provides feature check functions.
@@ -265,6 +270,11 @@ func WithFeatureCtx(ctx context.Context) context.Context {
 			DisableDelegateEndorsement:              !g.IsTsunami(height),
 			RefactorFreshAccountConversion:          g.IsTsunami(height),
 			SuicideTxLogMismatchPanic:               g.IsToBeEnabled(height),
+			EnableCancunEVM:                         g.IsVanuatu(height),
+			EnableStakingPrecompile:                 g.IsToBeEnabled(height),
+			EnableBLSEndorsement:                    g.IsToBeEnabled(height),
+			EnableDelegateGovernance:                g.IsToBeEnabled(height),
+			EnableDoubleSignSlashing:                g.IsToBeEnabled(height),
 		},
 	)
 }
@@ -9,6 +9,8 @@ import (
 type (
 	helperContextKey struct{}
 
+	simulationContextKey struct{}
+
 	// HelperContext is the context for EVM helper
 	HelperContext struct {
 		GetBlockHash   GetBlockHash
@@ -17,6 +19,14 @@ type (
 		// TODO: sgd should be moved into depositGasFunc
 		Sgd SGDRegistry
 	}
+
+	// SimulationContext is the context overriding the state and the block of a simulation
+	SimulationContext struct {
+		StateOverride StateOverride
+		BlockOverride *BlockOverride
+		// Blocks splits the executions simulated in a sequence into blocks, in place of the BlockOverride of all
+		Blocks []SimulationBlock
+	}
 )
 
 // WithHelperCtx returns a new context with helper context
@@ -32,3 +42,14 @@ func mustGetHelperCtx(ctx context.Context) HelperContext {
 	}
 	return hc
 }
+
+// WithSimulationCtx returns a new context with simulation context
+func WithSimulationCtx(ctx context.Context, sctx SimulationContext) context.Context {
+	return context.WithValue(ctx, simulationContextKey{}, sctx)
+}
+
+// GetSimulationCtx returns the simulation context from the context
+func GetSimulationCtx(ctx context.Context) (SimulationContext, bool) {
+	sc, ok := ctx.Value(simulationContextKey{}).(SimulationContext)
+	return sc, ok
+}
@@ -42,8 +42,16 @@ type (
 		Snapshot() Contract
 	}
 
+	// ForkedStorageReader is implemented by the state manager of a chain forked from a remote one, which reads
+	// the contract storage missing locally from the remote chain
+	ForkedStorageReader interface {
+		ForkedStorage(hash.Hash160, hash.Hash256) ([]byte, error)
+	}
+
 	contract struct {
 		*state.Account
+		addr       hash.Hash160
+		fork       ForkedStorageReader
 		async      bool
 		dirtyCode  bool                       // contract's code has been set
 		dirtyState bool                       // contract's account state has changed
@@ -70,6 +78,9 @@ func (c *contract) GetCommittedState(key hash.Hash256) ([]byte, error) {
 // GetState get the value from contract storage
 func (c *contract) GetState(key hash.Hash256) ([]byte, error) {
 	v, err := c.trie.Get(key[:])
+	if errors.Cause(err) == trie.ErrNotExist && c.fork != nil {
+		v, err = c.fork.ForkedStorage(c.addr, key)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -164,6 +175,8 @@ func (c *contract) Snapshot() Contract {
 	}
 	return &contract{
 		Account:    c.Account.Clone(),
+		addr:       c.addr,
+		fork:       c.fork,
 		async:      c.async,
 		dirtyCode:  c.dirtyCode,
 		dirtyState: c.dirtyState,
@@ -181,6 +194,7 @@ func (c *contract) Snapshot() Contract {
 func newContract(addr hash.Hash160, account *state.Account, sm protocol.StateManager, enableAsync bool) (Contract, error) {
 	c := &contract{
 		Account:   account,
+		addr:      addr,
 		root:      account.Root,
 		committed: make(map[hash.Hash256][]byte),
 		sm:        sm,
@@ -194,6 +208,9 @@ func newContract(addr hash.Hash160, account *state.Account, sm protocol.StateMan
 			return h[:]
 		}),
 	}
+	if fork, ok := sm.(ForkedStorageReader); ok {
+		c.fork = fork
+	}
 	if account.Root != hash.ZeroHash256 {
 		options = append(options, mptrie.RootHashOption(account.Root[:]))
 	}
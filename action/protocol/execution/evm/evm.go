@@ -28,6 +28,7 @@ import (
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/staking"
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/tracer"
@@ -184,6 +185,10 @@ func newParams(
 		// Random opcode (EIP-4399) is not supported
 		context.Random = &common.Hash{}
 	}
+	if featureCtx.EnableCancunEVM {
+		// Blob transaction (EIP-4844) is not supported, BLOBBASEFEE opcode (EIP-7516) returns 0
+		context.BlobBaseFee = new(big.Int)
+	}
 
 	if vmCfg, ok := protocol.GetVMConfigCtx(ctx); ok {
 		vmConfig = vmCfg
@@ -447,9 +452,25 @@ func getChainConfig(g genesis.Blockchain, height uint64, id uint32, getBlockTime
 	}
 	sumatraTimestamp := (uint64)(sumatraTime.Unix())
 	chainConfig.ShanghaiTime = &sumatraTimestamp
+	// enable Cancun at Vanuatu
+	// the activation time is set to 0 (already activated) once Vanuatu height is reached, instead of
+	// an estimated block time, so that Cancun is enabled at exactly the Vanuatu height
+	if g.IsVanuatu(height) {
+		chainConfig.CancunTime = new(uint64)
+	}
 	return &chainConfig, nil
 }
 
+// activePrecompiles returns the addresses of the precompiles active under the rules, with the staking precompile
+// once it is enabled
+func activePrecompiles(rules params.Rules, featureCtx protocol.FeatureCtx) []common.Address {
+	precompiles := vm.ActivePrecompiles(rules)
+	if rules.IsBerlin && featureCtx.EnableStakingPrecompile {
+		precompiles = append(precompiles[:len(precompiles):len(precompiles)], staking.PrecompileAddress)
+	}
+	return precompiles
+}
+
 // Error in executeInEVM is a consensus issue
 func executeInEVM(evmParams *Params, stateDB *StateDBAdapter) ([]byte, uint64, uint64, string, iotextypes.ReceiptStatus, error) {
 	var (
@@ -482,7 +503,17 @@ func executeInEVM(evmParams *Params, stateDB *StateDBAdapter) ([]byte, uint64, u
 	// Set up the initial access list
 	rules := chainConfig.Rules(evm.Context.BlockNumber, g.IsSumatra(evmParams.blkCtx.BlockHeight), evmParams.context.Time)
 	if rules.IsBerlin {
-		stateDB.Prepare(rules, evmParams.txCtx.Origin, evmParams.context.Coinbase, evmParams.contract, vm.ActivePrecompiles(rules), evmParams.accessList)
+		// the staking precompile is registered for the Berlin rules, see precompile.go
+		if evmParams.featureCtx.EnableStakingPrecompile {
+			stateDB.stakingPrecompileEnabled = true
+			binding := _stakingPrecompile.bind(stateDB)
+			defer _stakingPrecompile.unbind(binding)
+			evm.Context.Transfer = binding.transfer
+		} else {
+			stateDB.stakingPrecompileHidden = true
+			evm.Context.Transfer = stateDB.transferToHiddenPrecompile
+		}
+		stateDB.Prepare(rules, evmParams.txCtx.Origin, evmParams.context.Coinbase, evmParams.contract, activePrecompiles(rules, evmParams.featureCtx), evmParams.accessList)
 	}
 	var (
 		contractRawAddress = action.EmptyAddress
@@ -635,7 +666,7 @@ func intrinsicGas(size uint64, list types.AccessList) (uint64, error) {
 	return size*action.ExecutionDataGas + action.ExecutionBaseIntrinsicGas + accessListGas, nil
 }
 
-// SimulateExecution simulates the execution in evm
+// SimulateExecution simulates the execution in evm, on the state and the block overridden by the simulation context
 func SimulateExecution(
 	ctx context.Context,
 	sm protocol.StateManager,
@@ -644,33 +675,137 @@ func SimulateExecution(
 ) ([]byte, *action.Receipt, error) {
 	ctx, span := tracer.NewSpan(ctx, "evm.SimulateExecution")
 	defer span.End()
-	bcCtx := protocol.MustGetBlockchainCtx(ctx)
-	g := genesis.MustExtractGenesisContext(ctx)
-	ctx = protocol.WithActionCtx(
+	sctx, _ := GetSimulationCtx(ctx)
+	ctx, err := withSimulationBlockCtx(ctx, sctx.BlockOverride)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx = withSimulationActionCtx(ctx, caller, ex)
+	if err := sctx.StateOverride.Apply(ctx, sm); err != nil {
+		return nil, nil, err
+	}
+	if override, ok := sctx.StateOverride[common.BytesToAddress(caller.Bytes())]; ok && override != nil && override.Nonce != nil {
+		ex.SetNonce(*override.Nonce)
+	}
+	return ExecuteContract(ctx, sm, ex)
+}
+
+// SimulateExecutions simulates the executions in evm one after another, so that each execution runs on the state
+// left by the previous ones. The executions run in the same block, or in the blocks of the simulation context one
+// after another. The state is overridden by the simulation context before the first execution.
+func SimulateExecutions(
+	ctx context.Context,
+	sm protocol.StateManager,
+	callers []address.Address,
+	exs []*action.Execution,
+) ([][]byte, []*action.Receipt, error) {
+	ctx, span := tracer.NewSpan(ctx, "evm.SimulateExecutions")
+	defer span.End()
+	if len(callers) != len(exs) {
+		return nil, nil, errors.Errorf("%d callers of %d executions", len(callers), len(exs))
+	}
+	if len(exs) == 0 {
+		return nil, nil, nil
+	}
+	sctx, _ := GetSimulationCtx(ctx)
+	blocks := sctx.Blocks
+	if len(blocks) == 0 {
+		blocks = []SimulationBlock{{Size: len(exs), Override: sctx.BlockOverride}}
+	}
+	var size int
+	for _, blk := range blocks {
+		if blk.Size <= 0 {
+			return nil, nil, errors.Errorf("invalid size %d of a simulated block", blk.Size)
+		}
+		size += blk.Size
+	}
+	if size != len(exs) {
+		return nil, nil, errors.Errorf("%d executions in the blocks of %d executions", size, len(exs))
+	}
+	var (
+		retvals  = make([][]byte, 0, len(exs))
+		receipts = make([]*action.Receipt, 0, len(exs))
+	)
+	for j, blk := range blocks {
+		blkCtx, err := withSimulationBlockCtx(ctx, blk.Override)
+		if err != nil {
+			return nil, nil, err
+		}
+		if j == 0 {
+			if err := sctx.StateOverride.Apply(withSimulationActionCtx(blkCtx, callers[0], exs[0]), sm); err != nil {
+				return nil, nil, err
+			}
+		}
+		for i, end := len(receipts), len(receipts)+blk.Size; i < end; i++ {
+			retval, receipt, err := ExecuteContract(withSimulationActionCtx(blkCtx, callers[i], exs[i]), sm, exs[i])
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to simulate execution %d", i)
+			}
+			retvals = append(retvals, retval)
+			receipts = append(receipts, receipt)
+		}
+	}
+	return retvals, receipts, nil
+}
+
+func withSimulationActionCtx(ctx context.Context, caller address.Address, ex *action.Execution) context.Context {
+	return protocol.WithActionCtx(
 		ctx,
 		protocol.ActionCtx{
 			Caller:     caller,
 			ActionHash: hash.Hash256b(byteutil.Must(proto.Marshal(ex.Proto()))),
 		},
 	)
-	zeroAddr, err := address.FromString(address.ZeroAddress)
+}
+
+// withSimulationBlockCtx sets the block next to the tip, or the overridden one, in which the simulation runs
+// SimulationPrecompiles returns the addresses of the precompiles active in the block simulated by
+// SimulateExecution()
+func SimulationPrecompiles(ctx context.Context) ([]common.Address, error) {
+	sctx, _ := GetSimulationCtx(ctx)
+	ctx, err := withSimulationBlockCtx(ctx, sctx.BlockOverride)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
-	ctx = protocol.WithBlockCtx(
-		ctx,
-		protocol.BlockCtx{
-			BlockHeight:    bcCtx.Tip.Height + 1,
-			BlockTimeStamp: bcCtx.Tip.Timestamp.Add(g.BlockInterval),
-			GasLimit:       g.BlockGasLimitByHeight(bcCtx.Tip.Height + 1),
-			Producer:       zeroAddr,
-		},
+	var (
+		blkCtx = protocol.MustGetBlockCtx(ctx)
+		g      = genesis.MustExtractGenesisContext(ctx)
 	)
+	chainConfig, err := getChainConfig(g.Blockchain, blkCtx.BlockHeight, protocol.MustGetBlockchainCtx(ctx).EvmNetworkID, mustGetHelperCtx(ctx).GetBlockTime)
+	if err != nil {
+		return nil, err
+	}
+	rules := chainConfig.Rules(new(big.Int).SetUint64(blkCtx.BlockHeight), g.IsSumatra(blkCtx.BlockHeight), uint64(blkCtx.BlockTimeStamp.Unix()))
+	return activePrecompiles(rules, protocol.MustGetFeatureCtx(ctx)), nil
+}
 
-	ctx = protocol.WithFeatureCtx(ctx)
-	return ExecuteContract(
-		ctx,
-		sm,
-		ex,
-	)
+func withSimulationBlockCtx(ctx context.Context, override *BlockOverride) (context.Context, error) {
+	bcCtx := protocol.MustGetBlockchainCtx(ctx)
+	g := genesis.MustExtractGenesisContext(ctx)
+	zeroAddr, err := address.FromString(address.ZeroAddress)
+	if err != nil {
+		return nil, err
+	}
+	blkCtx := protocol.BlockCtx{
+		BlockHeight:    bcCtx.Tip.Height + 1,
+		BlockTimeStamp: bcCtx.Tip.Timestamp.Add(g.BlockInterval),
+		GasLimit:       g.BlockGasLimitByHeight(bcCtx.Tip.Height + 1),
+		Producer:       zeroAddr,
+	}
+	if override != nil {
+		if override.Number != nil {
+			blkCtx.BlockHeight = *override.Number
+			blkCtx.GasLimit = g.BlockGasLimitByHeight(blkCtx.BlockHeight)
+		}
+		if override.Time != nil {
+			blkCtx.BlockTimeStamp = *override.Time
+		}
+		if override.GasLimit != nil {
+			blkCtx.GasLimit = *override.GasLimit
+		}
+		if override.Coinbase != nil {
+			blkCtx.Producer = override.Coinbase
+		}
+	}
+	return protocol.WithFeatureCtx(protocol.WithBlockCtx(ctx, blkCtx)), nil
 }
@@ -7,12 +7,15 @@ package evm
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"math/big"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
@@ -22,11 +25,14 @@ import (
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/staking"
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/state"
 	"github.com/iotexproject/iotex-core/test/identityset"
 	"github.com/iotexproject/iotex-core/test/mock/mock_chainmanager"
 	"github.com/iotexproject/iotex-core/testutil"
+	"github.com/iotexproject/iotex-core/testutil/testdb"
 )
 
 func TestExecuteContractFailure(t *testing.T) {
@@ -90,6 +96,8 @@ func TestConstantinople(t *testing.T) {
 
 	evmNetworkID := uint32(100)
 	g := genesis.Default
+	// Vanuatu is not scheduled yet in the default genesis
+	g.VanuatuBlockHeight = 49275561
 	ctx = protocol.WithBlockchainCtx(genesis.WithGenesisContext(ctx, g), protocol.BlockchainCtx{
 		ChainID:      1,
 		EvmNetworkID: evmNetworkID,
@@ -252,11 +260,29 @@ func TestConstantinople(t *testing.T) {
 			"io1pcg2ja9krrhujpazswgz77ss46xgt88afqlk6y",
 			29275560,
 		},
-		// after Tsunami
+		// after Tsunami - Upernavik
 		{
 			action.EmptyAddress,
 			29275561,
 		},
+		{
+			"io1pcg2ja9krrhujpazswgz77ss46xgt88afqlk6y",
+			39275560,
+		},
+		// after Upernavik - Vanuatu
+		{
+			action.EmptyAddress,
+			39275561,
+		},
+		{
+			"io1pcg2ja9krrhujpazswgz77ss46xgt88afqlk6y",
+			49275560,
+		},
+		// after Vanuatu
+		{
+			action.EmptyAddress,
+			49275561,
+		},
 		{
 			"io1pcg2ja9krrhujpazswgz77ss46xgt88afqlk6y",
 			1261440000, // = 200*365*24*3600/5, around 200 years later
@@ -355,8 +381,17 @@ func TestConstantinople(t *testing.T) {
 		require.Equal(isSumatra, chainRules.IsMerge)
 		require.Equal(isSumatra, chainRules.IsShanghai)
 
-		// Cancun, Prague not yet enabled
-		require.False(evmChainConfig.IsCancun(big.NewInt(int64(e.height)), evm.Context.Time))
+		// Vanuatu = enable Cancun
+		isVanuatu := g.IsVanuatu(e.height)
+		require.Equal(isVanuatu, evmChainConfig.IsCancun(big.NewInt(int64(e.height)), evm.Context.Time))
+		require.Equal(isVanuatu, chainRules.IsCancun)
+		if isVanuatu {
+			require.Equal(new(big.Int), evm.Context.BlobBaseFee)
+		} else {
+			require.Nil(evm.Context.BlobBaseFee)
+		}
+
+		// Prague not yet enabled
 		require.False(evmChainConfig.IsPrague(big.NewInt(int64(e.height)), evm.Context.Time))
 
 		// test basefee
@@ -467,3 +502,123 @@ func gasExecuteInEVM(gas, consume, refund, size uint64) (uint64, uint64, error)
 	remainingGas -= consume
 	return remainingGas, remainingGas + refund, nil
 }
+
+// snapshotStateManager hands out distinct snapshots for the nested calls, reverting to them is not supported
+type snapshotStateManager struct {
+	protocol.StateManager
+	snapshot int
+}
+
+func (sm *snapshotStateManager) Snapshot() int {
+	sm.snapshot++
+	return sm.snapshot
+}
+
+func (sm *snapshotStateManager) Revert(int) error {
+	return nil
+}
+
+func TestStakingPrecompileCalledByContract(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	sm := &snapshotStateManager{StateManager: testdb.NewMockStateManager(ctrl)}
+	writeStakingView := func() {
+		view, _, err := staking.CreateBaseView(sm, false)
+		require.NoError(err)
+		require.NoError(sm.WriteView("staking", view))
+	}
+	writeStakingView()
+	csm, err := staking.NewCandidateStateManager(sm, false)
+	require.NoError(err)
+	owner := identityset.Address(1)
+	require.NoError(csm.Upsert(&staking.Candidate{
+		Owner:              owner,
+		Operator:           owner,
+		Reward:             owner,
+		Name:               "test",
+		Votes:              big.NewInt(3000),
+		SelfStake:          big.NewInt(1000),
+		SelfStakeBucketIdx: 0,
+	}))
+	writeStakingView()
+
+	// the contract forwards the calldata to the staking precompile by STATICCALL, and returns what it returns
+	contract := common.HexToAddress("0x0000000000000000000000000000000000abcdef")
+	code, err := hex.DecodeString("366000600037" + "600060003660006110005afa" + "50" + "3d600060003e" + "3d6000f3")
+	require.NoError(err)
+	input := append(crypto.Keccak256([]byte("candidateVotes(address)"))[:4], common.LeftPadBytes(owner.Bytes(), 32)...)
+	caller := common.BytesToAddress(identityset.Address(27).Bytes())
+
+	g := genesis.Default
+	height := g.UpernavikBlockHeight
+	execute := func(g genesis.Genesis, to common.Address, amount *big.Int) ([]byte, *action.Receipt) {
+		ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{
+			Caller: identityset.Address(27),
+		})
+		ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+			BlockHeight: height,
+			Producer:    identityset.Address(27),
+			GasLimit:    testutil.TestGasLimit,
+		})
+		ctx = protocol.WithBlockchainCtx(genesis.WithGenesisContext(ctx, g), protocol.BlockchainCtx{
+			ChainID:      1,
+			EvmNetworkID: 100,
+		})
+		ctx = protocol.WithFeatureCtx(ctx)
+		ctx = WithHelperCtx(ctx, HelperContext{
+			GetBlockHash: func(uint64) (hash.Hash256, error) {
+				return hash.ZeroHash256, nil
+			},
+			GetBlockTime: func(uint64) (time.Time, error) {
+				return time.Time{}, nil
+			},
+			DepositGasFunc: func(context.Context, protocol.StateManager, address.Address, *big.Int, *big.Int) (*action.TransactionLog, error) {
+				return nil, nil
+			},
+		})
+		require.NoError(StateOverride{
+			caller:   {Balance: big.NewInt(1)},
+			contract: {Code: code},
+		}.Apply(ctx, sm))
+		toAddr, err := address.FromBytes(to.Bytes())
+		require.NoError(err)
+		e, err := action.NewExecution(toAddr.String(), 1, amount, testutil.TestGasLimit, big.NewInt(0), input)
+		require.NoError(err)
+		ret, receipt, err := ExecuteContract(ctx, sm, e)
+		require.NoError(err)
+		return ret, receipt
+	}
+
+	// the precompile acts as an account without code before it is enabled
+	ret, receipt := execute(g, contract, big.NewInt(0))
+	require.EqualValues(iotextypes.ReceiptStatus_Success, receipt.Status)
+	require.Empty(ret)
+	precompileAddr, err := address.FromBytes(staking.PrecompileAddress.Bytes())
+	require.NoError(err)
+	ret, receipt = execute(g, staking.PrecompileAddress, big.NewInt(0))
+	require.EqualValues(iotextypes.ReceiptStatus_Success, receipt.Status)
+	require.Empty(ret)
+	recorded, err := accountutil.Recorded(sm, precompileAddr)
+	require.NoError(err)
+	require.False(recorded)
+	stateDB, err := NewStateDBAdapter(sm, height, hash.ZeroHash256, NotFixTopicCopyBugOption())
+	require.NoError(err)
+	require.False(stateDB.Exist(staking.PrecompileAddress))
+
+	g.ToBeEnabledBlockHeight = height
+	ret, receipt = execute(g, contract, big.NewInt(0))
+	require.EqualValues(iotextypes.ReceiptStatus_Success, receipt.Status)
+	require.Len(ret, 64)
+	require.Equal(big.NewInt(3000), new(big.Int).SetBytes(ret[:32]))
+	require.Equal(big.NewInt(1000), new(big.Int).SetBytes(ret[32:]))
+
+	// calling the precompile directly
+	ret, receipt = execute(g, staking.PrecompileAddress, big.NewInt(0))
+	require.EqualValues(iotextypes.ReceiptStatus_Success, receipt.Status)
+	require.Len(ret, 64)
+
+	// the precompile does not accept value
+	_, receipt = execute(g, staking.PrecompileAddress, big.NewInt(1))
+	require.EqualValues(iotextypes.ReceiptStatus_ErrExecutionReverted, receipt.Status)
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 	"github.com/pkg/errors"
@@ -26,6 +27,7 @@ import (
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
 	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/staking"
 	"github.com/iotexproject/iotex-core/db/trie"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/state"
@@ -41,6 +43,9 @@ type (
 	// preimageMap records the preimage of hash reported by VM
 	preimageMap map[common.Hash]protocol.SerializableBytes
 
+	// createdAccount records the accounts created in the current transaction
+	createdAccount map[common.Address]struct{}
+
 	// StateDBAdapter represents the state db adapter for evm to access iotx blockchain
 	StateDBAdapter struct {
 		sm                         protocol.StateManager
@@ -61,12 +66,23 @@ type (
 		preimageSnapshot           map[int]preimageMap
 		accessList                 *accessList // per-transaction access list
 		accessListSnapshot         map[int]*accessList
+		transientStorage           transientStorage // per-transaction transient storage (EIP-1153)
+		transientStorageSnapshot   map[int]transientStorage
+		createdAccount             createdAccount
 		logsSnapshot               map[int]int // logs is an array, save len(logs) at time of snapshot suffices
 		txLogsSnapshot             map[int]int
 		notFixTopicCopyBug         bool
 		asyncContractTrie          bool
 		disableSortCachedContracts bool
 		useConfirmedNonce          bool
+		// stakingPrecompileEnabled is set if the staking precompile is enabled for the execution, which then exists
+		// as an account, so that calling it never creates the account
+		stakingPrecompileEnabled bool
+		// stakingPrecompileHidden is set if the EVM sees the staking precompile registered into go-ethereum before
+		// it is enabled, see precompile.go. Its account is then created only by a call transferring value, the same
+		// as an address without code.
+		stakingPrecompileHidden    bool
+		precompileCreationDeferred bool
 		legacyNonceAccount         bool
 		fixSnapshotOrder           bool
 		revertLog                  bool
@@ -170,23 +186,26 @@ func NewStateDBAdapter(
 	opts ...StateDBAdapterOption,
 ) (*StateDBAdapter, error) {
 	s := &StateDBAdapter{
-		sm:                     sm,
-		logs:                   []*action.Log{},
-		err:                    nil,
-		blockHeight:            blockHeight,
-		executionHash:          executionHash,
-		lastAddBalanceAmount:   new(big.Int),
-		refundSnapshot:         make(map[int]uint64),
-		cachedContract:         make(contractMap),
-		contractSnapshot:       make(map[int]contractMap),
-		selfDestructed:         make(deleteAccount),
-		selfDestructedSnapshot: make(map[int]deleteAccount),
-		preimages:              make(preimageMap),
-		preimageSnapshot:       make(map[int]preimageMap),
-		accessList:             newAccessList(),
-		accessListSnapshot:     make(map[int]*accessList),
-		logsSnapshot:           make(map[int]int),
-		txLogsSnapshot:         make(map[int]int),
+		sm:                       sm,
+		logs:                     []*action.Log{},
+		err:                      nil,
+		blockHeight:              blockHeight,
+		executionHash:            executionHash,
+		lastAddBalanceAmount:     new(big.Int),
+		refundSnapshot:           make(map[int]uint64),
+		cachedContract:           make(contractMap),
+		contractSnapshot:         make(map[int]contractMap),
+		selfDestructed:           make(deleteAccount),
+		selfDestructedSnapshot:   make(map[int]deleteAccount),
+		preimages:                make(preimageMap),
+		preimageSnapshot:         make(map[int]preimageMap),
+		accessList:               newAccessList(),
+		accessListSnapshot:       make(map[int]*accessList),
+		transientStorage:         newTransientStorage(),
+		transientStorageSnapshot: make(map[int]transientStorage),
+		createdAccount:           make(createdAccount),
+		logsSnapshot:             make(map[int]int),
+		txLogsSnapshot:           make(map[int]int),
 	}
 	for _, opt := range opts {
 		if err := opt(s); err != nil {
@@ -220,6 +239,15 @@ func (stateDB *StateDBAdapter) accountCreationOpts() []state.AccountCreationOpti
 
 // CreateAccount creates an account in iotx blockchain
 func (stateDB *StateDBAdapter) CreateAccount(evmAddr common.Address) {
+	if stateDB.stakingPrecompileHidden && evmAddr == staking.PrecompileAddress {
+		// the EVM creates the account of a precompile on any call, so defer it to the transfer of the call
+		stateDB.precompileCreationDeferred = true
+		return
+	}
+	stateDB.createAccount(evmAddr)
+}
+
+func (stateDB *StateDBAdapter) createAccount(evmAddr common.Address) {
 	addr, err := address.FromBytes(evmAddr.Bytes())
 	if err != nil {
 		log.L().Error("Failed to convert evm address.", zap.Error(err))
@@ -231,9 +259,23 @@ func (stateDB *StateDBAdapter) CreateAccount(evmAddr common.Address) {
 		stateDB.logError(err)
 		return
 	}
+	stateDB.createdAccount[evmAddr] = struct{}{}
 	log.L().Debug("Called CreateAccount.", log.Hex("addrHash", evmAddr[:]))
 }
 
+// transferToHiddenPrecompile is the transfer of the EVM with the staking precompile hidden, which creates the account
+// of the precompile deferred by CreateAccount only if the amount is not zero
+func (stateDB *StateDBAdapter) transferToHiddenPrecompile(db vm.StateDB, from, to common.Address, amount *uint256.Int) {
+	if to == staking.PrecompileAddress && stateDB.precompileCreationDeferred {
+		stateDB.precompileCreationDeferred = false
+		if amount.IsZero() {
+			return
+		}
+		stateDB.createAccount(to)
+	}
+	MakeTransfer(db, from, to, amount)
+}
+
 // SubBalance subtracts balance from account
 func (stateDB *StateDBAdapter) SubBalance(evmAddr common.Address, a256 *uint256.Int) {
 	amount := a256.ToBig()
@@ -433,14 +475,7 @@ func (stateDB *StateDBAdapter) SelfDestruct(evmAddr common.Address) {
 	}
 	// clears the account balance
 	actBalance := new(big.Int).Set(s.Balance)
-	if err := s.SubBalance(s.Balance); err != nil {
-		log.L().Debug("failed to clear balance", zap.Error(err), zap.String("address", evmAddr.Hex()))
-		return
-	}
-	addrHash := hash.BytesToHash160(evmAddr.Bytes())
-	if _, err := stateDB.sm.PutState(s, protocol.LegacyKeyOption(addrHash)); err != nil {
-		log.L().Error("Failed to kill contract.", zap.Error(err))
-		stateDB.logError(err)
+	if err := stateDB.selfDestruct(evmAddr, s); err != nil {
 		return
 	}
 	// To ensure data consistency, generate this log after the hard-fork
@@ -450,23 +485,43 @@ func (stateDB *StateDBAdapter) SelfDestruct(evmAddr common.Address) {
 		// before calling SelfDestruct, EVM will transfer the contract's balance to beneficiary
 		// need to create a transaction log on successful SelfDestruct
 		if stateDB.lastAddBalanceAmount.Cmp(actBalance) == 0 {
-			if stateDB.lastAddBalanceAmount.Cmp(big.NewInt(0)) > 0 {
-				from, _ := address.FromBytes(evmAddr[:])
-				stateDB.addTransactionLogs(&action.TransactionLog{
-					Type:      iotextypes.TransactionLogType_IN_CONTRACT_TRANSFER,
-					Sender:    from.String(),
-					Recipient: stateDB.lastAddBalanceAddr,
-					Amount:    stateDB.lastAddBalanceAmount,
-				})
-			}
+			stateDB.addSelfDestructTransactionLog(evmAddr)
 		} else {
 			log.L().Panic("SelfDestruct contract's balance does not match",
 				zap.String("SelfDestruct", actBalance.String()),
 				zap.String("beneficiary", stateDB.lastAddBalanceAmount.String()))
 		}
 	}
+}
+
+// selfDestruct clears the balance of the account and marks it as deleted
+func (stateDB *StateDBAdapter) selfDestruct(evmAddr common.Address, s *state.Account) error {
+	if err := s.SubBalance(s.Balance); err != nil {
+		log.L().Debug("failed to clear balance", zap.Error(err), zap.String("address", evmAddr.Hex()))
+		return err
+	}
+	addrHash := hash.BytesToHash160(evmAddr.Bytes())
+	if _, err := stateDB.sm.PutState(s, protocol.LegacyKeyOption(addrHash)); err != nil {
+		log.L().Error("Failed to kill contract.", zap.Error(err))
+		stateDB.logError(err)
+		return err
+	}
 	// mark it as deleted
 	stateDB.selfDestructed[addrHash] = struct{}{}
+	return nil
+}
+
+// addSelfDestructTransactionLog adds the log of transferring the balance of a self-destructed contract to beneficiary
+func (stateDB *StateDBAdapter) addSelfDestructTransactionLog(evmAddr common.Address) {
+	if stateDB.lastAddBalanceAmount.Cmp(big.NewInt(0)) > 0 {
+		from, _ := address.FromBytes(evmAddr[:])
+		stateDB.addTransactionLogs(&action.TransactionLog{
+			Type:      iotextypes.TransactionLogType_IN_CONTRACT_TRANSFER,
+			Sender:    from.String(),
+			Recipient: stateDB.lastAddBalanceAddr,
+			Amount:    new(big.Int).Set(stateDB.lastAddBalanceAmount),
+		})
+	}
 }
 
 // HasSelfDestructed returns whether the contract has been killed
@@ -478,19 +533,32 @@ func (stateDB *StateDBAdapter) HasSelfDestructed(evmAddr common.Address) bool {
 
 // SetTransientState sets transient storage for a given account
 func (stateDB *StateDBAdapter) SetTransientState(addr common.Address, key, value common.Hash) {
-	log.S().Panic("SetTransientState not implemented")
+	stateDB.transientStorage.Set(addr, key, value)
 }
 
 // GetTransientState gets transient storage for a given account.
 func (stateDB *StateDBAdapter) GetTransientState(addr common.Address, key common.Hash) common.Hash {
-	log.S().Panic("GetTransientState not implemented")
-	return common.Hash{}
+	return stateDB.transientStorage.Get(addr, key)
 }
 
 // Selfdestruct6780 implements EIP-6780
 func (stateDB *StateDBAdapter) Selfdestruct6780(evmAddr common.Address) {
-	//Todo: implement EIP-6780
-	log.S().Panic("Selfdestruct6780 not implemented")
+	if !stateDB.Exist(evmAddr) {
+		log.L().Debug("Account does not exist.", zap.String("address", evmAddr.Hex()))
+		return
+	}
+	// EVM has moved the contract's balance to beneficiary before calling Selfdestruct6780
+	stateDB.addSelfDestructTransactionLog(evmAddr)
+	if _, ok := stateDB.createdAccount[evmAddr]; !ok {
+		// only the contract created in the same transaction is deleted
+		return
+	}
+	s, err := stateDB.accountState(evmAddr)
+	if err != nil {
+		log.L().Debug("Failed to get account.", zap.String("address", evmAddr.Hex()))
+		return
+	}
+	stateDB.selfDestruct(evmAddr, s)
 }
 
 // Exist checks the existence of an address
@@ -505,6 +573,9 @@ func (stateDB *StateDBAdapter) Exist(evmAddr common.Address) bool {
 	if _, ok := stateDB.cachedContract[addrHash]; ok {
 		return true
 	}
+	if stateDB.stakingPrecompileEnabled && evmAddr == staking.PrecompileAddress {
+		return true
+	}
 	recorded, err := accountutil.Recorded(stateDB.sm, addr)
 	if !recorded || err != nil {
 		log.L().Debug("Account does not exist.", zap.String("address", addr.String()))
@@ -527,6 +598,7 @@ func (stateDB *StateDBAdapter) Exist(evmAddr common.Address) bool {
 // - Add coinbase to access list (EIP-3651)
 // - Reset transient storage (EIP-1153)
 func (stateDB *StateDBAdapter) Prepare(rules params.Rules, sender, coinbase common.Address, dst *common.Address, precompiles []common.Address, list types.AccessList) {
+	stateDB.transientStorage = newTransientStorage()
 	if !rules.IsBerlin {
 		return
 	}
@@ -623,6 +695,18 @@ func (stateDB *StateDBAdapter) RevertToSnapshot(snapshot int) {
 			}
 		}
 	}
+	// restore transient storage
+	stateDB.transientStorage = stateDB.transientStorageSnapshot[snapshot]
+	{
+		delete(stateDB.transientStorageSnapshot, snapshot)
+		for i := snapshot + 1; ; i++ {
+			if _, ok := stateDB.transientStorageSnapshot[i]; ok {
+				delete(stateDB.transientStorageSnapshot, i)
+			} else {
+				break
+			}
+		}
+	}
 	// restore logs and txLogs
 	if stateDB.revertLog {
 		stateDB.logs = stateDB.logs[:stateDB.logsSnapshot[snapshot]]
@@ -750,6 +834,8 @@ func (stateDB *StateDBAdapter) Snapshot() int {
 	stateDB.preimageSnapshot[sn] = p
 	// save a copy of access list
 	stateDB.accessListSnapshot[sn] = stateDB.accessList.Copy()
+	// save a copy of transient storage
+	stateDB.transientStorageSnapshot[sn] = stateDB.transientStorage.Copy()
 	return sn
 }
 
@@ -1089,6 +1175,9 @@ func (stateDB *StateDBAdapter) clear() {
 	stateDB.preimageSnapshot = make(map[int]preimageMap)
 	stateDB.accessList = newAccessList()
 	stateDB.accessListSnapshot = make(map[int]*accessList)
+	stateDB.transientStorage = newTransientStorage()
+	stateDB.transientStorageSnapshot = make(map[int]transientStorage)
+	stateDB.createdAccount = make(createdAccount)
 	stateDB.logsSnapshot = make(map[int]int)
 	stateDB.txLogsSnapshot = make(map[int]int)
 	stateDB.logs = []*action.Log{}
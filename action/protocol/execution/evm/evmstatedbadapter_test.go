@@ -13,6 +13,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/golang/mock/gomock"
 	"github.com/holiman/uint256"
 	"github.com/iotexproject/go-pkgs/hash"
@@ -934,3 +935,67 @@ func TestSortMap(t *testing.T) {
 		require.True(testFunc(t, sm))
 	})
 }
+
+func TestTransientStorageSnapshot(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	sm, err := initMockStateManager(ctrl)
+	require.NoError(err)
+	stateDB, err := NewStateDBAdapter(sm, 1, hash.ZeroHash256, FixSnapshotOrderOption(), RevertLogOption())
+	require.NoError(err)
+	var (
+		addr = common.HexToAddress("02ae2a956d21e8d481c3a69e146633470cf625ec")
+		k1   = common.HexToHash("01")
+		k2   = common.HexToHash("02")
+		v1   = common.HexToHash("1234")
+		v2   = common.HexToHash("5678")
+	)
+	require.Equal(common.Hash{}, stateDB.GetTransientState(addr, k1))
+	stateDB.SetTransientState(addr, k1, v1)
+	require.Equal(v1, stateDB.GetTransientState(addr, k1))
+
+	sn := stateDB.Snapshot()
+	stateDB.SetTransientState(addr, k1, v2)
+	stateDB.SetTransientState(addr, k2, v2)
+	require.Equal(v2, stateDB.GetTransientState(addr, k1))
+	require.Equal(v2, stateDB.GetTransientState(addr, k2))
+	stateDB.RevertToSnapshot(sn)
+	require.Equal(v1, stateDB.GetTransientState(addr, k1))
+	require.Equal(common.Hash{}, stateDB.GetTransientState(addr, k2))
+
+	// transient storage is reset for each transaction
+	stateDB.Prepare(params.Rules{}, addr, addr, nil, nil, nil)
+	require.Equal(common.Hash{}, stateDB.GetTransientState(addr, k1))
+}
+
+func TestSelfdestruct6780(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	sm, err := initMockStateManager(ctrl)
+	require.NoError(err)
+	stateDB, err := NewStateDBAdapter(sm, 1, hash.ZeroHash256, FixSnapshotOrderOption(), RevertLogOption())
+	require.NoError(err)
+	var (
+		existing    = common.HexToAddress("02ae2a956d21e8d481c3a69e146633470cf625ec")
+		created     = common.HexToAddress("05ae2a956d21e8d481c3a69e146633470cf625ec")
+		beneficiary = common.HexToAddress("1234567890123456789012345678901234567890")
+		amount      = uint256.NewInt(1000)
+	)
+	// contract existing before the transaction is not deleted, but its balance is moved out
+	stateDB.AddBalance(existing, amount)
+	stateDB.SubBalance(existing, amount)
+	stateDB.AddBalance(beneficiary, amount)
+	stateDB.Selfdestruct6780(existing)
+	require.False(stateDB.HasSelfDestructed(existing))
+	require.Len(stateDB.TransactionLogs(), 1)
+	require.Equal(amount.ToBig(), stateDB.TransactionLogs()[0].Amount)
+
+	// contract created in the same transaction is deleted
+	stateDB.CreateAccount(created)
+	stateDB.AddBalance(beneficiary, common.U2560)
+	stateDB.Selfdestruct6780(created)
+	require.True(stateDB.HasSelfDestructed(created))
+	require.Len(stateDB.TransactionLogs(), 1)
+}
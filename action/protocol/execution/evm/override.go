@@ -0,0 +1,113 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package evm
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/account/accountpb"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+)
+
+type (
+	// AccountOverride overrides the state of an account in a simulation, the nil fields are left as they are
+	AccountOverride struct {
+		Balance *big.Int
+		// Nonce is the nonce of the next action of the account
+		Nonce *uint64
+		Code  []byte
+		// StateDiff overrides the given slots of the storage, leaving the others as they are
+		StateDiff map[common.Hash]common.Hash
+	}
+
+	// StateOverride overrides the states of the accounts in a simulation
+	StateOverride map[common.Address]*AccountOverride
+
+	// BlockOverride overrides the block in which the simulation runs, the nil fields are left as they are
+	BlockOverride struct {
+		Number   *uint64
+		Time     *time.Time
+		GasLimit *uint64
+		Coinbase address.Address
+	}
+
+	// SimulationBlock is a block of consecutive executions simulated in a sequence
+	SimulationBlock struct {
+		// Size is the number of executions in the block
+		Size int
+		// Override overrides the block if not nil
+		Override *BlockOverride
+	}
+)
+
+// Apply applies the overrides to the state
+func (so StateOverride) Apply(ctx context.Context, sm protocol.StateManager) error {
+	if len(so) == 0 {
+		return nil
+	}
+	addrs := make([]common.Address, 0, len(so))
+	for addr := range so {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i][:], addrs[j][:]) < 0
+	})
+	stateDB, err := prepareStateDB(ctx, sm)
+	if err != nil {
+		return err
+	}
+	for _, evmAddr := range addrs {
+		override := so[evmAddr]
+		if override == nil {
+			continue
+		}
+		if override.Balance != nil || override.Nonce != nil {
+			addr, err := address.FromBytes(evmAddr.Bytes())
+			if err != nil {
+				return err
+			}
+			acct, err := accountutil.LoadOrCreateAccount(sm, addr, stateDB.accountCreationOpts()...)
+			if err != nil {
+				return err
+			}
+			if override.Balance != nil {
+				if override.Balance.Sign() < 0 {
+					return errors.Errorf("invalid balance %s of %s", override.Balance, evmAddr.Hex())
+				}
+				acct.Balance = new(big.Int).Set(override.Balance)
+			}
+			if override.Nonce != nil {
+				// a zero-nonce account keeps the nonce of its next action
+				acctPb := acct.ToProto()
+				acctPb.Type = accountpb.AccountType_ZERO_NONCE
+				acctPb.Nonce = *override.Nonce
+				acct.FromProto(acctPb)
+			}
+			if err := accountutil.StoreAccount(sm, addr, acct); err != nil {
+				return err
+			}
+		}
+		if override.Code != nil {
+			stateDB.SetCode(evmAddr, override.Code)
+		}
+		for k, v := range override.StateDiff {
+			stateDB.SetState(evmAddr, k, v)
+		}
+		if err := stateDB.Error(); err != nil {
+			return errors.Wrapf(err, "failed to override the state of %s", evmAddr.Hex())
+		}
+	}
+	return stateDB.CommitContracts()
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package evm
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+
+	"github.com/iotexproject/iotex-core/action/protocol/staking"
+)
+
+type (
+	// stakingPrecompile is the staking precompile registered into the precompiled contracts of go-ethereum, so that
+	// it is reached by CALL and STATICCALL from contracts. The precompiled contracts are shared by all EVMs, so the
+	// precompile reads the state of the execution bound to the goroutine running the EVM. It acts as an account
+	// without code for the executions which the precompile is not enabled for.
+	stakingPrecompile struct {
+		mutex    sync.RWMutex
+		bindings map[uint64]*precompileBinding
+	}
+
+	// precompileBinding is the execution bound to a goroutine
+	precompileBinding struct {
+		id      uint64
+		stateDB *StateDBAdapter
+		// value is the amount transferred to the precompile by the call being run
+		value *uint256.Int
+	}
+)
+
+var _stakingPrecompile = &stakingPrecompile{
+	bindings: make(map[uint64]*precompileBinding),
+}
+
+func init() {
+	// the staking precompile is enabled after Okhotsk, since when the EVM runs with the Berlin rules or later
+	vm.PrecompiledContractsBerlin[staking.PrecompileAddress] = _stakingPrecompile
+	vm.PrecompiledContractsCancun[staking.PrecompileAddress] = _stakingPrecompile
+}
+
+// bind binds the state of the execution to the current goroutine
+func (p *stakingPrecompile) bind(stateDB *StateDBAdapter) *precompileBinding {
+	b := &precompileBinding{
+		id:      goroutineID(),
+		stateDB: stateDB,
+	}
+	p.mutex.Lock()
+	p.bindings[b.id] = b
+	p.mutex.Unlock()
+	return b
+}
+
+func (p *stakingPrecompile) unbind(b *precompileBinding) {
+	p.mutex.Lock()
+	delete(p.bindings, b.id)
+	p.mutex.Unlock()
+}
+
+func (p *stakingPrecompile) bound() *precompileBinding {
+	id := goroutineID()
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return p.bindings[id]
+}
+
+// RequiredGas returns the gas cost of the call
+func (p *stakingPrecompile) RequiredGas(input []byte) uint64 {
+	if p.bound() == nil {
+		return 0
+	}
+	return staking.NewPrecompile(nil).RequiredGas(input)
+}
+
+// Run runs the staking precompile against the staking view of the bound state, the precompile is read-only and
+// reverts the calls transferring any value
+func (p *stakingPrecompile) Run(input []byte) ([]byte, error) {
+	b := p.bound()
+	if b == nil {
+		return nil, nil
+	}
+	value := b.value
+	b.value = nil
+	if value != nil && !value.IsZero() {
+		return nil, vm.ErrExecutionReverted
+	}
+	csr, err := staking.ConstructBaseView(b.stateDB.sm)
+	if err != nil {
+		return nil, err
+	}
+	return staking.NewPrecompile(csr).Run(input)
+}
+
+// transfer records the value transferred to the precompile, which the EVM transfers right before running it
+func (b *precompileBinding) transfer(db vm.StateDB, from, to common.Address, amount *uint256.Int) {
+	if to == staking.PrecompileAddress {
+		b.value = amount
+	}
+	MakeTransfer(db, from, to, amount)
+}
+
+// goroutineID returns the id of the current goroutine, from the header "goroutine <id> [" of its stack
+func goroutineID() uint64 {
+	var buf [64]byte
+	fields := bytes.Fields(buf[:runtime.Stack(buf[:], false)])
+	if len(fields) < 2 {
+		return 0
+	}
+	id, _ := strconv.ParseUint(string(fields[1]), 10, 64)
+	return id
+}
@@ -71,6 +71,7 @@ type (
 		IsIceland  bool `json:"isIceland"`
 		IsLondon   bool `json:"isLondon"`
 		IsShanghai bool `json:"isShanghai"`
+		IsCancun   bool `json:"isCancun"`
 	}
 
 	Log struct {
@@ -437,6 +438,12 @@ func (sct *SmartContractTest) prepareBlockchain(
 		cfg.Genesis.Blockchain.SumatraBlockHeight = 0
 		cfg.Genesis.ActionGasLimit = 10000000
 	}
+	if sct.InitGenesis.IsCancun {
+		// Cancun is enabled at Vanuatu height
+		cfg.Genesis.Blockchain.TsunamiBlockHeight = 0
+		cfg.Genesis.Blockchain.UpernavikBlockHeight = 0
+		cfg.Genesis.Blockchain.VanuatuBlockHeight = 0
+	}
 	for _, expectedBalance := range sct.InitBalances {
 		cfg.Genesis.InitBalanceMap[expectedBalance.Account] = expectedBalance.Balance().String()
 	}
@@ -1319,6 +1326,105 @@ func TestShanghaiEVM(t *testing.T) {
 	t.Run("push0-valid", func(t *testing.T) {
 		NewSmartContractTest(t, "testdata-shanghai/push0.json")
 	})
+	t.Run("transient-storage-invalid", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-shanghai/transient-storage.json")
+	})
+}
+
+func TestCancunEVM(t *testing.T) {
+	t.Run("array-return", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/array-return.json")
+	})
+	t.Run("basefee", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/basefee.json")
+	})
+	t.Run("basic-token", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/basic-token.json")
+	})
+	t.Run("call-dynamic", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/call-dynamic.json")
+	})
+	t.Run("chainid-selfbalance", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/chainid-selfbalance.json")
+	})
+	t.Run("CVE-2021-39137-attack-replay", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/CVE-2021-39137-attack-replay.json")
+	})
+	t.Run("datacopy", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/datacopy.json")
+	})
+	t.Run("f.value", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/f.value.json")
+	})
+	t.Run("factory", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/factory.json")
+	})
+	t.Run("gas-test", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/gas-test.json")
+	})
+	t.Run("infiniteloop", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/infiniteloop.json")
+	})
+	t.Run("mapping-delete", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/mapping-delete.json")
+	})
+	t.Run("maxtime", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/maxtime.json")
+	})
+	t.Run("modifiers", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/modifiers.json")
+	})
+	t.Run("multisend", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/multisend.json")
+	})
+	t.Run("no-variable-length-returns", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/no-variable-length-returns.json")
+	})
+	t.Run("public-mapping", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/public-mapping.json")
+	})
+	t.Run("reentry-attack", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/reentry-attack.json")
+	})
+	t.Run("remove-from-array", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/remove-from-array.json")
+	})
+	t.Run("self-destruct", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/self-destruct.json")
+	})
+	t.Run("send-eth", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/send-eth.json")
+	})
+	t.Run("sha3", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/sha3.json")
+	})
+	t.Run("storage-test", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/storage-test.json")
+	})
+	t.Run("tail-recursion", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/tail-recursion.json")
+	})
+	t.Run("tuple", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/tuple.json")
+	})
+	t.Run("wireconnection", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/wireconnection.json")
+	})
+	t.Run("prevrandao", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/prevrandao.json")
+	})
+	t.Run("push0-valid", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/push0.json")
+	})
+	t.Run("transient-storage", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/transient-storage.json")
+	})
+	t.Run("mcopy", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/mcopy.json")
+	})
+	t.Run("blobbasefee", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata-cancun/blobbasefee.json")
+	})
 }
 
 func benchmarkHotContractWithFactory(b *testing.B, async bool) {
@@ -84,6 +84,13 @@ type (
 		Dock
 	}
 
+	// Accumulator is implemented by the state managers which defer the additions to a state until the result of
+	// the action is merged, so that the actions adding to the same state do not conflict when run in parallel
+	Accumulator interface {
+		// Accumulate adds to the state located by the options with the func, which reads and writes the state
+		Accumulate(func(StateManager) error, ...StateOption) error
+	}
+
 	// Dock defines an interface for protocol to read/write their private data in StateReader/Manager
 	// data are stored as interface{}, user needs to type-assert on their own upon Unload()
 	Dock interface {
@@ -0,0 +1,508 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package poll
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos/rolldpospb"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+const (
+	_delegateConfigProtocolID = "delegateconfig"
+
+	_delegateConfigABI = `[
+	{
+		"inputs": [
+			{"internalType": "uint64", "name": "numDelegates", "type": "uint64"},
+			{"internalType": "uint64", "name": "numCandidateDelegates", "type": "uint64"},
+			{"internalType": "uint64", "name": "productivityThreshold", "type": "uint64"},
+			{"internalType": "uint32", "name": "probationIntensityRate", "type": "uint32"}
+		],
+		"name": "proposeDelegateConfig",
+		"outputs": [],
+		"stateMutability": "nonpayable",
+		"type": "function"
+	},
+	{
+		"inputs": [
+			{"internalType": "uint64", "name": "proposalID", "type": "uint64"}
+		],
+		"name": "approveDelegateConfig",
+		"outputs": [],
+		"stateMutability": "nonpayable",
+		"type": "function"
+	}
+]`
+)
+
+var (
+	_delegateConfigProposalsKey = hash.Hash256b([]byte("poll.DelegateConfigProposals"))
+
+	// ErrInvalidDelegateConfigCall indicates the call data to the delegate config protocol is invalid
+	ErrInvalidDelegateConfigCall = errors.New("invalid delegate config call")
+
+	errNotDelegate      = errors.New("caller is not a delegate of the current epoch")
+	errProposalPending  = errors.New("caller has made a proposal in the current epoch")
+	errProposalNotExist = errors.New("proposal does not exist or has expired")
+	errAlreadyApproved  = errors.New("proposal has already been approved")
+	errConfigApproved   = errors.New("a delegate config has been approved in the current epoch")
+
+	_proposeDelegateCfg abi.Method
+	_approveDelegateCfg abi.Method
+)
+
+func init() {
+	delegateConfigInterface, err := abi.JSON(strings.NewReader(_delegateConfigABI))
+	if err != nil {
+		panic(err)
+	}
+	var ok bool
+	if _proposeDelegateCfg, ok = delegateConfigInterface.Methods["proposeDelegateConfig"]; !ok {
+		panic("fail to load the proposeDelegateConfig method")
+	}
+	if _approveDelegateCfg, ok = delegateConfigInterface.Methods["approveDelegateConfig"]; !ok {
+		panic("fail to load the approveDelegateConfig method")
+	}
+}
+
+type (
+	// DepositGas deposits the gas fee into the rewarding fund
+	DepositGas func(ctx context.Context, sm protocol.StateManager, amount *big.Int) (*action.TransactionLog, error)
+
+	// DelegateConfigProtocol lets the delegates change the delegate set parameters without a hard fork.
+	// Each delegate can propose the parameters once an epoch, and the proposals compete for approvals.
+	// Once a proposal is approved by more than 2/3 of the delegates of the epoch, rolldpos protocol puts
+	// it in effect from the next epoch, and the other proposals are rejected. Proposals expire at the
+	// end of the epoch they are made in.
+	DelegateConfigProtocol struct {
+		addr       address.Address
+		depositGas DepositGas
+	}
+
+	// DelegateConfigProposal is a proposal of delegate config
+	DelegateConfigProposal struct {
+		ID        uint64
+		Epoch     uint64
+		Proposer  string
+		Config    *rolldpos.DelegateConfig
+		Approvers []string
+	}
+
+	// DelegateConfigProposals is the list of proposals sorted by ID
+	DelegateConfigProposals []*DelegateConfigProposal
+)
+
+// NewDelegateConfigProtocol creates a delegate config protocol
+func NewDelegateConfigProtocol(depositGas DepositGas) *DelegateConfigProtocol {
+	return &DelegateConfigProtocol{
+		addr:       protocol.HashStringToAddress(_delegateConfigProtocolID),
+		depositGas: depositGas,
+	}
+}
+
+// DelegateConfigProtocolAddr returns the address of the delegate config protocol
+func DelegateConfigProtocolAddr() address.Address {
+	return protocol.HashStringToAddress(_delegateConfigProtocolID)
+}
+
+// ProposeDelegateConfigData returns the call data to propose a delegate config
+func ProposeDelegateConfigData(numDelegates, numCandidateDelegates, productivityThreshold uint64, probationIntensityRate uint32) ([]byte, error) {
+	data, err := _proposeDelegateCfg.Inputs.Pack(numDelegates, numCandidateDelegates, productivityThreshold, probationIntensityRate)
+	if err != nil {
+		return nil, err
+	}
+	return append(_proposeDelegateCfg.ID, data...), nil
+}
+
+// ApproveDelegateConfigData returns the call data to approve a proposal
+func ApproveDelegateConfigData(proposalID uint64) ([]byte, error) {
+	data, err := _approveDelegateCfg.Inputs.Pack(proposalID)
+	if err != nil {
+		return nil, err
+	}
+	return append(_approveDelegateCfg.ID, data...), nil
+}
+
+// Handle handles the proposal and approval of delegate config
+func (p *DelegateConfigProtocol) Handle(ctx context.Context, act action.Action, sm protocol.StateManager) (*action.Receipt, error) {
+	exec, ok := act.(*action.Execution)
+	if !ok || !p.isDelegateConfigCall(ctx, exec) {
+		return nil, nil
+	}
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	var err error
+	switch data := exec.Data(); {
+	case bytes.HasPrefix(data, _proposeDelegateCfg.ID):
+		err = p.handlePropose(ctx, sm, data)
+	case bytes.HasPrefix(data, _approveDelegateCfg.ID):
+		err = p.handleApprove(ctx, sm, data)
+	default:
+		err = ErrInvalidDelegateConfigCall
+	}
+	status := iotextypes.ReceiptStatus_Success
+	switch errors.Cause(err) {
+	case nil:
+	case errNotDelegate, errProposalPending, errProposalNotExist, errAlreadyApproved, errConfigApproved,
+		ErrInvalidDelegateConfigCall, rolldpos.ErrInvalidDelegateConfig:
+		log.L().Debug("Failed to handle delegate config call", zap.String("caller", actionCtx.Caller.String()), zap.Error(err))
+		status = iotextypes.ReceiptStatus_Failure
+	default:
+		return nil, err
+	}
+	return p.settleAction(ctx, sm, uint64(status))
+}
+
+// Validate validates the call data to the delegate config protocol
+func (p *DelegateConfigProtocol) Validate(ctx context.Context, act action.Action, _ protocol.StateReader) error {
+	exec, ok := act.(*action.Execution)
+	if !ok || !p.isDelegateConfigCall(ctx, exec) {
+		return nil
+	}
+	switch data := exec.Data(); {
+	case bytes.HasPrefix(data, _proposeDelegateCfg.ID):
+		cfg, err := decodeProposeDelegateConfig(data)
+		if err != nil {
+			return err
+		}
+		return rolldpos.MustGetProtocol(protocol.MustGetRegistry(ctx)).ValidateDelegateConfig(cfg)
+	case bytes.HasPrefix(data, _approveDelegateCfg.ID):
+		_, err := decodeApproveDelegateConfig(data)
+		return err
+	default:
+		return ErrInvalidDelegateConfigCall
+	}
+}
+
+// ReadState reads the proposals
+func (p *DelegateConfigProtocol) ReadState(ctx context.Context, sr protocol.StateReader, method []byte, args ...[]byte) ([]byte, uint64, error) {
+	height, err := sr.Height()
+	if err != nil {
+		return nil, uint64(0), err
+	}
+	switch string(method) {
+	case "DelegateConfigProposals":
+		proposals, err := delegateConfigProposals(sr)
+		if err != nil {
+			return nil, uint64(0), err
+		}
+		data, err := proposals.Serialize()
+		return data, height, err
+	default:
+		return nil, uint64(0), errors.New("corresponding method isn't found")
+	}
+}
+
+// Register registers the protocol with a unique ID
+func (p *DelegateConfigProtocol) Register(r *protocol.Registry) error {
+	return r.Register(_delegateConfigProtocolID, p)
+}
+
+// ForceRegister registers the protocol with a unique ID and force replacing the previous protocol if it exists
+func (p *DelegateConfigProtocol) ForceRegister(r *protocol.Registry) error {
+	return r.ForceRegister(_delegateConfigProtocolID, p)
+}
+
+// Name returns the name of protocol
+func (p *DelegateConfigProtocol) Name() string {
+	return _delegateConfigProtocolID
+}
+
+func (p *DelegateConfigProtocol) isDelegateConfigCall(ctx context.Context, exec *action.Execution) bool {
+	return protocol.MustGetFeatureCtx(ctx).EnableDelegateGovernance && exec.Contract() == p.addr.String()
+}
+
+func (p *DelegateConfigProtocol) handlePropose(ctx context.Context, sm protocol.StateManager, data []byte) error {
+	cfg, err := decodeProposeDelegateConfig(data)
+	if err != nil {
+		return err
+	}
+	if err := rolldpos.MustGetProtocol(protocol.MustGetRegistry(ctx)).ValidateDelegateConfig(cfg); err != nil {
+		return err
+	}
+	epochNum, delegates, err := p.currentDelegates(ctx, sm)
+	if err != nil {
+		return err
+	}
+	caller := protocol.MustGetActionCtx(ctx).Caller.String()
+	if _, ok := delegates[caller]; !ok {
+		return errNotDelegate
+	}
+	proposals, err := delegateConfigProposals(sm)
+	if err != nil {
+		return err
+	}
+	id := uint64(1)
+	if n := len(proposals); n > 0 {
+		id = proposals[n-1].ID + 1
+	}
+	// the proposals of past epochs have expired
+	proposals = proposals.ofEpoch(epochNum)
+	for _, proposal := range proposals {
+		switch {
+		case proposal.isApproved(delegates):
+			return errConfigApproved
+		case proposal.Proposer == caller:
+			return errProposalPending
+		}
+	}
+	proposal := &DelegateConfigProposal{ID: id, Epoch: epochNum, Proposer: caller, Config: cfg}
+	return p.approve(ctx, sm, append(proposals, proposal), proposal, caller, delegates)
+}
+
+func (p *DelegateConfigProtocol) handleApprove(ctx context.Context, sm protocol.StateManager, data []byte) error {
+	id, err := decodeApproveDelegateConfig(data)
+	if err != nil {
+		return err
+	}
+	epochNum, delegates, err := p.currentDelegates(ctx, sm)
+	if err != nil {
+		return err
+	}
+	proposals, err := delegateConfigProposals(sm)
+	if err != nil {
+		return err
+	}
+	proposals = proposals.ofEpoch(epochNum)
+	var proposal *DelegateConfigProposal
+	for _, pp := range proposals {
+		if pp.ID == id {
+			proposal = pp
+		}
+		if pp.isApproved(delegates) {
+			if pp.ID == id {
+				return errAlreadyApproved
+			}
+			return errConfigApproved
+		}
+	}
+	if proposal == nil {
+		return errProposalNotExist
+	}
+	return p.approve(ctx, sm, proposals, proposal, protocol.MustGetActionCtx(ctx).Caller.String(), delegates)
+}
+
+// approve adds the approval of the caller to the proposal, and schedules the config for the next
+// epoch once the proposal is approved by a supermajority of the delegates
+func (p *DelegateConfigProtocol) approve(
+	ctx context.Context,
+	sm protocol.StateManager,
+	proposals DelegateConfigProposals,
+	proposal *DelegateConfigProposal,
+	caller string,
+	delegates map[string]struct{},
+) error {
+	if _, ok := delegates[caller]; !ok {
+		return errNotDelegate
+	}
+	for _, approver := range proposal.Approvers {
+		if approver == caller {
+			return errAlreadyApproved
+		}
+	}
+	proposal.Approvers = append(proposal.Approvers, caller)
+	// keep the approved proposal, so that no other proposal can be made or approved until next epoch
+	if _, err := sm.PutState(proposals, protocol.NamespaceOption(protocol.SystemNamespace), protocol.KeyOption(_delegateConfigProposalsKey[:])); err != nil {
+		return err
+	}
+	if !proposal.isApproved(delegates) {
+		return nil
+	}
+	cfg := *proposal.Config
+	cfg.Epoch = proposal.Epoch + 1
+	if err := rolldpos.MustGetProtocol(protocol.MustGetRegistry(ctx)).ScheduleDelegateConfig(sm, &cfg); err != nil {
+		return err
+	}
+	log.L().Info("Delegate config is approved",
+		zap.Uint64("proposal", proposal.ID),
+		zap.Uint64("epoch", cfg.Epoch),
+		zap.Uint64("numDelegates", cfg.NumDelegates),
+		zap.Uint64("numCandidateDelegates", cfg.NumCandidateDelegates))
+	return nil
+}
+
+func (p *DelegateConfigProtocol) currentDelegates(ctx context.Context, sm protocol.StateManager) (uint64, map[string]struct{}, error) {
+	reg := protocol.MustGetRegistry(ctx)
+	epochNum := rolldpos.MustGetProtocol(reg).GetEpochNum(protocol.MustGetBlockCtx(ctx).BlockHeight)
+	candidates, err := MustGetProtocol(reg).Delegates(ctx, sm)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "failed to get delegates")
+	}
+	delegates := make(map[string]struct{}, len(candidates))
+	for _, c := range candidates {
+		delegates[c.Address] = struct{}{}
+	}
+	return epochNum, delegates, nil
+}
+
+func (p *DelegateConfigProtocol) settleAction(ctx context.Context, sm protocol.StateManager, status uint64) (*action.Receipt, error) {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	gasFee := big.NewInt(0).Mul(actionCtx.GasPrice, big.NewInt(0).SetUint64(actionCtx.IntrinsicGas))
+	depositLog, err := p.depositGas(ctx, sm, gasFee)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to deposit gas")
+	}
+	accountCreationOpts := []state.AccountCreationOption{}
+	if protocol.MustGetFeatureCtx(ctx).CreateLegacyNonceAccount {
+		accountCreationOpts = append(accountCreationOpts, state.LegacyNonceAccountTypeOption())
+	}
+	acc, err := accountutil.LoadAccount(sm, actionCtx.Caller, accountCreationOpts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := acc.SetPendingNonce(actionCtx.Nonce + 1); err != nil {
+		return nil, errors.Wrap(err, "failed to set nonce")
+	}
+	if err := accountutil.StoreAccount(sm, actionCtx.Caller, acc); err != nil {
+		return nil, errors.Wrap(err, "failed to update nonce")
+	}
+	r := action.Receipt{
+		Status:          status,
+		BlockHeight:     blkCtx.BlockHeight,
+		ActionHash:      actionCtx.ActionHash,
+		GasConsumed:     actionCtx.IntrinsicGas,
+		ContractAddress: p.addr.String(),
+	}
+	r.AddTransactionLogs(depositLog)
+	return &r, nil
+}
+
+func decodeProposeDelegateConfig(data []byte) (*rolldpos.DelegateConfig, error) {
+	paramsMap := map[string]any{}
+	if err := _proposeDelegateCfg.Inputs.UnpackIntoMap(paramsMap, data[4:]); err != nil {
+		return nil, errors.Wrap(ErrInvalidDelegateConfigCall, err.Error())
+	}
+	cfg := &rolldpos.DelegateConfig{}
+	var ok bool
+	if cfg.NumDelegates, ok = paramsMap["numDelegates"].(uint64); !ok {
+		return nil, ErrInvalidDelegateConfigCall
+	}
+	if cfg.NumCandidateDelegates, ok = paramsMap["numCandidateDelegates"].(uint64); !ok {
+		return nil, ErrInvalidDelegateConfigCall
+	}
+	if cfg.ProductivityThreshold, ok = paramsMap["productivityThreshold"].(uint64); !ok {
+		return nil, ErrInvalidDelegateConfigCall
+	}
+	if cfg.ProbationIntensityRate, ok = paramsMap["probationIntensityRate"].(uint32); !ok {
+		return nil, ErrInvalidDelegateConfigCall
+	}
+	return cfg, nil
+}
+
+func decodeApproveDelegateConfig(data []byte) (uint64, error) {
+	paramsMap := map[string]any{}
+	if err := _approveDelegateCfg.Inputs.UnpackIntoMap(paramsMap, data[4:]); err != nil {
+		return 0, errors.Wrap(ErrInvalidDelegateConfigCall, err.Error())
+	}
+	id, ok := paramsMap["proposalID"].(uint64)
+	if !ok {
+		return 0, ErrInvalidDelegateConfigCall
+	}
+	return id, nil
+}
+
+// Serialize serializes the proposal to bytes
+func (dp *DelegateConfigProposal) Serialize() ([]byte, error) {
+	return proto.Marshal(dp.toProto())
+}
+
+// Deserialize deserializes bytes to the proposal
+func (dp *DelegateConfigProposal) Deserialize(buf []byte) error {
+	pb := &rolldpospb.DelegateConfigProposal{}
+	if err := proto.Unmarshal(buf, pb); err != nil {
+		return errors.Wrap(err, "failed to unmarshal delegate config proposal")
+	}
+	dp.loadProto(pb)
+	return nil
+}
+
+func (dp *DelegateConfigProposal) toProto() *rolldpospb.DelegateConfigProposal {
+	return &rolldpospb.DelegateConfigProposal{
+		Id:        dp.ID,
+		Epoch:     dp.Epoch,
+		Proposer:  dp.Proposer,
+		Config:    dp.Config.Proto(),
+		Approvers: dp.Approvers,
+	}
+}
+
+func (dp *DelegateConfigProposal) loadProto(pb *rolldpospb.DelegateConfigProposal) {
+	dp.ID = pb.GetId()
+	dp.Epoch = pb.GetEpoch()
+	dp.Proposer = pb.GetProposer()
+	dp.Config = &rolldpos.DelegateConfig{}
+	dp.Config.LoadProto(pb.GetConfig())
+	dp.Approvers = pb.GetApprovers()
+}
+
+// isApproved returns true if the proposal is approved by more than 2/3 of the delegates
+func (dp *DelegateConfigProposal) isApproved(delegates map[string]struct{}) bool {
+	return 3*len(dp.Approvers) > 2*len(delegates)
+}
+
+// Serialize serializes the proposals to bytes
+func (dps DelegateConfigProposals) Serialize() ([]byte, error) {
+	pb := &rolldpospb.DelegateConfigProposals{}
+	for _, dp := range dps {
+		pb.Proposals = append(pb.Proposals, dp.toProto())
+	}
+	return proto.Marshal(pb)
+}
+
+// Deserialize deserializes bytes to the proposals
+func (dps *DelegateConfigProposals) Deserialize(buf []byte) error {
+	pb := &rolldpospb.DelegateConfigProposals{}
+	if err := proto.Unmarshal(buf, pb); err != nil {
+		return errors.Wrap(err, "failed to unmarshal delegate config proposals")
+	}
+	proposals := make(DelegateConfigProposals, 0, len(pb.GetProposals()))
+	for _, dpPb := range pb.GetProposals() {
+		dp := &DelegateConfigProposal{}
+		dp.loadProto(dpPb)
+		proposals = append(proposals, dp)
+	}
+	*dps = proposals
+	return nil
+}
+
+func (dps DelegateConfigProposals) ofEpoch(epochNum uint64) DelegateConfigProposals {
+	var proposals DelegateConfigProposals
+	for _, dp := range dps {
+		if dp.Epoch == epochNum {
+			proposals = append(proposals, dp)
+		}
+	}
+	return proposals
+}
+
+func delegateConfigProposals(sr protocol.StateReader) (DelegateConfigProposals, error) {
+	var proposals DelegateConfigProposals
+	_, err := sr.State(&proposals, protocol.NamespaceOption(protocol.SystemNamespace), protocol.KeyOption(_delegateConfigProposalsKey[:]))
+	switch errors.Cause(err) {
+	case nil, state.ErrStateNotExist:
+		return proposals, nil
+	default:
+		return nil, errors.Wrap(err, "failed to read delegate config proposals")
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package poll
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil/testdb"
+)
+
+func TestDelegateConfigProtocol(t *testing.T) {
+	r := require.New(t)
+	ctrl := gomock.NewController(t)
+	sm := testdb.NewMockStateManager(ctrl)
+
+	var delegates []genesis.Delegate
+	for i := 0; i < 4; i++ {
+		delegates = append(delegates, genesis.Delegate{
+			OperatorAddrStr: identityset.Address(i).String(),
+			RewardAddrStr:   identityset.Address(i).String(),
+			VotesStr:        "1",
+		})
+	}
+	g := genesis.TestDefault()
+	g.OkhotskBlockHeight = 0
+	g.ToBeEnabledBlockHeight = 0
+	registry := protocol.NewRegistry()
+	rp := rolldpos.NewProtocol(4, 4, 2)
+	r.NoError(rp.Register(registry))
+	r.NoError(NewLifeLongDelegatesProtocol(delegates).Register(registry))
+	p := NewDelegateConfigProtocol(func(context.Context, protocol.StateManager, *big.Int) (*action.TransactionLog, error) {
+		return nil, nil
+	})
+	r.NoError(p.Register(registry))
+
+	call := func(height uint64, caller address.Address, data []byte) (*action.Receipt, error) {
+		acc, err := accountutil.LoadAccount(sm, caller)
+		r.NoError(err)
+		exec, err := action.NewExecution(DelegateConfigProtocolAddr().String(), acc.PendingNonce(), big.NewInt(0), 100000, big.NewInt(0), data)
+		r.NoError(err)
+		ctx := genesis.WithGenesisContext(protocol.WithRegistry(context.Background(), registry), g)
+		ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: height})
+		ctx = protocol.WithActionCtx(ctx, protocol.ActionCtx{
+			Caller:   caller,
+			Nonce:    acc.PendingNonce(),
+			GasPrice: big.NewInt(0),
+		})
+		ctx = protocol.WithFeatureCtx(ctx)
+		if err := p.Validate(ctx, exec, sm); err != nil {
+			return nil, err
+		}
+		return p.Handle(ctx, exec, sm)
+	}
+	propose, err := ProposeDelegateConfigData(3, 4, 80, 90)
+	r.NoError(err)
+	compete, err := ProposeDelegateConfigData(2, 4, 70, 90)
+	r.NoError(err)
+	approve, err := ApproveDelegateConfigData(1)
+	r.NoError(err)
+	approveCompeting, err := ApproveDelegateConfigData(2)
+	r.NoError(err)
+
+	invalid, err := ProposeDelegateConfigData(5, 4, 80, 90)
+	r.NoError(err)
+	_, err = call(1, identityset.Address(0), invalid)
+	r.ErrorIs(err, rolldpos.ErrInvalidDelegateConfig)
+	_, err = call(1, identityset.Address(0), []byte{1, 2, 3, 4})
+	r.ErrorIs(err, ErrInvalidDelegateConfigCall)
+
+	for _, v := range []struct {
+		caller int
+		data   []byte
+		status iotextypes.ReceiptStatus
+	}{
+		{5, propose, iotextypes.ReceiptStatus_Failure},
+		{0, propose, iotextypes.ReceiptStatus_Success},
+		// a delegate makes one proposal an epoch
+		{0, compete, iotextypes.ReceiptStatus_Failure},
+		// a pending proposal does not block the competing ones
+		{1, compete, iotextypes.ReceiptStatus_Success},
+		{0, approve, iotextypes.ReceiptStatus_Failure},
+		{5, approve, iotextypes.ReceiptStatus_Failure},
+		{1, approve, iotextypes.ReceiptStatus_Success},
+	} {
+		receipt, err := call(1, identityset.Address(v.caller), v.data)
+		r.NoError(err)
+		r.EqualValues(v.status, receipt.Status)
+	}
+	// 2 of 4 delegates is not a supermajority
+	cfg, err := rp.DelegateConfig(sm, 2)
+	r.NoError(err)
+	r.Nil(cfg)
+	data, _, err := p.ReadState(context.Background(), sm, []byte("DelegateConfigProposals"))
+	r.NoError(err)
+	var proposals DelegateConfigProposals
+	r.NoError(proposals.Deserialize(data))
+	r.Len(proposals, 2)
+	r.EqualValues(1, proposals[0].ID)
+	r.Equal(identityset.Address(0).String(), proposals[0].Proposer)
+	r.Len(proposals[0].Approvers, 2)
+	r.EqualValues(2, proposals[1].ID)
+	r.Len(proposals[1].Approvers, 1)
+
+	// the competing proposal is approved by a supermajority, and the other one is rejected
+	for _, v := range []struct {
+		caller int
+		data   []byte
+		status iotextypes.ReceiptStatus
+	}{
+		{2, approveCompeting, iotextypes.ReceiptStatus_Success},
+		{3, approveCompeting, iotextypes.ReceiptStatus_Success},
+		{0, approveCompeting, iotextypes.ReceiptStatus_Failure},
+		{2, approve, iotextypes.ReceiptStatus_Failure},
+		{3, propose, iotextypes.ReceiptStatus_Failure},
+	} {
+		receipt, err := call(1, identityset.Address(v.caller), v.data)
+		r.NoError(err)
+		r.EqualValues(v.status, receipt.Status)
+	}
+
+	// the config is in effect from the next epoch
+	cfg, err = rp.DelegateConfig(sm, 1)
+	r.NoError(err)
+	r.Nil(cfg)
+	cfg, err = rp.DelegateConfig(sm, 2)
+	r.NoError(err)
+	r.Equal(&rolldpos.DelegateConfig{
+		Epoch:                  2,
+		NumDelegates:           2,
+		NumCandidateDelegates:  4,
+		ProductivityThreshold:  70,
+		ProbationIntensityRate: 90,
+	}, cfg)
+
+	// the proposals expire in the next epoch, and new proposals can be made
+	receipt, err := call(rp.GetEpochHeight(2), identityset.Address(3), approveCompeting)
+	r.NoError(err)
+	r.EqualValues(iotextypes.ReceiptStatus_Failure, receipt.Status)
+	receipt, err = call(rp.GetEpochHeight(2), identityset.Address(3), propose)
+	r.NoError(err)
+	r.EqualValues(iotextypes.ReceiptStatus_Success, receipt.Status)
+	data, _, err = p.ReadState(context.Background(), sm, []byte("DelegateConfigProposals"))
+	r.NoError(err)
+	r.NoError(proposals.Deserialize(data))
+	r.Len(proposals, 1)
+	r.EqualValues(3, proposals[0].ID)
+}
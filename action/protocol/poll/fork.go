@@ -0,0 +1,101 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package poll
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/vote"
+	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+var (
+	_ protocol.StateForker = (*nativeStakingV2)(nil)
+	_ protocol.StateForker = (*stakingCommand)(nil)
+)
+
+// CreateForkedGenesisStates sets the delegates of the first epoch to the candidates of the remote chain at the
+// fork height. The votes of the candidates on probation have been reduced by the remote chain, so the probation
+// list of the first epoch is empty
+func (ns *nativeStakingV2) CreateForkedGenesisStates(ctx context.Context, sm protocol.StateManager, fsr protocol.ForkStateReader) error {
+	cands, err := readForkedCandidates(fsr)
+	if err != nil {
+		return err
+	}
+	if err := setCandidates(ctx, sm, ns.candIndexer, cands, uint64(1)); err != nil {
+		return err
+	}
+	if g := genesis.MustExtractGenesisContext(ctx); g.IsEaster(uint64(1)) {
+		return setNextEpochProbationList(sm, ns.candIndexer, uint64(1), vote.NewProbationList(ns.slasher.probationIntensity))
+	}
+	return nil
+}
+
+// ForkState forks the current candidates and probation list
+func (ns *nativeStakingV2) ForkState(fsr protocol.ForkStateReader, namespace string, key []byte) ([]byte, error) {
+	if namespace != protocol.SystemNamespace {
+		return nil, state.ErrStateNotExist
+	}
+	curKey, probationKey := candidatesutil.ConstructKey(candidatesutil.CurCandidateKey), candidatesutil.ConstructKey(candidatesutil.CurProbationKey)
+	switch {
+	case bytes.Equal(key, curKey[:]):
+		cands, err := readForkedCandidates(fsr)
+		if err != nil {
+			return nil, err
+		}
+		return cands.Serialize()
+	case bytes.Equal(key, probationKey[:]):
+		data, err := fsr.ReadState(_protocolID, []byte("ProbationListByEpoch"))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read the probation list from the fork")
+		}
+		list := &vote.ProbationList{}
+		if err := list.Deserialize(data); err != nil {
+			return nil, err
+		}
+		return vote.NewProbationList(list.IntensityRate).Serialize()
+	default:
+		return nil, state.ErrStateNotExist
+	}
+}
+
+// CreateForkedGenesisStates creates the forked genesis states with the native staking
+func (sc *stakingCommand) CreateForkedGenesisStates(ctx context.Context, sm protocol.StateManager, fsr protocol.ForkStateReader) error {
+	forker, ok := sc.stakingV2.(protocol.StateForker)
+	if !ok {
+		return errors.New("poll protocol without native staking cannot be forked")
+	}
+	return forker.CreateForkedGenesisStates(ctx, sm, fsr)
+}
+
+// ForkState forks the states with the native staking
+func (sc *stakingCommand) ForkState(fsr protocol.ForkStateReader, namespace string, key []byte) ([]byte, error) {
+	forker, ok := sc.stakingV2.(protocol.StateForker)
+	if !ok {
+		return nil, state.ErrStateNotExist
+	}
+	return forker.ForkState(fsr, namespace, key)
+}
+
+// readForkedCandidates reads the candidates of the current epoch of the remote chain, of which the votes of the
+// ones on probation have been reduced
+func readForkedCandidates(fsr protocol.ForkStateReader) (state.CandidateList, error) {
+	data, err := fsr.ReadState(_protocolID, []byte("CandidatesByEpoch"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the candidates from the fork")
+	}
+	var cands state.CandidateList
+	if err := cands.Deserialize(data); err != nil {
+		return nil, err
+	}
+	return cands, nil
+}
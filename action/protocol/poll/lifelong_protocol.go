@@ -150,15 +150,6 @@ func (p *lifeLongDelegatesProtocol) readBlockProducers() ([]byte, error) {
 func (p *lifeLongDelegatesProtocol) readActiveBlockProducers(ctx context.Context, sr protocol.StateReader, readFromNext bool) (state.CandidateList, error) {
 	var blockProducerList []string
 	rp := rolldpos.MustGetProtocol(protocol.MustGetRegistry(ctx))
-	blockProducerMap := make(map[string]*state.Candidate)
-	delegates := p.delegates
-	if len(p.delegates) > int(rp.NumCandidateDelegates()) {
-		delegates = p.delegates[:rp.NumCandidateDelegates()]
-	}
-	for _, bp := range delegates {
-		blockProducerList = append(blockProducerList, bp.Address)
-		blockProducerMap[bp.Address] = bp
-	}
 	targetHeight, err := sr.Height()
 	if err != nil {
 		return nil, err
@@ -169,8 +160,26 @@ func (p *lifeLongDelegatesProtocol) readActiveBlockProducers(ctx context.Context
 		targetEpochNum := rp.GetEpochNum(targetEpochStartHeight) + 1
 		targetEpochStartHeight = rp.GetEpochHeight(targetEpochNum) // next epoch start height
 	}
+	targetEpochNum := rp.GetEpochNum(targetEpochStartHeight)
+	numCandidateDelegates, err := rp.NumCandidateDelegatesByEpoch(sr, targetEpochNum)
+	if err != nil {
+		return nil, err
+	}
+	numDelegates, err := rp.NumDelegatesByEpoch(sr, targetEpochNum)
+	if err != nil {
+		return nil, err
+	}
+	blockProducerMap := make(map[string]*state.Candidate)
+	delegates := p.delegates
+	if len(p.delegates) > int(numCandidateDelegates) {
+		delegates = p.delegates[:numCandidateDelegates]
+	}
+	for _, bp := range delegates {
+		blockProducerList = append(blockProducerList, bp.Address)
+		blockProducerMap[bp.Address] = bp
+	}
 	crypto.SortCandidates(blockProducerList, targetEpochStartHeight, crypto.CryptoSeed)
-	length := int(rp.NumDelegates())
+	length := int(numDelegates)
 	if len(blockProducerList) < length {
 		// TODO: if the number of delegates is smaller than expected, should it return error or not?
 		length = len(blockProducerList)
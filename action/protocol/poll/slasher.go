@@ -172,7 +172,7 @@ func (sh *Slasher) ReadState(
 		return data, height, nil
 	case "BlockProducersByEpoch":
 		if indexer != nil {
-			blockProducers, err := sh.GetBPFromIndexer(ctx, epochStartHeight)
+			blockProducers, err := sh.GetBPFromIndexer(ctx, sr, epochStartHeight)
 			if err == nil {
 				data, err := blockProducers.Serialize()
 				if err != nil {
@@ -195,7 +195,7 @@ func (sh *Slasher) ReadState(
 		return data, height, nil
 	case "ActiveBlockProducersByEpoch":
 		if indexer != nil {
-			activeBlockProducers, err := sh.GetABPFromIndexer(ctx, epochStartHeight)
+			activeBlockProducers, err := sh.GetABPFromIndexer(ctx, sr, epochStartHeight)
 			if err == nil {
 				data, err := activeBlockProducers.Serialize()
 				if err != nil {
@@ -285,11 +285,24 @@ func (sh *Slasher) GetCandidates(ctx context.Context, sr protocol.StateReader, r
 
 // GetBlockProducers returns BP list
 func (sh *Slasher) GetBlockProducers(ctx context.Context, sr protocol.StateReader, readFromNext bool) (state.CandidateList, uint64, error) {
+	rp := rolldpos.MustGetProtocol(protocol.MustGetRegistry(ctx))
+	targetHeight, err := sr.Height()
+	if err != nil {
+		return nil, uint64(0), err
+	}
+	targetEpochNum := rp.GetEpochNum(targetHeight)
+	if readFromNext {
+		targetEpochNum++
+	}
 	candidates, height, err := sh.GetCandidates(ctx, sr, readFromNext)
 	if err != nil {
 		return nil, uint64(0), err
 	}
-	bp, err := sh.calculateBlockProducer(candidates)
+	cfg, err := sh.delegateConfig(ctx, sr, targetEpochNum)
+	if err != nil {
+		return nil, uint64(0), err
+	}
+	bp, err := sh.calculateBlockProducer(candidates, cfg.NumCandidateDelegates)
 	if err != nil {
 		return nil, uint64(0), err
 	}
@@ -313,7 +326,11 @@ func (sh *Slasher) GetActiveBlockProducers(ctx context.Context, sr protocol.Stat
 	if err != nil {
 		return nil, uint64(0), errors.Wrapf(err, "failed to read block producers at height %d", targetEpochStartHeight)
 	}
-	abp, err := sh.calculateActiveBlockProducer(ctx, blockProducers, targetEpochStartHeight)
+	cfg, err := sh.delegateConfig(ctx, sr, rp.GetEpochNum(targetEpochStartHeight))
+	if err != nil {
+		return nil, uint64(0), err
+	}
+	abp, err := sh.calculateActiveBlockProducer(ctx, blockProducers, targetEpochStartHeight, cfg.NumDelegates)
 	if err != nil {
 		return nil, uint64(0), err
 	}
@@ -340,21 +357,31 @@ func (sh *Slasher) GetCandidatesFromIndexer(ctx context.Context, epochStartHeigh
 }
 
 // GetBPFromIndexer returns BP list from indexer
-func (sh *Slasher) GetBPFromIndexer(ctx context.Context, epochStartHeight uint64) (state.CandidateList, error) {
+func (sh *Slasher) GetBPFromIndexer(ctx context.Context, sr protocol.StateReader, epochStartHeight uint64) (state.CandidateList, error) {
+	rp := rolldpos.MustGetProtocol(protocol.MustGetRegistry(ctx))
 	candidates, err := sh.GetCandidatesFromIndexer(ctx, epochStartHeight)
 	if err != nil {
 		return nil, err
 	}
-	return sh.calculateBlockProducer(candidates)
+	cfg, err := sh.delegateConfig(ctx, sr, rp.GetEpochNum(epochStartHeight))
+	if err != nil {
+		return nil, err
+	}
+	return sh.calculateBlockProducer(candidates, cfg.NumCandidateDelegates)
 }
 
 // GetABPFromIndexer returns active BP list from indexer
-func (sh *Slasher) GetABPFromIndexer(ctx context.Context, epochStartHeight uint64) (state.CandidateList, error) {
-	blockProducers, err := sh.GetBPFromIndexer(ctx, epochStartHeight)
+func (sh *Slasher) GetABPFromIndexer(ctx context.Context, sr protocol.StateReader, epochStartHeight uint64) (state.CandidateList, error) {
+	rp := rolldpos.MustGetProtocol(protocol.MustGetRegistry(ctx))
+	blockProducers, err := sh.GetBPFromIndexer(ctx, sr, epochStartHeight)
 	if err != nil {
 		return nil, err
 	}
-	return sh.calculateActiveBlockProducer(ctx, blockProducers, epochStartHeight)
+	cfg, err := sh.delegateConfig(ctx, sr, rp.GetEpochNum(epochStartHeight))
+	if err != nil {
+		return nil, err
+	}
+	return sh.calculateActiveBlockProducer(ctx, blockProducers, epochStartHeight, cfg.NumDelegates)
 }
 
 // GetProbationList returns the probation list at given epoch
@@ -394,9 +421,13 @@ func (sh *Slasher) CalculateProbationList(
 	rp := rolldpos.MustGetProtocol(protocol.MustGetRegistry(ctx))
 	g := genesis.MustExtractGenesisContext(ctx)
 	easterEpochNum := rp.GetEpochNum(g.EasterBlockHeight)
+	cfg, err := sh.delegateConfig(ctx, sm, epochNum)
+	if err != nil {
+		return nil, err
+	}
 
 	nextProbationlist := &vote.ProbationList{
-		IntensityRate: sh.probationIntensity,
+		IntensityRate: cfg.ProbationIntensityRate,
 	}
 	upd, err := sh.getUnprodDelegate(sm)
 	if err != nil {
@@ -500,6 +531,10 @@ func (sh *Slasher) calculateUnproductiveDelegates(ctx context.Context, sr protoc
 	if err != nil {
 		return nil, err
 	}
+	cfg, err := sh.delegateConfig(ctx, sr, epochNum)
+	if err != nil {
+		return nil, err
+	}
 	productivityFunc := sh.productivity
 	if featureCtx.CurrentEpochProductivity {
 		productivityFunc = func(start, end uint64) (map[string]uint64, error) {
@@ -527,14 +562,23 @@ func (sh *Slasher) calculateUnproductiveDelegates(ctx context.Context, sr protoc
 			produce[abp.Address] = 0
 		}
 	}
+	return UnproductiveDelegates(produce, numBlks, cfg.ProductivityThreshold), nil
+}
+
+// UnproductiveDelegates returns the delegates whose number of blocks produced in percent of the expected number is
+// below the productivity threshold, with the expected number evenly split among the delegates in produce
+func UnproductiveDelegates(produce map[string]uint64, numBlks, productivityThreshold uint64) []string {
 	unqualified := make([]string, 0)
+	if len(produce) == 0 {
+		return unqualified
+	}
 	expectedNumBlks := numBlks / uint64(len(produce))
 	for addr, actualNumBlks := range produce {
-		if actualNumBlks*100/expectedNumBlks < sh.prodThreshold {
+		if actualNumBlks*100/expectedNumBlks < productivityThreshold {
 			unqualified = append(unqualified, addr)
 		}
 	}
-	return unqualified, nil
+	return unqualified
 }
 
 func (sh *Slasher) updateCurrentBlockMeta(ctx context.Context, sm protocol.StateManager) error {
@@ -543,11 +587,27 @@ func (sh *Slasher) updateCurrentBlockMeta(ctx context.Context, sm protocol.State
 	return setCurrentBlockMeta(sm, currentBlockMeta, blkCtx.BlockHeight, sh.numOfBlocksByEpoch)
 }
 
+// delegateConfig returns the delegate set parameters of the epoch, which are either approved by
+// governance or defined in genesis
+func (sh *Slasher) delegateConfig(ctx context.Context, sr protocol.StateReader, epochNum uint64) (*rolldpos.DelegateConfig, error) {
+	rp := rolldpos.MustGetProtocol(protocol.MustGetRegistry(ctx))
+	cfg, err := rp.DelegateConfig(sr, epochNum)
+	if err != nil || cfg != nil {
+		return cfg, err
+	}
+	return &rolldpos.DelegateConfig{
+		NumDelegates:           sh.numDelegates,
+		NumCandidateDelegates:  sh.numCandidateDelegates,
+		ProductivityThreshold:  sh.prodThreshold,
+		ProbationIntensityRate: sh.probationIntensity,
+	}, nil
+}
+
 // calculateBlockProducer calculates block producer by given candidate list
-func (sh *Slasher) calculateBlockProducer(candidates state.CandidateList) (state.CandidateList, error) {
+func (sh *Slasher) calculateBlockProducer(candidates state.CandidateList, numCandidateDelegates uint64) (state.CandidateList, error) {
 	var blockProducers state.CandidateList
 	for i, candidate := range candidates {
-		if uint64(i) >= sh.numCandidateDelegates {
+		if uint64(i) >= numCandidateDelegates {
 			break
 		}
 		if candidate.Votes.Cmp(big.NewInt(0)) == 0 {
@@ -564,6 +624,7 @@ func (sh *Slasher) calculateActiveBlockProducer(
 	ctx context.Context,
 	blockProducers state.CandidateList,
 	epochStartHeight uint64,
+	numDelegates uint64,
 ) (state.CandidateList, error) {
 	var blockProducerList []string
 	blockProducerMap := make(map[string]*state.Candidate)
@@ -573,14 +634,14 @@ func (sh *Slasher) calculateActiveBlockProducer(
 	}
 	crypto.SortCandidates(blockProducerList, epochStartHeight, crypto.CryptoSeed)
 
-	length := int(sh.numDelegates)
+	length := int(numDelegates)
 	if len(blockProducerList) < length {
 		// TODO: if the number of delegates is smaller than expected, should it return error or not?
 		length = len(blockProducerList)
 		log.L().Warn(
 			"the number of block producer is less than expected",
 			zap.Int("actual block producer", len(blockProducerList)),
-			zap.Uint64("expected", sh.numDelegates),
+			zap.Uint64("expected", numDelegates),
 		)
 	}
 	var activeBlockProducers state.CandidateList
@@ -46,6 +46,21 @@ type GenesisStateCreator interface {
 	CreateGenesisStates(context.Context, StateManager) error
 }
 
+// ForkStateReader reads the states of the remote chain which the local chain is forked from, with the ReadState of
+// the protocols of the remote chain
+type ForkStateReader interface {
+	ReadState(protocolID string, method []byte, args ...[]byte) ([]byte, error)
+}
+
+// StateForker forks the states of the protocol from the remote chain, instead of creating them from the genesis
+type StateForker interface {
+	// CreateForkedGenesisStates creates the genesis states of the forked chain, e.g. the ones iterated at startup
+	CreateForkedGenesisStates(context.Context, StateManager, ForkStateReader) error
+	// ForkState returns the serialized state in the namespace at the key read from the remote chain, or
+	// state.ErrStateNotExist if it is not forked
+	ForkState(ForkStateReader, string, []byte) ([]byte, error)
+}
+
 // PreStatesCreator creates preliminary states for state manager
 type PreStatesCreator interface {
 	CreatePreStates(context.Context, StateManager) error
@@ -0,0 +1,129 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package rewarding
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"strconv"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+var _ protocol.StateForker = (*Protocol)(nil)
+
+// CreateForkedGenesisStates creates the addresses exempt from the epoch reward in the genesis, which the remote
+// chain does not return. The admin and the fund are forked
+func (p *Protocol) CreateForkedGenesisStates(ctx context.Context, sm protocol.StateManager, _ protocol.ForkStateReader) error {
+	g := genesis.MustExtractGenesisContext(ctx)
+	return p.putState(ctx, sm, _exemptKey, &exempt{
+		addrs: g.ExemptAddrsFromEpochReward(),
+	})
+}
+
+// ForkState forks the admin, the fund and the unclaimed balances of the reward accounts. The reward accounts are
+// forked in the v2 storage only, since their keys are hashed in the legacy one
+func (p *Protocol) ForkState(fsr protocol.ForkStateReader, ns string, key []byte) ([]byte, error) {
+	var k []byte
+	switch {
+	case ns == _v2RewardingNamespace && bytes.HasPrefix(key, p.keyPrefix):
+		k = key[len(p.keyPrefix):]
+	case ns != _v2RewardingNamespace && bytes.Equal(key, p.legacyKey(_adminKey)):
+		k = _adminKey
+	case ns != _v2RewardingNamespace && bytes.Equal(key, p.legacyKey(_fundKey)):
+		k = _fundKey
+	default:
+		return nil, state.ErrStateNotExist
+	}
+	switch {
+	case bytes.Equal(k, _adminKey):
+		a, err := readForkedAdmin(fsr)
+		if err != nil {
+			return nil, err
+		}
+		return a.Serialize()
+	case bytes.Equal(k, _fundKey):
+		total, err := readForkedAmount(fsr, "TotalBalance")
+		if err != nil {
+			return nil, err
+		}
+		available, err := readForkedAmount(fsr, "AvailableBalance")
+		if err != nil {
+			return nil, err
+		}
+		return fund{totalBalance: total, unclaimedBalance: available}.Serialize()
+	case bytes.HasPrefix(k, _adminKey) && len(k) == len(_adminKey)+len(hash.ZeroHash160):
+		addr, err := address.FromBytes(k[len(_adminKey):])
+		if err != nil {
+			return nil, state.ErrStateNotExist
+		}
+		balance, err := readForkedAmount(fsr, "UnclaimedBalance", []byte(addr.String()))
+		if err != nil {
+			return nil, err
+		}
+		if balance.Sign() == 0 {
+			return nil, state.ErrStateNotExist
+		}
+		return rewardAccount{balance: balance}.Serialize()
+	default:
+		return nil, state.ErrStateNotExist
+	}
+}
+
+func (p *Protocol) legacyKey(key []byte) []byte {
+	keyHash := hash.Hash160b(append(p.keyPrefix, key...))
+	return keyHash[:]
+}
+
+func readForkedAdmin(fsr protocol.ForkStateReader) (*admin, error) {
+	var (
+		a   admin
+		err error
+	)
+	if a.blockReward, err = readForkedAmount(fsr, "BlockReward"); err != nil {
+		return nil, err
+	}
+	if a.epochReward, err = readForkedAmount(fsr, "EpochReward"); err != nil {
+		return nil, err
+	}
+	if a.foundationBonus, err = readForkedAmount(fsr, "FoundationBonus"); err != nil {
+		return nil, err
+	}
+	for method, v := range map[string]*uint64{
+		"NumDelegatesForEpochReward":     &a.numDelegatesForEpochReward,
+		"NumDelegatesForFoundationBonus": &a.numDelegatesForFoundationBonus,
+		"FoundationBonusLastEpoch":       &a.foundationBonusLastEpoch,
+		"ProductivityThreshold":          &a.productivityThreshold,
+	} {
+		data, err := fsr.ReadState(_protocolID, []byte(method))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s from the fork", method)
+		}
+		if *v, err = strconv.ParseUint(string(data), 10, 64); err != nil {
+			return nil, errors.Wrapf(err, "invalid forked %s", method)
+		}
+	}
+	return &a, nil
+}
+
+func readForkedAmount(fsr protocol.ForkStateReader, method string, args ...[]byte) (*big.Int, error) {
+	data, err := fsr.ReadState(_protocolID, []byte(method), args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s from the fork", method)
+	}
+	amount, ok := new(big.Int).SetString(string(data), 10)
+	if !ok {
+		return nil, errors.Errorf("invalid forked %s %s", method, string(data))
+	}
+	return amount, nil
+}
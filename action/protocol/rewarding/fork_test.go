@@ -0,0 +1,85 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package rewarding
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+type testForkStateReader map[string]string
+
+func (r testForkStateReader) ReadState(_ string, method []byte, args ...[]byte) ([]byte, error) {
+	key := string(method)
+	for _, arg := range args {
+		key += string(arg)
+	}
+	return []byte(r[key]), nil
+}
+
+func TestForkState(t *testing.T) {
+	r := require.New(t)
+	p := NewProtocol(genesis.Default.Rewarding)
+	addr := identityset.Address(0)
+	fsr := testForkStateReader{
+		"BlockReward":                                        "16",
+		"EpochReward":                                        "300",
+		"FoundationBonus":                                    "80",
+		"NumDelegatesForEpochReward":                         "100",
+		"NumDelegatesForFoundationBonus":                     "36",
+		"FoundationBonusLastEpoch":                           "8760",
+		"ProductivityThreshold":                              "85",
+		"TotalBalance":                                       "1000",
+		"AvailableBalance":                                   "400",
+		"UnclaimedBalance" + addr.String():                   "5",
+		"UnclaimedBalance" + identityset.Address(1).String(): "0",
+	}
+
+	// admin
+	data, err := p.ForkState(fsr, _v2RewardingNamespace, append(p.keyPrefix, _adminKey...))
+	r.NoError(err)
+	a := admin{}
+	r.NoError(a.Deserialize(data))
+	r.Equal(big.NewInt(16), a.blockReward)
+	r.Equal(big.NewInt(300), a.epochReward)
+	r.Equal(big.NewInt(80), a.foundationBonus)
+	r.Equal(uint64(100), a.numDelegatesForEpochReward)
+	r.Equal(uint64(36), a.numDelegatesForFoundationBonus)
+	r.Equal(uint64(8760), a.foundationBonusLastEpoch)
+	r.Equal(uint64(85), a.productivityThreshold)
+	legacy, err := p.ForkState(fsr, _protocolID, p.legacyKey(_adminKey))
+	r.NoError(err)
+	r.Equal(data, legacy)
+
+	// fund
+	data, err = p.ForkState(fsr, _v2RewardingNamespace, append(p.keyPrefix, _fundKey...))
+	r.NoError(err)
+	f := fund{}
+	r.NoError(f.Deserialize(data))
+	r.Equal(big.NewInt(1000), f.totalBalance)
+	r.Equal(big.NewInt(400), f.unclaimedBalance)
+
+	// reward accounts
+	data, err = p.ForkState(fsr, _v2RewardingNamespace, append(append(p.keyPrefix, _adminKey...), addr.Bytes()...))
+	r.NoError(err)
+	acc := rewardAccount{}
+	r.NoError(acc.Deserialize(data))
+	r.Equal(big.NewInt(5), acc.balance)
+	_, err = p.ForkState(fsr, _v2RewardingNamespace, append(append(p.keyPrefix, _adminKey...), identityset.Address(1).Bytes()...))
+	r.Equal(state.ErrStateNotExist, err)
+
+	// the other states are not forked
+	_, err = p.ForkState(fsr, _v2RewardingNamespace, append(p.keyPrefix, _exemptKey...))
+	r.Equal(state.ErrStateNotExist, err)
+	_, err = p.ForkState(fsr, _protocolID, p.legacyKey(append(_adminKey, addr.Bytes()...)))
+	r.Equal(state.ErrStateNotExist, err)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 
@@ -92,13 +93,17 @@ func (p *Protocol) deposit(
 		return nil, err
 	}
 	// Add balance to fund
-	f := fund{}
-	if _, err := p.state(ctx, sm, _fundKey, &f); err != nil {
-		return nil, err
-	}
-	f.totalBalance.Add(f.totalBalance, amount)
-	f.unclaimedBalance.Add(f.unclaimedBalance, amount)
-	if !isZero(sgdAmount) {
+	if isZero(sgdAmount) {
+		if err := p.addFund(ctx, sm, amount); err != nil {
+			return nil, err
+		}
+	} else {
+		f := fund{}
+		if _, err := p.state(ctx, sm, _fundKey, &f); err != nil {
+			return nil, err
+		}
+		f.totalBalance.Add(f.totalBalance, amount)
+		f.unclaimedBalance.Add(f.unclaimedBalance, amount)
 		f.unclaimedBalance.Sub(f.unclaimedBalance, sgdAmount)
 		if f.unclaimedBalance.Sign() == -1 {
 			return nil, errors.New("no enough available balance")
@@ -107,9 +112,9 @@ func (p *Protocol) deposit(
 		if err := p.grantToAccount(ctx, sm, receiver, sgdAmount); err != nil {
 			return nil, err
 		}
-	}
-	if err := p.putState(ctx, sm, _fundKey, &f); err != nil {
-		return nil, err
+		if err := p.putState(ctx, sm, _fundKey, &f); err != nil {
+			return nil, err
+		}
 	}
 	return &action.TransactionLog{
 		Type:      transactionLogType,
@@ -119,6 +124,32 @@ func (p *Protocol) deposit(
 	}, nil
 }
 
+// addFund adds the amount to the balance of the fund. The additions commute, so they are deferred if the state
+// manager accumulates them.
+func (p *Protocol) addFund(ctx context.Context, sm protocol.StateManager, amount *big.Int) error {
+	add := func(sm protocol.StateManager) error {
+		f := fund{}
+		if _, err := p.state(ctx, sm, _fundKey, &f); err != nil {
+			return err
+		}
+		f.totalBalance.Add(f.totalBalance, amount)
+		f.unclaimedBalance.Add(f.unclaimedBalance, amount)
+		return p.putState(ctx, sm, _fundKey, &f)
+	}
+	if acc, ok := sm.(protocol.Accumulator); ok {
+		return acc.Accumulate(add, p.fundKeyOptions(ctx)...)
+	}
+	return add(sm)
+}
+
+// fundKeyOptions returns the options locating the fund state being written
+func (p *Protocol) fundKeyOptions(ctx context.Context) []protocol.StateOption {
+	if useV2Storage(ctx) {
+		return []protocol.StateOption{protocol.KeyOption(append(p.keyPrefix, _fundKey...)), protocol.NamespaceOption(_v2RewardingNamespace)}
+	}
+	return []protocol.StateOption{protocol.LegacyKeyOption(hash.Hash160b(append(p.keyPrefix, _fundKey...)))}
+}
+
 // TotalBalance returns the total balance of the rewarding fund
 func (p *Protocol) TotalBalance(
 	ctx context.Context,
@@ -8,6 +8,7 @@ package rewarding
 import (
 	"context"
 	"math/big"
+	"strconv"
 
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -280,6 +281,31 @@ func (p *Protocol) ReadState(
 			return nil, uint64(0), err
 		}
 		return []byte(balance.String()), height, nil
+	case "BlockReward", "EpochReward", "FoundationBonus", "FoundationBonusLastEpoch",
+		"NumDelegatesForEpochReward", "NumDelegatesForFoundationBonus", "ProductivityThreshold":
+		a := admin{}
+		height, err := p.state(ctx, sr, _adminKey, &a)
+		if err != nil {
+			return nil, uint64(0), err
+		}
+		var value string
+		switch string(method) {
+		case "BlockReward":
+			value = a.blockReward.String()
+		case "EpochReward":
+			value = a.epochReward.String()
+		case "FoundationBonus":
+			value = a.foundationBonus.String()
+		case "FoundationBonusLastEpoch":
+			value = strconv.FormatUint(a.foundationBonusLastEpoch, 10)
+		case "NumDelegatesForEpochReward":
+			value = strconv.FormatUint(a.numDelegatesForEpochReward, 10)
+		case "NumDelegatesForFoundationBonus":
+			value = strconv.FormatUint(a.numDelegatesForFoundationBonus, 10)
+		case "ProductivityThreshold":
+			value = strconv.FormatUint(a.productivityThreshold, 10)
+		}
+		return []byte(value), height, nil
 	default:
 		return nil, uint64(0), errors.New("corresponding method isn't found")
 	}
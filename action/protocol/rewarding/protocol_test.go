@@ -8,6 +8,7 @@ package rewarding
 import (
 	"context"
 	"math/big"
+	"strconv"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -513,6 +514,33 @@ func TestProtocol_Handle(t *testing.T) {
 		require.Equal(t, ts.expect, output)
 	}
 
+	// Test for ReadState of admin parameters
+	epochReward, err := p.EpochReward(ctx, sm)
+	require.NoError(t, err)
+	foundationBonus, err := p.FoundationBonus(ctx, sm)
+	require.NoError(t, err)
+	lastEpoch, err := p.FoundationBonusLastEpoch(ctx, sm)
+	require.NoError(t, err)
+	numEpochReward, err := p.NumDelegatesForEpochReward(ctx, sm)
+	require.NoError(t, err)
+	numFoundationBonus, err := p.NumDelegatesForFoundationBonus(ctx, sm)
+	require.NoError(t, err)
+	threshold, err := p.ProductivityThreshold(ctx, sm)
+	require.NoError(t, err)
+	for method, expect := range map[string]string{
+		"BlockReward":                    BlockReward.String(),
+		"EpochReward":                    epochReward.String(),
+		"FoundationBonus":                foundationBonus.String(),
+		"FoundationBonusLastEpoch":       strconv.FormatUint(lastEpoch, 10),
+		"NumDelegatesForEpochReward":     strconv.FormatUint(numEpochReward, 10),
+		"NumDelegatesForFoundationBonus": strconv.FormatUint(numFoundationBonus, 10),
+		"ProductivityThreshold":          strconv.FormatUint(threshold, 10),
+	} {
+		output, _, err := p.ReadState(ctx, sm, []byte(method))
+		require.NoError(t, err)
+		require.Equal(t, expect, string(output), method)
+	}
+
 	// Test for deleteState
 	sm.EXPECT().DelState(gomock.Any()).DoAndReturn(func(addrHash hash.Hash160) error {
 		cb.Delete("state", addrHash[:], "failed to delete state")
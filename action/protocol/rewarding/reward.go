@@ -221,21 +221,13 @@ func (p *Protocol) GrantEpochReward(
 
 	// Reward additional bootstrap bonus
 	if a.grantFoundationBonus(epochNum) || (epochNum >= p.cfg.FoundationBonusP2StartEpoch && epochNum <= p.cfg.FoundationBonusP2EndEpoch) {
-		for i, count := 0, uint64(0); i < len(candidates) && count < a.numDelegatesForFoundationBonus; i++ {
-			if _, ok := exemptAddrs[candidates[i].Address]; ok {
-				continue
-			}
-			if candidates[i].Votes.Cmp(big.NewInt(0)) == 0 {
-				// hard probation
-				continue
-			}
-			count++
+		for _, candidate := range FoundationBonusRecipients(candidates, a.numDelegatesForFoundationBonus, exemptAddrs) {
 			// If reward address doesn't exist, do nothing
-			if candidates[i].RewardAddress == "" {
-				log.S().Warnf("Candidate %s doesn't have a reward address", candidates[i].Address)
+			if candidate.RewardAddress == "" {
+				log.S().Warnf("Candidate %s doesn't have a reward address", candidate.Address)
 				continue
 			}
-			rewardAddr, err := address.FromString(candidates[i].RewardAddress)
+			rewardAddr, err := address.FromString(candidate.RewardAddress)
 			if err != nil {
 				return nil, err
 			}
@@ -244,7 +236,7 @@ func (p *Protocol) GrantEpochReward(
 			}
 			rewardLog := rewardingpb.RewardLog{
 				Type:   rewardingpb.RewardLog_FOUNDATION_BONUS,
-				Addr:   candidates[i].RewardAddress,
+				Addr:   candidate.RewardAddress,
 				Amount: a.foundationBonus.String(),
 			}
 			data, err := proto.Marshal(&rewardLog)
@@ -417,22 +409,10 @@ func (p *Protocol) splitEpochReward(
 	exemptAddrs map[string]interface{},
 	uqd map[string]bool,
 ) ([]address.Address, []*big.Int, error) {
-	filteredCandidates := make([]*state.Candidate, 0)
-	for _, candidate := range candidates {
-		if _, ok := exemptAddrs[candidate.Address]; ok {
-			continue
-		}
-		filteredCandidates = append(filteredCandidates, candidate)
-	}
-	candidates = filteredCandidates
+	candidates, amounts := SplitEpochReward(candidates, totalAmount, numDelegatesForEpochReward, exemptAddrs, uqd)
 	if len(candidates) == 0 {
 		return nil, nil, nil
 	}
-	// We at most allow numDelegatesForEpochReward delegates to get the epoch reward
-	if uint64(len(candidates)) > numDelegatesForEpochReward {
-		candidates = candidates[:numDelegatesForEpochReward]
-	}
-	totalWeight := big.NewInt(0)
 	rewardAddrs := make([]address.Address, 0)
 	for _, candidate := range candidates {
 		var rewardAddr address.Address
@@ -446,6 +426,37 @@ func (p *Protocol) splitEpochReward(
 			log.S().Warnf("Candidate %s doesn't have a reward address", candidate.Address)
 		}
 		rewardAddrs = append(rewardAddrs, rewardAddr)
+	}
+	return rewardAddrs, amounts, nil
+}
+
+// SplitEpochReward returns the candidates sharing the epoch reward and their amounts. The top
+// numDelegatesForEpochReward candidates not exempted share the reward in proportion to their votes, while the
+// unqualified ones are counted in the total weight but get nothing.
+func SplitEpochReward(
+	candidates []*state.Candidate,
+	totalAmount *big.Int,
+	numDelegatesForEpochReward uint64,
+	exemptAddrs map[string]interface{},
+	uqd map[string]bool,
+) ([]*state.Candidate, []*big.Int) {
+	filteredCandidates := make([]*state.Candidate, 0)
+	for _, candidate := range candidates {
+		if _, ok := exemptAddrs[candidate.Address]; ok {
+			continue
+		}
+		filteredCandidates = append(filteredCandidates, candidate)
+	}
+	candidates = filteredCandidates
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	// We at most allow numDelegatesForEpochReward delegates to get the epoch reward
+	if uint64(len(candidates)) > numDelegatesForEpochReward {
+		candidates = candidates[:numDelegatesForEpochReward]
+	}
+	totalWeight := big.NewInt(0)
+	for _, candidate := range candidates {
 		totalWeight = big.NewInt(0).Add(totalWeight, candidate.Votes)
 	}
 	amounts := make([]*big.Int, 0)
@@ -463,7 +474,24 @@ func (p *Protocol) splitEpochReward(
 		amountPerAddr = big.NewInt(0).Div(big.NewInt(0).Mul(totalAmount, candidate.Votes), totalWeight)
 		amounts = append(amounts, amountPerAddr)
 	}
-	return rewardAddrs, amounts, nil
+	return candidates, amounts
+}
+
+// FoundationBonusRecipients returns the top numDelegatesForFoundationBonus candidates granted the foundation
+// bonus, skipping the exempted ones and the ones on hard probation
+func FoundationBonusRecipients(candidates []*state.Candidate, numDelegatesForFoundationBonus uint64, exemptAddrs map[string]interface{}) []*state.Candidate {
+	recipients := make([]*state.Candidate, 0)
+	for i := 0; i < len(candidates) && uint64(len(recipients)) < numDelegatesForFoundationBonus; i++ {
+		if _, ok := exemptAddrs[candidates[i].Address]; ok {
+			continue
+		}
+		if candidates[i].Votes.Cmp(big.NewInt(0)) == 0 {
+			// hard probation
+			continue
+		}
+		recipients = append(recipients, candidates[i])
+	}
+	return recipients
 }
 
 func (p *Protocol) assertNoRewardYet(ctx context.Context, sm protocol.StateManager, prefix []byte, index uint64) error {
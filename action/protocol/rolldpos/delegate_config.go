@@ -0,0 +1,188 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos/rolldpospb"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+// MaxNumCandidateDelegates is the maximum number of candidate delegates a config can approve
+const MaxNumCandidateDelegates = 256
+
+var (
+	_delegateConfigsKey = hash.Hash256b([]byte("rolldpos.DelegateConfigs"))
+
+	// ErrInvalidDelegateConfig indicates the delegate config is invalid
+	ErrInvalidDelegateConfig = errors.New("invalid delegate config")
+)
+
+type (
+	// DelegateConfig is the delegate set parameters approved by governance, which are in effect
+	// from the start of Epoch. The epoch length is not affected and stays as defined in genesis.
+	DelegateConfig struct {
+		Epoch                  uint64
+		NumDelegates           uint64
+		NumCandidateDelegates  uint64
+		ProductivityThreshold  uint64
+		ProbationIntensityRate uint32
+	}
+
+	// DelegateConfigs is the history of delegate configs sorted by epoch
+	DelegateConfigs []*DelegateConfig
+)
+
+// Validate checks the parameters of the config
+func (c *DelegateConfig) Validate() error {
+	switch {
+	case c.NumDelegates == 0:
+		return errors.Wrap(ErrInvalidDelegateConfig, "number of delegates is 0")
+	case c.NumCandidateDelegates < c.NumDelegates:
+		return errors.Wrapf(ErrInvalidDelegateConfig, "%d candidate delegates is less than %d delegates", c.NumCandidateDelegates, c.NumDelegates)
+	case c.NumCandidateDelegates > MaxNumCandidateDelegates:
+		return errors.Wrapf(ErrInvalidDelegateConfig, "%d candidate delegates is more than %d", c.NumCandidateDelegates, MaxNumCandidateDelegates)
+	case c.ProductivityThreshold > 100:
+		return errors.Wrapf(ErrInvalidDelegateConfig, "productivity threshold %d is larger than 100", c.ProductivityThreshold)
+	case c.ProbationIntensityRate > 100:
+		return errors.Wrapf(ErrInvalidDelegateConfig, "probation intensity rate %d is larger than 100", c.ProbationIntensityRate)
+	}
+	return nil
+}
+
+// ValidateDelegateConfig checks the config, and that every delegate has a block to produce in an epoch,
+// whose length stays as defined in genesis
+func (p *Protocol) ValidateDelegateConfig(c *DelegateConfig) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	epochLength := p.numDelegates * p.numSubEpochs
+	if p.dardanellesOn && p.numSubEpochsDardanelles < p.numSubEpochs {
+		epochLength = p.numDelegates * p.numSubEpochsDardanelles
+	}
+	if c.NumDelegates > epochLength {
+		return errors.Wrapf(ErrInvalidDelegateConfig, "%d delegates is more than %d blocks in an epoch", c.NumDelegates, epochLength)
+	}
+	return nil
+}
+
+// Proto converts the config to protobuf message
+func (c *DelegateConfig) Proto() *rolldpospb.DelegateConfig {
+	return &rolldpospb.DelegateConfig{
+		Epoch:                  c.Epoch,
+		NumDelegates:           c.NumDelegates,
+		NumCandidateDelegates:  c.NumCandidateDelegates,
+		ProductivityThreshold:  c.ProductivityThreshold,
+		ProbationIntensityRate: c.ProbationIntensityRate,
+	}
+}
+
+// LoadProto loads the config from protobuf message
+func (c *DelegateConfig) LoadProto(pb *rolldpospb.DelegateConfig) {
+	c.Epoch = pb.GetEpoch()
+	c.NumDelegates = pb.GetNumDelegates()
+	c.NumCandidateDelegates = pb.GetNumCandidateDelegates()
+	c.ProductivityThreshold = pb.GetProductivityThreshold()
+	c.ProbationIntensityRate = pb.GetProbationIntensityRate()
+}
+
+// Serialize serializes the configs to bytes
+func (cs DelegateConfigs) Serialize() ([]byte, error) {
+	pb := &rolldpospb.DelegateConfigs{}
+	for _, c := range cs {
+		pb.Configs = append(pb.Configs, c.Proto())
+	}
+	return proto.Marshal(pb)
+}
+
+// Deserialize deserializes bytes to the configs
+func (cs *DelegateConfigs) Deserialize(buf []byte) error {
+	pb := &rolldpospb.DelegateConfigs{}
+	if err := proto.Unmarshal(buf, pb); err != nil {
+		return errors.Wrap(err, "failed to unmarshal delegate configs")
+	}
+	configs := make(DelegateConfigs, 0, len(pb.Configs))
+	for _, cPb := range pb.Configs {
+		c := &DelegateConfig{}
+		c.LoadProto(cPb)
+		configs = append(configs, c)
+	}
+	*cs = configs
+	return nil
+}
+
+// DelegateConfig returns the delegate config approved by governance in effect at the epoch,
+// or nil if the genesis parameters are still in use
+func (p *Protocol) DelegateConfig(sr protocol.StateReader, epochNum uint64) (*DelegateConfig, error) {
+	configs, err := delegateConfigs(sr)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(configs) - 1; i >= 0; i-- {
+		if configs[i].Epoch <= epochNum {
+			return configs[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// NumDelegatesByEpoch returns the number of delegates in an epoch
+func (p *Protocol) NumDelegatesByEpoch(sr protocol.StateReader, epochNum uint64) (uint64, error) {
+	c, err := p.DelegateConfig(sr, epochNum)
+	if err != nil || c == nil {
+		return p.numDelegates, err
+	}
+	return c.NumDelegates, nil
+}
+
+// NumCandidateDelegatesByEpoch returns the number of delegate candidates for an epoch
+func (p *Protocol) NumCandidateDelegatesByEpoch(sr protocol.StateReader, epochNum uint64) (uint64, error) {
+	c, err := p.DelegateConfig(sr, epochNum)
+	if err != nil || c == nil {
+		return p.numCandidateDelegates, err
+	}
+	return c.NumCandidateDelegates, nil
+}
+
+// ScheduleDelegateConfig puts a config in effect from the start of its epoch. A config scheduled
+// for the same epoch is replaced, while the configs of earlier epochs are kept as history.
+func (p *Protocol) ScheduleDelegateConfig(sm protocol.StateManager, c *DelegateConfig) error {
+	if err := p.ValidateDelegateConfig(c); err != nil {
+		return err
+	}
+	configs, err := delegateConfigs(sm)
+	if err != nil {
+		return err
+	}
+	if n := len(configs); n > 0 {
+		switch last := configs[n-1]; {
+		case last.Epoch > c.Epoch:
+			return errors.Wrapf(ErrInvalidDelegateConfig, "epoch %d is before scheduled epoch %d", c.Epoch, last.Epoch)
+		case last.Epoch == c.Epoch:
+			configs = configs[:n-1]
+		}
+	}
+	configs = append(configs, c)
+	_, err = sm.PutState(&configs, protocol.NamespaceOption(protocol.SystemNamespace), protocol.KeyOption(_delegateConfigsKey[:]))
+	return err
+}
+
+func delegateConfigs(sr protocol.StateReader) (DelegateConfigs, error) {
+	var configs DelegateConfigs
+	_, err := sr.State(&configs, protocol.NamespaceOption(protocol.SystemNamespace), protocol.KeyOption(_delegateConfigsKey[:]))
+	switch errors.Cause(err) {
+	case nil:
+		return configs, nil
+	case state.ErrStateNotExist:
+		return nil, nil
+	default:
+		return nil, errors.Wrap(err, "failed to read delegate configs")
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/testutil/testdb"
+)
+
+func TestDelegateConfig(t *testing.T) {
+	r := require.New(t)
+	ctrl := gomock.NewController(t)
+	sm := testdb.NewMockStateManager(ctrl)
+	p := NewProtocol(36, 24, 15)
+
+	c, err := p.DelegateConfig(sm, 10)
+	r.NoError(err)
+	r.Nil(c)
+	num, err := p.NumDelegatesByEpoch(sm, 10)
+	r.NoError(err)
+	r.EqualValues(24, num)
+
+	r.ErrorIs(p.ScheduleDelegateConfig(sm, &DelegateConfig{Epoch: 5, NumDelegates: 30, NumCandidateDelegates: 29}), ErrInvalidDelegateConfig)
+	r.ErrorIs(p.ScheduleDelegateConfig(sm, &DelegateConfig{Epoch: 5, NumDelegates: 0, NumCandidateDelegates: 29}), ErrInvalidDelegateConfig)
+	r.ErrorIs(p.ScheduleDelegateConfig(sm, &DelegateConfig{Epoch: 5, NumDelegates: 30, NumCandidateDelegates: MaxNumCandidateDelegates + 1}), ErrInvalidDelegateConfig)
+	// every delegate needs a block in the epoch of 24 * 15 blocks
+	r.ErrorIs(p.ScheduleDelegateConfig(sm, &DelegateConfig{Epoch: 5, NumDelegates: 361, NumCandidateDelegates: 361}), ErrInvalidDelegateConfig)
+	r.ErrorIs(NewProtocol(36, 24, 15, EnableDardanellesSubEpoch(10, 2)).ValidateDelegateConfig(&DelegateConfig{NumDelegates: 49, NumCandidateDelegates: 49}), ErrInvalidDelegateConfig)
+	r.NoError(p.ValidateDelegateConfig(&DelegateConfig{NumDelegates: 256, NumCandidateDelegates: MaxNumCandidateDelegates}))
+	r.NoError(p.ScheduleDelegateConfig(sm, &DelegateConfig{Epoch: 5, NumDelegates: 30, NumCandidateDelegates: 40}))
+	r.NoError(p.ScheduleDelegateConfig(sm, &DelegateConfig{Epoch: 8, NumDelegates: 12, NumCandidateDelegates: 12}))
+	// replace the config scheduled for the same epoch
+	r.NoError(p.ScheduleDelegateConfig(sm, &DelegateConfig{Epoch: 8, NumDelegates: 16, NumCandidateDelegates: 20, ProbationIntensityRate: 90}))
+	r.ErrorIs(p.ScheduleDelegateConfig(sm, &DelegateConfig{Epoch: 7, NumDelegates: 12, NumCandidateDelegates: 12}), ErrInvalidDelegateConfig)
+
+	for _, v := range []struct {
+		epoch, numDelegates, numCandidateDelegates uint64
+	}{
+		{4, 24, 36},
+		{5, 30, 40},
+		{7, 30, 40},
+		{8, 16, 20},
+		{100, 16, 20},
+	} {
+		num, err := p.NumDelegatesByEpoch(sm, v.epoch)
+		r.NoError(err)
+		r.Equal(v.numDelegates, num)
+		num, err = p.NumCandidateDelegatesByEpoch(sm, v.epoch)
+		r.NoError(err)
+		r.Equal(v.numCandidateDelegates, num)
+	}
+	c, err = p.DelegateConfig(sm, 9)
+	r.NoError(err)
+	r.EqualValues(90, c.ProbationIntensityRate)
+	// the epoch length is not affected
+	r.Equal(NewProtocol(36, 24, 15).GetEpochHeight(9), p.GetEpochHeight(9))
+}
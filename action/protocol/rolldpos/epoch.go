@@ -110,9 +110,17 @@ func (p *Protocol) ReadState(ctx context.Context, sr protocol.StateReader, metho
 	}
 	switch string(method) {
 	case "NumCandidateDelegates":
-		return []byte(strconv.FormatUint(p.numCandidateDelegates, 10)), tipHeight, nil
+		numCandidateDelegates, err := p.NumCandidateDelegatesByEpoch(sr, p.GetEpochNum(tipHeight))
+		if err != nil {
+			return nil, uint64(0), err
+		}
+		return []byte(strconv.FormatUint(numCandidateDelegates, 10)), tipHeight, nil
 	case "NumDelegates":
-		return []byte(strconv.FormatUint(p.numDelegates, 10)), tipHeight, nil
+		numDelegates, err := p.NumDelegatesByEpoch(sr, p.GetEpochNum(tipHeight))
+		if err != nil {
+			return nil, uint64(0), err
+		}
+		return []byte(strconv.FormatUint(numDelegates, 10)), tipHeight, nil
 	case "NumSubEpochs":
 		if len(args) != 1 {
 			return nil, uint64(0), errors.Errorf("invalid number of arguments %d", len(args))
@@ -183,12 +191,12 @@ func (p *Protocol) Name() string {
 	return protocolID
 }
 
-// NumCandidateDelegates returns the number of delegate candidates for an epoch
+// NumCandidateDelegates returns the number of delegate candidates for an epoch defined in genesis
 func (p *Protocol) NumCandidateDelegates() uint64 {
 	return p.numCandidateDelegates
 }
 
-// NumDelegates returns the number of delegates in an epoch
+// NumDelegates returns the number of delegates in an epoch defined in genesis, which also decides the epoch length
 func (p *Protocol) NumDelegates() uint64 {
 	return p.numDelegates
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
+	"github.com/iotexproject/iotex-core/state"
 	"github.com/iotexproject/iotex-core/test/mock/mock_chainmanager"
 )
 
@@ -73,6 +74,7 @@ func TestProtocol_ReadState(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	sm := mock_chainmanager.NewMockStateManager(ctrl)
 	sm.EXPECT().Height().Return(uint64(1), nil).AnyTimes()
+	sm.EXPECT().State(gomock.Any(), gomock.Any()).Return(uint64(0), state.ErrStateNotExist).AnyTimes()
 
 	arg1Num, err := strconv.ParseUint(string(arg1), 10, 64)
 	require.NoError(err)
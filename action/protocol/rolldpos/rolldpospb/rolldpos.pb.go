@@ -0,0 +1,437 @@
+// Copyright (c) 2024 IoTeX
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+// To compile the proto, run:
+//      protoc --go_out=plugins=grpc:. *.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v4.23.3
+// source: action/protocol/rolldpos/rolldpospb/rolldpos.proto
+
+package rolldpospb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DelegateConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch                  uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	NumDelegates           uint64 `protobuf:"varint,2,opt,name=numDelegates,proto3" json:"numDelegates,omitempty"`
+	NumCandidateDelegates  uint64 `protobuf:"varint,3,opt,name=numCandidateDelegates,proto3" json:"numCandidateDelegates,omitempty"`
+	ProductivityThreshold  uint64 `protobuf:"varint,4,opt,name=productivityThreshold,proto3" json:"productivityThreshold,omitempty"`
+	ProbationIntensityRate uint32 `protobuf:"varint,5,opt,name=probationIntensityRate,proto3" json:"probationIntensityRate,omitempty"`
+}
+
+func (x *DelegateConfig) Reset() {
+	*x = DelegateConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelegateConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegateConfig) ProtoMessage() {}
+
+func (x *DelegateConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegateConfig.ProtoReflect.Descriptor instead.
+func (*DelegateConfig) Descriptor() ([]byte, []int) {
+	return file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DelegateConfig) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *DelegateConfig) GetNumDelegates() uint64 {
+	if x != nil {
+		return x.NumDelegates
+	}
+	return 0
+}
+
+func (x *DelegateConfig) GetNumCandidateDelegates() uint64 {
+	if x != nil {
+		return x.NumCandidateDelegates
+	}
+	return 0
+}
+
+func (x *DelegateConfig) GetProductivityThreshold() uint64 {
+	if x != nil {
+		return x.ProductivityThreshold
+	}
+	return 0
+}
+
+func (x *DelegateConfig) GetProbationIntensityRate() uint32 {
+	if x != nil {
+		return x.ProbationIntensityRate
+	}
+	return 0
+}
+
+type DelegateConfigs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Configs []*DelegateConfig `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+}
+
+func (x *DelegateConfigs) Reset() {
+	*x = DelegateConfigs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelegateConfigs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegateConfigs) ProtoMessage() {}
+
+func (x *DelegateConfigs) ProtoReflect() protoreflect.Message {
+	mi := &file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegateConfigs.ProtoReflect.Descriptor instead.
+func (*DelegateConfigs) Descriptor() ([]byte, []int) {
+	return file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DelegateConfigs) GetConfigs() []*DelegateConfig {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type DelegateConfigProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Epoch     uint64          `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Proposer  string          `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Config    *DelegateConfig `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	Approvers []string        `protobuf:"bytes,5,rep,name=approvers,proto3" json:"approvers,omitempty"`
+}
+
+func (x *DelegateConfigProposal) Reset() {
+	*x = DelegateConfigProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelegateConfigProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegateConfigProposal) ProtoMessage() {}
+
+func (x *DelegateConfigProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegateConfigProposal.ProtoReflect.Descriptor instead.
+func (*DelegateConfigProposal) Descriptor() ([]byte, []int) {
+	return file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DelegateConfigProposal) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DelegateConfigProposal) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *DelegateConfigProposal) GetProposer() string {
+	if x != nil {
+		return x.Proposer
+	}
+	return ""
+}
+
+func (x *DelegateConfigProposal) GetConfig() *DelegateConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *DelegateConfigProposal) GetApprovers() []string {
+	if x != nil {
+		return x.Approvers
+	}
+	return nil
+}
+
+type DelegateConfigProposals struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Proposals []*DelegateConfigProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+}
+
+func (x *DelegateConfigProposals) Reset() {
+	*x = DelegateConfigProposals{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelegateConfigProposals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegateConfigProposals) ProtoMessage() {}
+
+func (x *DelegateConfigProposals) ProtoReflect() protoreflect.Message {
+	mi := &file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegateConfigProposals.ProtoReflect.Descriptor instead.
+func (*DelegateConfigProposals) Descriptor() ([]byte, []int) {
+	return file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DelegateConfigProposals) GetProposals() []*DelegateConfigProposal {
+	if x != nil {
+		return x.Proposals
+	}
+	return nil
+}
+
+var File_action_protocol_rolldpos_rolldpospb_rolldpos_proto protoreflect.FileDescriptor
+
+var file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_rawDesc = []byte{
+	0x0a, 0x32, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x64, 0x70, 0x6f, 0x73, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x64,
+	0x70, 0x6f, 0x73, 0x70, 0x62, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x64, 0x70, 0x6f, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x72, 0x6f, 0x6c, 0x6c, 0x64, 0x70, 0x6f, 0x73, 0x70, 0x62,
+	0x22, 0xee, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x75, 0x6d,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x6e, 0x75, 0x6d, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x12, 0x34, 0x0a,
+	0x15, 0x6e, 0x75, 0x6d, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x6e, 0x75,
+	0x6d, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x15, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x70, 0x72, 0x6f,
+	0x62, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x52,
+	0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x70, 0x72, 0x6f, 0x62, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x52, 0x61, 0x74,
+	0x65, 0x22, 0x47, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x6f, 0x6c, 0x6c, 0x64, 0x70, 0x6f, 0x73,
+	0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x16, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x6f, 0x6c, 0x6c, 0x64, 0x70,
+	0x6f, 0x73, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x22, 0x5b, 0x0a, 0x17, 0x44, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x12, 0x40, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x6f, 0x6c, 0x6c, 0x64, 0x70,
+	0x6f, 0x73, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x2f, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x72, 0x6f, 0x6c,
+	0x6c, 0x64, 0x70, 0x6f, 0x73, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x64, 0x70, 0x6f, 0x73, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_rawDescOnce sync.Once
+	file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_rawDescData = file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_rawDesc
+)
+
+func file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_rawDescGZIP() []byte {
+	file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_rawDescOnce.Do(func() {
+		file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_rawDescData = protoimpl.X.CompressGZIP(file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_rawDescData)
+	})
+	return file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_rawDescData
+}
+
+var file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_goTypes = []interface{}{
+	(*DelegateConfig)(nil),          // 0: rolldpospb.DelegateConfig
+	(*DelegateConfigs)(nil),         // 1: rolldpospb.DelegateConfigs
+	(*DelegateConfigProposal)(nil),  // 2: rolldpospb.DelegateConfigProposal
+	(*DelegateConfigProposals)(nil), // 3: rolldpospb.DelegateConfigProposals
+}
+var file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_depIdxs = []int32{
+	0, // 0: rolldpospb.DelegateConfigs.configs:type_name -> rolldpospb.DelegateConfig
+	0, // 1: rolldpospb.DelegateConfigProposal.config:type_name -> rolldpospb.DelegateConfig
+	2, // 2: rolldpospb.DelegateConfigProposals.proposals:type_name -> rolldpospb.DelegateConfigProposal
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_init() }
+func file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_init() {
+	if File_action_protocol_rolldpos_rolldpospb_rolldpos_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelegateConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelegateConfigs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelegateConfigProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelegateConfigProposals); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_goTypes,
+		DependencyIndexes: file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_depIdxs,
+		MessageInfos:      file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_msgTypes,
+	}.Build()
+	File_action_protocol_rolldpos_rolldpospb_rolldpos_proto = out.File
+	file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_rawDesc = nil
+	file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_goTypes = nil
+	file_action_protocol_rolldpos_rolldpospb_rolldpos_proto_depIdxs = nil
+}
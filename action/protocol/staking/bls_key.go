@@ -0,0 +1,145 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/crypto/bls"
+)
+
+const _blsKeyABI = `[
+	{
+		"inputs": [
+			{"internalType": "bytes", "name": "publicKey", "type": "bytes"},
+			{"internalType": "bytes", "name": "proofOfPossession", "type": "bytes"}
+		],
+		"name": "candidateUpdateBLSPublicKey",
+		"outputs": [],
+		"stateMutability": "nonpayable",
+		"type": "function"
+	}
+]`
+
+var (
+	// ErrInvalidBLSKeyUpdate indicates the BLS public key update is invalid
+	ErrInvalidBLSKeyUpdate = errors.New("invalid BLS public key update")
+
+	_candidateUpdateBLSKeyMethod abi.Method
+)
+
+func init() {
+	blsKeyInterface, err := abi.JSON(strings.NewReader(_blsKeyABI))
+	if err != nil {
+		panic(err)
+	}
+	var ok bool
+	_candidateUpdateBLSKeyMethod, ok = blsKeyInterface.Methods["candidateUpdateBLSPublicKey"]
+	if !ok {
+		panic("fail to load the candidateUpdateBLSPublicKey method")
+	}
+}
+
+// BLSKeyUpdate registers the BLS public key of the candidate owned by the sender. It is sent as
+// an execution to the staking protocol, since the candidate update action has no field for it.
+type BLSKeyUpdate struct {
+	pubKey []byte
+	proof  []byte
+}
+
+// NewBLSKeyUpdate creates a BLS public key update with the proof of possession of the key
+func NewBLSKeyUpdate(sk *bls.PrivateKey) (*BLSKeyUpdate, error) {
+	proof, err := sk.ProvePossession()
+	if err != nil {
+		return nil, err
+	}
+	return &BLSKeyUpdate{pubKey: sk.PublicKey().Bytes(), proof: proof}, nil
+}
+
+// NewBLSKeyUpdateFromABIBinary decodes the update from the call data to the staking protocol
+func NewBLSKeyUpdateFromABIBinary(data []byte) (*BLSKeyUpdate, error) {
+	if !IsBLSKeyUpdate(data) {
+		return nil, ErrInvalidBLSKeyUpdate
+	}
+	paramsMap := map[string]any{}
+	if err := _candidateUpdateBLSKeyMethod.Inputs.UnpackIntoMap(paramsMap, data[4:]); err != nil {
+		return nil, errors.Wrap(ErrInvalidBLSKeyUpdate, err.Error())
+	}
+	pubKey, ok := paramsMap["publicKey"].([]byte)
+	if !ok {
+		return nil, ErrInvalidBLSKeyUpdate
+	}
+	proof, ok := paramsMap["proofOfPossession"].([]byte)
+	if !ok {
+		return nil, ErrInvalidBLSKeyUpdate
+	}
+	return &BLSKeyUpdate{pubKey: pubKey, proof: proof}, nil
+}
+
+// IsBLSKeyUpdate returns true if the call data is to update the BLS public key
+func IsBLSKeyUpdate(data []byte) bool {
+	return len(data) >= 4 && bytes.Equal(_candidateUpdateBLSKeyMethod.ID, data[:4])
+}
+
+// EncodeABIBinary encodes the update into the call data to the staking protocol
+func (u *BLSKeyUpdate) EncodeABIBinary() ([]byte, error) {
+	data, err := _candidateUpdateBLSKeyMethod.Inputs.Pack(u.pubKey, u.proof)
+	if err != nil {
+		return nil, err
+	}
+	return append(_candidateUpdateBLSKeyMethod.ID, data...), nil
+}
+
+// PublicKey verifies the proof of possession and returns the BLS public key
+func (u *BLSKeyUpdate) PublicKey() (*bls.PublicKey, error) {
+	pk, err := bls.PublicKeyFromBytes(u.pubKey)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidBLSKeyUpdate, err.Error())
+	}
+	if !pk.VerifyPossession(u.proof) {
+		return nil, errors.Wrap(ErrInvalidBLSKeyUpdate, "failed to verify the proof of possession")
+	}
+	return pk, nil
+}
+
+// DelegateBLSPublicKeys returns the BLS public keys of the candidates for the delegates in the same order, with nil for
+// the delegates without one, as of the start of the epoch. A key is used by the operator it is bound
+// to, from the epoch after the one it is registered in, unless another candidate has taken the operator.
+func DelegateBLSPublicKeys(cands CandidateList, delegates []string, epochStartHeight uint64) ([]*bls.PublicKey, error) {
+	var (
+		operators = make(map[string]string, len(cands))
+		keys      = make(map[string][]byte)
+	)
+	for _, cand := range cands {
+		operators[cand.Operator.String()] = cand.Owner.String()
+	}
+	for _, cand := range cands {
+		if len(cand.BLSPubKey) == 0 || cand.BLSKeyOperator == nil || cand.BLSKeyHeight >= epochStartHeight {
+			continue
+		}
+		operator := cand.BLSKeyOperator.String()
+		if owner, ok := operators[operator]; ok && owner != cand.Owner.String() {
+			continue
+		}
+		keys[operator] = cand.BLSPubKey
+	}
+	pubKeys := make([]*bls.PublicKey, len(delegates))
+	for i, addr := range delegates {
+		key, ok := keys[addr]
+		if !ok {
+			continue
+		}
+		var err error
+		if pubKeys[i], err = bls.PublicKeyFromBytes(key); err != nil {
+			return nil, err
+		}
+	}
+	return pubKeys, nil
+}
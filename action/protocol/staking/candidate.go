@@ -6,6 +6,7 @@
 package staking
 
 import (
+	"bytes"
 	"math/big"
 	"sort"
 	"strings"
@@ -30,6 +31,11 @@ type (
 		Votes              *big.Int
 		SelfStakeBucketIdx uint64
 		SelfStake          *big.Int
+		// BLSPubKey is the optional BLS public key to aggregate the commit endorsements. The key is
+		// bound to BLSKeyOperator, and is used from the epoch after BLSKeyHeight it is registered at
+		BLSPubKey      []byte
+		BLSKeyOperator address.Address
+		BLSKeyHeight   uint64
 	}
 
 	// CandidateList is a list of candidates which is sortable
@@ -52,6 +58,9 @@ func (d *Candidate) Clone() *Candidate {
 		Votes:              new(big.Int).Set(d.Votes),
 		SelfStakeBucketIdx: d.SelfStakeBucketIdx,
 		SelfStake:          new(big.Int).Set(d.SelfStake),
+		BLSPubKey:          bytes.Clone(d.BLSPubKey),
+		BLSKeyOperator:     d.BLSKeyOperator,
+		BLSKeyHeight:       d.BLSKeyHeight,
 	}
 }
 
@@ -63,7 +72,10 @@ func (d *Candidate) Equal(c *Candidate) bool {
 		address.Equal(d.Operator, c.Operator) &&
 		address.Equal(d.Reward, c.Reward) &&
 		d.Votes.Cmp(c.Votes) == 0 &&
-		d.SelfStake.Cmp(c.SelfStake) == 0
+		d.SelfStake.Cmp(c.SelfStake) == 0 &&
+		bytes.Equal(d.BLSPubKey, c.BLSPubKey) &&
+		address.Equal(d.BLSKeyOperator, c.BLSKeyOperator) &&
+		d.BLSKeyHeight == c.BLSKeyHeight
 }
 
 // Validate does the sanity check
@@ -184,7 +196,7 @@ func (d *Candidate) toProto() (*stakingpb.Candidate, error) {
 		return nil, ErrMissingField
 	}
 
-	return &stakingpb.Candidate{
+	pb := &stakingpb.Candidate{
 		OwnerAddress:       d.Owner.String(),
 		OperatorAddress:    d.Operator.String(),
 		RewardAddress:      d.Reward.String(),
@@ -192,7 +204,13 @@ func (d *Candidate) toProto() (*stakingpb.Candidate, error) {
 		Votes:              d.Votes.String(),
 		SelfStakeBucketIdx: d.SelfStakeBucketIdx,
 		SelfStake:          d.SelfStake.String(),
-	}, nil
+		BlsPubKey:          d.BLSPubKey,
+		BlsKeyHeight:       d.BLSKeyHeight,
+	}
+	if d.BLSKeyOperator != nil {
+		pb.BlsKeyOperator = d.BLSKeyOperator.String()
+	}
+	return pb, nil
 }
 
 func (d *Candidate) fromProto(pb *stakingpb.Candidate) error {
@@ -228,6 +246,14 @@ func (d *Candidate) fromProto(pb *stakingpb.Candidate) error {
 	if !ok {
 		return action.ErrInvalidAmount
 	}
+	d.BLSPubKey = bytes.Clone(pb.GetBlsPubKey())
+	d.BLSKeyOperator = nil
+	if len(pb.GetBlsKeyOperator()) != 0 {
+		if d.BLSKeyOperator, err = address.FromString(pb.GetBlsKeyOperator()); err != nil {
+			return err
+		}
+	}
+	d.BLSKeyHeight = pb.GetBlsKeyHeight()
 	return nil
 }
 
@@ -0,0 +1,167 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	blake2b "github.com/minio/blake2b-simd"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/endorsement"
+)
+
+const _evidenceABI = `[
+	{
+		"inputs": [
+			{"internalType": "bytes", "name": "message1", "type": "bytes"},
+			{"internalType": "bytes", "name": "message2", "type": "bytes"}
+		],
+		"name": "reportDoubleSign",
+		"outputs": [],
+		"stateMutability": "nonpayable",
+		"type": "function"
+	}
+]`
+
+var (
+	// ErrInvalidEvidence indicates the double-sign evidence is invalid
+	ErrInvalidEvidence = errors.New("invalid double-sign evidence")
+
+	_reportDoubleSignMethod abi.Method
+)
+
+func init() {
+	evidenceInterface, err := abi.JSON(strings.NewReader(_evidenceABI))
+	if err != nil {
+		panic(err)
+	}
+	var ok bool
+	_reportDoubleSignMethod, ok = evidenceInterface.Methods["reportDoubleSign"]
+	if !ok {
+		panic("fail to load the reportDoubleSign method")
+	}
+}
+
+type (
+	// DoubleSignEvidence is the evidence of a delegate endorsing two different blocks
+	// on the same topic at the same height and round
+	DoubleSignEvidence struct {
+		msg1, msg2 *iotextypes.ConsensusMessage
+	}
+
+	consensusVoteDoc struct {
+		vote *iotextypes.ConsensusVote
+	}
+)
+
+// NewDoubleSignEvidence creates a double-sign evidence from two consensus messages
+func NewDoubleSignEvidence(msg1, msg2 *iotextypes.ConsensusMessage) *DoubleSignEvidence {
+	return &DoubleSignEvidence{msg1: msg1, msg2: msg2}
+}
+
+// NewDoubleSignEvidenceFromABIBinary decodes the evidence from the call data to the staking protocol
+func NewDoubleSignEvidenceFromABIBinary(data []byte) (*DoubleSignEvidence, error) {
+	if len(data) <= 4 || !bytes.Equal(_reportDoubleSignMethod.ID, data[:4]) {
+		return nil, ErrInvalidEvidence
+	}
+	paramsMap := map[string]any{}
+	if err := _reportDoubleSignMethod.Inputs.UnpackIntoMap(paramsMap, data[4:]); err != nil {
+		return nil, errors.Wrap(ErrInvalidEvidence, err.Error())
+	}
+	msgs := make([]*iotextypes.ConsensusMessage, 2)
+	for i, name := range []string{"message1", "message2"} {
+		b, ok := paramsMap[name].([]byte)
+		if !ok {
+			return nil, ErrInvalidEvidence
+		}
+		msgs[i] = &iotextypes.ConsensusMessage{}
+		if err := proto.Unmarshal(b, msgs[i]); err != nil {
+			return nil, errors.Wrap(ErrInvalidEvidence, err.Error())
+		}
+	}
+	return NewDoubleSignEvidence(msgs[0], msgs[1]), nil
+}
+
+// IsDoubleSignEvidence returns true if the call data is to report a double-sign evidence
+func IsDoubleSignEvidence(data []byte) bool {
+	return len(data) >= 4 && bytes.Equal(_reportDoubleSignMethod.ID, data[:4])
+}
+
+// EncodeABIBinary encodes the evidence into the call data to the staking protocol
+func (e *DoubleSignEvidence) EncodeABIBinary() ([]byte, error) {
+	msg1, err := proto.Marshal(e.msg1)
+	if err != nil {
+		return nil, err
+	}
+	msg2, err := proto.Marshal(e.msg2)
+	if err != nil {
+		return nil, err
+	}
+	data, err := _reportDoubleSignMethod.Inputs.Pack(msg1, msg2)
+	if err != nil {
+		return nil, err
+	}
+	return append(_reportDoubleSignMethod.ID, data...), nil
+}
+
+// Height returns the consensus height of the evidence
+func (e *DoubleSignEvidence) Height() uint64 {
+	return e.msg1.GetHeight()
+}
+
+// Offender verifies the evidence and returns the operator address of the delegate who double-signed
+func (e *DoubleSignEvidence) Offender() (address.Address, error) {
+	var (
+		votes = make([]*iotextypes.ConsensusVote, 2)
+		ens   = make([]*endorsement.Endorsement, 2)
+	)
+	for i, msg := range []*iotextypes.ConsensusMessage{e.msg1, e.msg2} {
+		if msg == nil || msg.GetVote() == nil || msg.GetEndorsement() == nil {
+			return nil, errors.Wrap(ErrInvalidEvidence, "consensus vote is expected")
+		}
+		votes[i] = msg.GetVote()
+		ens[i] = &endorsement.Endorsement{}
+		if err := ens[i].LoadProto(msg.GetEndorsement()); err != nil {
+			return nil, errors.Wrap(ErrInvalidEvidence, err.Error())
+		}
+		if !endorsement.VerifyEndorsement(&consensusVoteDoc{votes[i]}, ens[i]) {
+			return nil, errors.Wrap(ErrInvalidEvidence, "failed to verify the endorsement")
+		}
+	}
+	switch {
+	case e.msg1.GetHeight() != e.msg2.GetHeight():
+		return nil, errors.Wrap(ErrInvalidEvidence, "different heights")
+	case votes[0].GetTopic() != votes[1].GetTopic():
+		return nil, errors.Wrap(ErrInvalidEvidence, "different topics")
+	case !ens[0].Timestamp().Equal(ens[1].Timestamp()):
+		// the endorsement timestamp of a topic is determined by the round
+		return nil, errors.Wrap(ErrInvalidEvidence, "different rounds")
+	case !bytes.Equal(ens[0].Endorser().Bytes(), ens[1].Endorser().Bytes()):
+		return nil, errors.Wrap(ErrInvalidEvidence, "different endorsers")
+	case bytes.Equal(votes[0].GetBlockHash(), votes[1].GetBlockHash()):
+		return nil, errors.Wrap(ErrInvalidEvidence, "same block endorsed")
+	}
+	offender := ens[0].Endorser().Address()
+	if offender == nil {
+		return nil, errors.Wrap(ErrInvalidEvidence, "invalid endorser")
+	}
+	return offender, nil
+}
+
+func (doc *consensusVoteDoc) Hash() ([]byte, error) {
+	ser, err := proto.Marshal(doc.vote)
+	if err != nil {
+		return nil, err
+	}
+	h := blake2b.Sum256(ser)
+	return h[:], nil
+}
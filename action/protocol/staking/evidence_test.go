@@ -0,0 +1,83 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func newTestConsensusVoteMessage(
+	t *testing.T,
+	sk crypto.PrivateKey,
+	height uint64,
+	topic iotextypes.ConsensusVote_Topic,
+	ts time.Time,
+	blkHash []byte,
+) *iotextypes.ConsensusMessage {
+	vote := &iotextypes.ConsensusVote{BlockHash: blkHash, Topic: topic}
+	en, err := endorsement.Endorse(sk, &consensusVoteDoc{vote}, ts)
+	require.NoError(t, err)
+	enPb, err := en.Proto()
+	require.NoError(t, err)
+	return &iotextypes.ConsensusMessage{
+		Height:      height,
+		Endorsement: enPb,
+		Msg:         &iotextypes.ConsensusMessage_Vote{Vote: vote},
+	}
+}
+
+func TestDoubleSignEvidence(t *testing.T) {
+	r := require.New(t)
+	var (
+		sk      = identityset.PrivateKey(7)
+		ts      = time.Unix(1700000000, 0)
+		blkHash = []byte("block1")
+		msg     = newTestConsensusVoteMessage(t, sk, 10, iotextypes.ConsensusVote_LOCK, ts, blkHash)
+	)
+
+	// encode and decode
+	e := NewDoubleSignEvidence(msg, newTestConsensusVoteMessage(t, sk, 10, iotextypes.ConsensusVote_LOCK, ts, []byte("block2")))
+	data, err := e.EncodeABIBinary()
+	r.NoError(err)
+	r.True(IsDoubleSignEvidence(data))
+	r.False(IsDoubleSignEvidence(data[:3]))
+	decoded, err := NewDoubleSignEvidenceFromABIBinary(data)
+	r.NoError(err)
+	r.Equal(uint64(10), decoded.Height())
+	offender, err := decoded.Offender()
+	r.NoError(err)
+	r.Equal(identityset.Address(7).String(), offender.String())
+	_, err = NewDoubleSignEvidenceFromABIBinary(data[:len(data)-1])
+	r.ErrorIs(err, ErrInvalidEvidence)
+
+	tampered := newTestConsensusVoteMessage(t, sk, 10, iotextypes.ConsensusVote_LOCK, ts, []byte("block2"))
+	tampered.GetVote().BlockHash = []byte("block3")
+	for _, c := range []struct {
+		name string
+		msg  *iotextypes.ConsensusMessage
+	}{
+		{"same block", newTestConsensusVoteMessage(t, sk, 10, iotextypes.ConsensusVote_LOCK, ts, blkHash)},
+		{"different height", newTestConsensusVoteMessage(t, sk, 11, iotextypes.ConsensusVote_LOCK, ts, []byte("block2"))},
+		{"different topic", newTestConsensusVoteMessage(t, sk, 10, iotextypes.ConsensusVote_COMMIT, ts, []byte("block2"))},
+		{"different round", newTestConsensusVoteMessage(t, sk, 10, iotextypes.ConsensusVote_LOCK, ts.Add(time.Second), []byte("block2"))},
+		{"different endorser", newTestConsensusVoteMessage(t, identityset.PrivateKey(8), 10, iotextypes.ConsensusVote_LOCK, ts, []byte("block2"))},
+		{"invalid signature", tampered},
+		{"not a vote", &iotextypes.ConsensusMessage{Height: 10, Endorsement: msg.Endorsement}},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := NewDoubleSignEvidence(msg, c.msg).Offender()
+			require.ErrorIs(t, err, ErrInvalidEvidence)
+		})
+	}
+}
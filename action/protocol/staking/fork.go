@@ -0,0 +1,252 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/staking/stakingpb"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+// _forkPageSize is the number of candidates or buckets read from the remote chain in a request
+const _forkPageSize = 1000
+
+var _ protocol.StateForker = (*Protocol)(nil)
+
+// CreateForkedGenesisStates creates the candidates, the bucket count and the bucket pool read from the remote
+// chain, which are loaded into the view at startup. The votes of the candidates include the ones of the contract
+// staking buckets, and their BLS keys are not forked, since the remote chain does not return them
+func (p *Protocol) CreateForkedGenesisStates(ctx context.Context, sm protocol.StateManager, fsr protocol.ForkStateReader) error {
+	csm, err := NewCandidateStateManager(sm, false)
+	if err != nil {
+		return err
+	}
+	for offset := uint32(0); ; offset += _forkPageSize {
+		var list iotextypes.CandidateListV2
+		if err := readForkedStakingData(fsr, iotexapi.ReadStakingDataMethod_CANDIDATES, &iotexapi.ReadStakingDataRequest{
+			Request: &iotexapi.ReadStakingDataRequest_Candidates_{
+				Candidates: &iotexapi.ReadStakingDataRequest_Candidates{
+					Pagination: &iotexapi.PaginationParam{Offset: offset, Limit: _forkPageSize},
+				},
+			},
+		}, &list); err != nil {
+			return err
+		}
+		for _, pb := range list.GetCandidates() {
+			c := &Candidate{}
+			if err := c.loadIoTeXTypes(pb); err != nil {
+				return errors.Wrapf(err, "invalid forked candidate %s", pb.GetName())
+			}
+			if err := csm.Upsert(c); err != nil {
+				return err
+			}
+		}
+		if len(list.GetCandidates()) < _forkPageSize {
+			break
+		}
+	}
+
+	var count iotextypes.BucketsCount
+	if err := readForkedStakingData(fsr, iotexapi.ReadStakingDataMethod_BUCKETS_COUNT, &iotexapi.ReadStakingDataRequest{
+		Request: &iotexapi.ReadStakingDataRequest_BucketsCount_{
+			BucketsCount: &iotexapi.ReadStakingDataRequest_BucketsCount{},
+		},
+	}, &count); err != nil {
+		return err
+	}
+	if _, err := sm.PutState(&totalBucketCount{count: count.GetTotal()}, protocol.NamespaceOption(_stakingNameSpace), protocol.KeyOption(TotalBucketKey)); err != nil {
+		return err
+	}
+	var pool iotextypes.AccountMeta
+	if err := readForkedStakingData(fsr, iotexapi.ReadStakingDataMethod_TOTAL_STAKING_AMOUNT, &iotexapi.ReadStakingDataRequest{
+		Request: &iotexapi.ReadStakingDataRequest_TotalStakingAmount_{
+			TotalStakingAmount: &iotexapi.ReadStakingDataRequest_TotalStakingAmount{},
+		},
+	}, &pool); err != nil {
+		return err
+	}
+	amount, ok := new(big.Int).SetString(pool.GetBalance(), 10)
+	if !ok {
+		return errors.Wrapf(action.ErrInvalidAmount, "invalid forked total staking amount %s", pool.GetBalance())
+	}
+	if _, err := sm.PutState(&totalAmount{amount: amount, count: count.GetActive()}, protocol.NamespaceOption(_stakingNameSpace), protocol.KeyOption(_bucketPoolAddrKey)); err != nil {
+		return err
+	}
+	return errors.Wrap(csm.Commit(ctx), "failed to commit candidate change in CreateForkedGenesisStates")
+}
+
+// ForkState forks the buckets, and the bucket indices of the voters and the candidates. The endorsements and the
+// jail records are not forked
+func (p *Protocol) ForkState(fsr protocol.ForkStateReader, ns string, key []byte) ([]byte, error) {
+	if ns != _stakingNameSpace || len(key) == 0 {
+		return nil, state.ErrStateNotExist
+	}
+	switch key[0] {
+	case _bucket:
+		if len(key) != 9 {
+			return nil, state.ErrStateNotExist
+		}
+		buckets, err := readForkedBuckets(fsr, iotexapi.ReadStakingDataMethod_BUCKETS_BY_INDEXES, &iotexapi.ReadStakingDataRequest{
+			Request: &iotexapi.ReadStakingDataRequest_BucketsByIndexes{
+				BucketsByIndexes: &iotexapi.ReadStakingDataRequest_VoteBucketsByIndexes{
+					Index: []uint64{byteutil.BytesToUint64BigEndian(key[1:])},
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(buckets) == 0 {
+			return nil, state.ErrStateNotExist
+		}
+		return buckets[0].Serialize()
+	case _voterIndex, _candIndex:
+		addr, err := address.FromBytes(key[1:])
+		if err != nil {
+			return nil, state.ErrStateNotExist
+		}
+		indices, err := readForkedBucketIndices(fsr, key[0], addr)
+		if err != nil {
+			return nil, err
+		}
+		if len(indices) == 0 {
+			return nil, state.ErrStateNotExist
+		}
+		return indices.Serialize()
+	default:
+		return nil, state.ErrStateNotExist
+	}
+}
+
+// readForkedBucketIndices reads the indices of the buckets of a voter, or of the buckets voting for a candidate
+// by its owner
+func readForkedBucketIndices(fsr protocol.ForkStateReader, prefix byte, addr address.Address) (BucketIndices, error) {
+	var name string
+	if prefix == _candIndex {
+		var cand iotextypes.CandidateV2
+		if err := readForkedStakingData(fsr, iotexapi.ReadStakingDataMethod_CANDIDATE_BY_ADDRESS, &iotexapi.ReadStakingDataRequest{
+			Request: &iotexapi.ReadStakingDataRequest_CandidateByAddress_{
+				CandidateByAddress: &iotexapi.ReadStakingDataRequest_CandidateByAddress{
+					OwnerAddr: addr.String(),
+				},
+			},
+		}, &cand); err != nil {
+			return nil, err
+		}
+		if len(cand.GetName()) == 0 {
+			return nil, nil
+		}
+		name = cand.GetName()
+	}
+	var indices BucketIndices
+	for offset := uint32(0); ; offset += _forkPageSize {
+		pagination := &iotexapi.PaginationParam{Offset: offset, Limit: _forkPageSize}
+		method := iotexapi.ReadStakingDataMethod_BUCKETS_BY_VOTER
+		req := &iotexapi.ReadStakingDataRequest{
+			Request: &iotexapi.ReadStakingDataRequest_BucketsByVoter{
+				BucketsByVoter: &iotexapi.ReadStakingDataRequest_VoteBucketsByVoter{
+					VoterAddress: addr.String(),
+					Pagination:   pagination,
+				},
+			},
+		}
+		if prefix == _candIndex {
+			method = iotexapi.ReadStakingDataMethod_BUCKETS_BY_CANDIDATE
+			req = &iotexapi.ReadStakingDataRequest{
+				Request: &iotexapi.ReadStakingDataRequest_BucketsByCandidate{
+					BucketsByCandidate: &iotexapi.ReadStakingDataRequest_VoteBucketsByCandidate{
+						CandName:   name,
+						Pagination: pagination,
+					},
+				},
+			}
+		}
+		buckets, err := readForkedBuckets(fsr, method, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range buckets {
+			indices.addBucketIndex(b.Index)
+		}
+		if len(buckets) < _forkPageSize {
+			return indices, nil
+		}
+	}
+}
+
+func readForkedBuckets(fsr protocol.ForkStateReader, method iotexapi.ReadStakingDataMethod_Name, req *iotexapi.ReadStakingDataRequest) ([]*VoteBucket, error) {
+	var list iotextypes.VoteBucketList
+	if err := readForkedStakingData(fsr, method, req, &list); err != nil {
+		return nil, err
+	}
+	buckets := make([]*VoteBucket, 0, len(list.GetBuckets()))
+	for _, pb := range list.GetBuckets() {
+		b := &VoteBucket{}
+		if err := b.loadIoTeXTypes(pb); err != nil {
+			return nil, errors.Wrapf(err, "invalid forked bucket %d", pb.GetIndex())
+		}
+		buckets = append(buckets, b)
+	}
+	return buckets, nil
+}
+
+func readForkedStakingData(fsr protocol.ForkStateReader, method iotexapi.ReadStakingDataMethod_Name, req *iotexapi.ReadStakingDataRequest, resp proto.Message) error {
+	m, err := proto.Marshal(&iotexapi.ReadStakingDataMethod{Method: method})
+	if err != nil {
+		return err
+	}
+	arg, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	data, err := fsr.ReadState(_protocolID, m, arg)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read %s from the fork", method.String())
+	}
+	return proto.Unmarshal(data, resp)
+}
+
+func (d *Candidate) loadIoTeXTypes(pb *iotextypes.CandidateV2) error {
+	return d.fromProto(&stakingpb.Candidate{
+		OwnerAddress:       pb.GetOwnerAddress(),
+		OperatorAddress:    pb.GetOperatorAddress(),
+		RewardAddress:      pb.GetRewardAddress(),
+		Name:               pb.GetName(),
+		Votes:              pb.GetTotalWeightedVotes(),
+		SelfStakeBucketIdx: pb.GetSelfStakeBucketIdx(),
+		SelfStake:          pb.GetSelfStakingTokens(),
+	})
+}
+
+func (vb *VoteBucket) loadIoTeXTypes(pb *iotextypes.VoteBucket) error {
+	return vb.fromProto(&stakingpb.Bucket{
+		Index:                     pb.GetIndex(),
+		CandidateAddress:          pb.GetCandidateAddress(),
+		Owner:                     pb.GetOwner(),
+		StakedAmount:              pb.GetStakedAmount(),
+		StakedDuration:            pb.GetStakedDuration(),
+		CreateTime:                pb.GetCreateTime(),
+		StakeStartTime:            pb.GetStakeStartTime(),
+		UnstakeStartTime:          pb.GetUnstakeStartTime(),
+		AutoStake:                 pb.GetAutoStake(),
+		ContractAddress:           pb.GetContractAddress(),
+		StakedDurationBlockNumber: pb.GetStakedDurationBlockNumber(),
+		CreateBlockHeight:         pb.GetCreateBlockHeight(),
+		StakeStartBlockHeight:     pb.GetStakeStartBlockHeight(),
+		UnstakeStartBlockHeight:   pb.GetUnstakeStartBlockHeight(),
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+)
+
+const (
+	handleCandidateUpdateBLSKey = "candidateUpdateBLSPublicKey"
+)
+
+var errBLSKeyRegistered = &handleError{
+	err:           errors.New("BLS public key is already registered"),
+	failureStatus: iotextypes.ReceiptStatus_Failure,
+}
+
+func (p *Protocol) isBLSKeyUpdate(ctx context.Context, act *action.Execution) bool {
+	return protocol.MustGetFeatureCtx(ctx).EnableBLSEndorsement && act.Contract() == p.addr.String() && IsBLSKeyUpdate(act.Data())
+}
+
+func (p *Protocol) handleCandidateUpdateBLSKey(ctx context.Context, act *action.Execution, csm CandidateStateManager,
+) (*receiptLog, error) {
+	actCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	featureCtx := protocol.MustGetFeatureCtx(ctx)
+	log := newReceiptLog(p.addr.String(), handleCandidateUpdateBLSKey, featureCtx.NewStakingReceiptFormat)
+
+	_, fetchErr := fetchCaller(ctx, csm, big.NewInt(0))
+	if fetchErr != nil {
+		return log, fetchErr
+	}
+	update, err := NewBLSKeyUpdateFromABIBinary(act.Data())
+	if err != nil {
+		return log, &handleError{
+			err:           err,
+			failureStatus: iotextypes.ReceiptStatus_Failure,
+		}
+	}
+	pk, err := update.PublicKey()
+	if err != nil {
+		return log, &handleError{
+			err:           err,
+			failureStatus: iotextypes.ReceiptStatus_Failure,
+		}
+	}
+
+	// only owner can update candidate
+	c := csm.GetByOwner(actCtx.Caller)
+	if c == nil {
+		return log, errCandNotExist
+	}
+	log.AddTopics(c.Owner.Bytes())
+	// the key of the operator cannot be changed, so that the footers of past blocks remain verifiable.
+	// A new key is registered after the operator changes, since the key belongs to the operator node.
+	if len(c.BLSPubKey) != 0 && address.Equal(c.BLSKeyOperator, c.Operator) {
+		return log, errBLSKeyRegistered
+	}
+	c.BLSPubKey = pk.Bytes()
+	c.BLSKeyOperator = c.Operator
+	c.BLSKeyHeight = blkCtx.BlockHeight
+	if err := csm.Upsert(c); err != nil {
+		return log, csmErrorToHandleError(c.Owner.String(), err)
+	}
+
+	log.AddAddress(actCtx.Caller)
+	return log, nil
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/mohae/deepcopy"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/crypto/bls"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestProtocol_HandleCandidateUpdateBLSKey(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	sm, p, _, _ := initTestState(t, ctrl, []*bucketConfig{
+		{identityset.Address(1), identityset.Address(1), "1200000000000000000000000", 30, true, true, nil, 0},
+	}, []*candidateConfig{
+		{identityset.Address(1), identityset.Address(7), identityset.Address(1), "test1"},
+	})
+	require.NoError(setupAccount(sm, identityset.Address(1), 1000))
+	require.NoError(setupAccount(sm, identityset.Address(2), 1000))
+
+	sk, err := bls.GenerateKey()
+	require.NoError(err)
+	update, err := NewBLSKeyUpdate(sk)
+	require.NoError(err)
+	data, err := update.EncodeABIBinary()
+	require.NoError(err)
+
+	cfg := deepcopy.Copy(genesis.Default).(genesis.Genesis)
+	cfg.TsunamiBlockHeight = 1
+	cfg.ToBeEnabledBlockHeight = 1
+	newCtx := func(caller address.Address, act *action.Execution) context.Context {
+		intrinsicGas, err := act.IntrinsicGas()
+		require.NoError(err)
+		ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{
+			Caller:       caller,
+			GasPrice:     act.GasPrice(),
+			IntrinsicGas: intrinsicGas,
+			Nonce:        act.Nonce(),
+		})
+		ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+			BlockHeight:    11,
+			BlockTimeStamp: timeBlock,
+			GasLimit:       1000000,
+		})
+		ctx = genesis.WithGenesisContext(ctx, cfg)
+		return protocol.WithFeatureCtx(protocol.WithFeatureWithHeightCtx(ctx))
+	}
+
+	// invalid proof of possession
+	other, err := bls.GenerateKey()
+	require.NoError(err)
+	invalid := &BLSKeyUpdate{pubKey: other.PublicKey().Bytes(), proof: update.proof}
+	invalidData, err := invalid.EncodeABIBinary()
+	require.NoError(err)
+	act, err := action.NewExecution(p.addr.String(), 1, big.NewInt(0), 1000000, big.NewInt(1000), invalidData)
+	require.NoError(err)
+	require.ErrorIs(p.Validate(newCtx(identityset.Address(1), act), act, sm), ErrInvalidBLSKeyUpdate)
+
+	// only the owner of a candidate can update
+	act, err = action.NewExecution(p.addr.String(), 1, big.NewInt(0), 1000000, big.NewInt(1000), data)
+	require.NoError(err)
+	ctx := newCtx(identityset.Address(2), act)
+	require.NoError(p.Validate(ctx, act, sm))
+	r, err := p.Handle(ctx, act, sm)
+	require.NoError(err)
+	require.Equal(uint64(iotextypes.ReceiptStatus_ErrCandidateNotExist), r.Status)
+
+	ctx = newCtx(identityset.Address(1), act)
+	r, err = p.Handle(ctx, act, sm)
+	require.NoError(err)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), r.Status)
+	csm, err := NewCandidateStateManager(sm, false)
+	require.NoError(err)
+	cand := csm.GetByOwner(identityset.Address(1))
+	require.Equal(sk.PublicKey().Bytes(), cand.BLSPubKey)
+	require.Equal(identityset.Address(7).String(), cand.BLSKeyOperator.String())
+	require.Equal(uint64(11), cand.BLSKeyHeight)
+	// the key is used from the next epoch
+	operators := []string{identityset.Address(7).String(), identityset.Address(8).String()}
+	pubKeys, err := DelegateBLSPublicKeys(csm.DirtyView().candCenter.All(), operators, 11)
+	require.NoError(err)
+	require.Equal([]*bls.PublicKey{nil, nil}, pubKeys)
+	pubKeys, err = DelegateBLSPublicKeys(csm.DirtyView().candCenter.All(), operators, 12)
+	require.NoError(err)
+	require.Equal(sk.PublicKey().Bytes(), pubKeys[0].Bytes())
+	require.Nil(pubKeys[1])
+
+	// the key cannot be changed
+	update, err = NewBLSKeyUpdate(other)
+	require.NoError(err)
+	data, err = update.EncodeABIBinary()
+	require.NoError(err)
+	act, err = action.NewExecution(p.addr.String(), 2, big.NewInt(0), 1000000, big.NewInt(1000), data)
+	require.NoError(err)
+	r, err = p.Handle(newCtx(identityset.Address(1), act), act, sm)
+	require.NoError(err)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Failure), r.Status)
+
+	// the key of the previous operator is not used by the new operator, which registers its own key
+	cand.Operator = identityset.Address(8)
+	require.NoError(csm.Upsert(cand))
+	require.NoError(csm.Commit(context.Background()))
+	pubKeys, err = DelegateBLSPublicKeys(csm.DirtyView().candCenter.All(), operators, 12)
+	require.NoError(err)
+	require.Equal(sk.PublicKey().Bytes(), pubKeys[0].Bytes())
+	require.Nil(pubKeys[1])
+	act, err = action.NewExecution(p.addr.String(), 3, big.NewInt(0), 1000000, big.NewInt(1000), data)
+	require.NoError(err)
+	r, err = p.Handle(newCtx(identityset.Address(1), act), act, sm)
+	require.NoError(err)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), r.Status)
+	csm, err = NewCandidateStateManager(sm, false)
+	require.NoError(err)
+	pubKeys, err = DelegateBLSPublicKeys(csm.DirtyView().candCenter.All(), operators, 11)
+	require.NoError(err)
+	require.Equal([]*bls.PublicKey{nil, nil}, pubKeys)
+	pubKeys, err = DelegateBLSPublicKeys(csm.DirtyView().candCenter.All(), operators, 100)
+	require.NoError(err)
+	require.Nil(pubKeys[0])
+	require.Equal(other.PublicKey().Bytes(), pubKeys[1].Bytes())
+
+	// not handled before the fork
+	cfg.ToBeEnabledBlockHeight = 100
+	r, err = p.Handle(newCtx(identityset.Address(1), act), act, sm)
+	require.NoError(err)
+	require.Nil(r)
+}
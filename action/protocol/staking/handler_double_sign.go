@@ -0,0 +1,146 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+const (
+	handleReportDoubleSign = "reportDoubleSign"
+)
+
+var (
+	errCandidateJailed = &handleError{
+		err:           errors.New("candidate is already jailed"),
+		failureStatus: iotextypes.ReceiptStatus_Failure,
+	}
+	errEvidencePunished = &handleError{
+		err:           errors.New("double-sign has been punished"),
+		failureStatus: iotextypes.ReceiptStatus_Failure,
+	}
+)
+
+func (p *Protocol) isDoubleSignReport(ctx context.Context, act *action.Execution) bool {
+	return protocol.MustGetFeatureCtx(ctx).EnableDoubleSignSlashing && act.Contract() == p.addr.String() && IsDoubleSignEvidence(act.Data())
+}
+
+func (p *Protocol) handleReportDoubleSign(ctx context.Context, act *action.Execution, csm CandidateStateManager,
+) (*receiptLog, []*action.TransactionLog, error) {
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	featureCtx := protocol.MustGetFeatureCtx(ctx)
+	log := newReceiptLog(p.addr.String(), handleReportDoubleSign, featureCtx.NewStakingReceiptFormat)
+
+	evidence, err := NewDoubleSignEvidenceFromABIBinary(act.Data())
+	if err != nil {
+		return log, nil, &handleError{
+			err:           err,
+			failureStatus: iotextypes.ReceiptStatus_Failure,
+		}
+	}
+	offender, err := evidence.Offender()
+	if err != nil {
+		return log, nil, &handleError{
+			err:           err,
+			failureStatus: iotextypes.ReceiptStatus_Failure,
+		}
+	}
+	// the evidence expires after DoubleSignEvidenceMaxAge epochs
+	rp := rolldpos.MustGetProtocol(protocol.MustGetRegistry(ctx))
+	epochNum := rp.GetEpochNum(blkCtx.BlockHeight)
+	if evidence.Height() > blkCtx.BlockHeight || epochNum-rp.GetEpochNum(evidence.Height()) > p.config.DoubleSignEvidenceMaxAge {
+		return log, nil, &handleError{
+			err:           errors.Wrapf(ErrInvalidEvidence, "evidence at height %d is expired", evidence.Height()),
+			failureStatus: iotextypes.ReceiptStatus_Failure,
+		}
+	}
+	cand := csm.GetByOperator(offender)
+	if cand == nil {
+		return log, nil, errCandNotExist
+	}
+	log.AddTopics(cand.Owner.Bytes())
+	jail, err := getJail(csm.SR(), cand.Owner)
+	switch errors.Cause(err) {
+	case nil:
+		if blkCtx.BlockHeight < jail.ReleaseHeight {
+			return log, nil, errCandidateJailed
+		}
+		if evidence.Height() <= jail.EvidenceHeight {
+			return log, nil, errEvidencePunished
+		}
+	case state.ErrStateNotExist:
+	default:
+		return log, nil, errors.Wrapf(err, "failed to get jail of candidate %s", cand.Owner.String())
+	}
+
+	slashed, err := p.slashSelfStake(csm, cand)
+	if err != nil {
+		return log, nil, err
+	}
+	if err := putJail(csm.SM(), cand.Owner, &Jail{
+		Height:         blkCtx.BlockHeight,
+		EvidenceHeight: evidence.Height(),
+		ReleaseHeight:  rp.GetEpochHeight(epochNum + p.config.DoubleSignJailEpochs),
+		SlashedAmount:  slashed,
+	}); err != nil {
+		return log, nil, errors.Wrapf(err, "failed to jail candidate %s", cand.Owner.String())
+	}
+	log.AddAddress(offender)
+	log.SetData(slashed.Bytes())
+	return log, nil, nil
+}
+
+// slashSelfStake burns DoubleSignSlashRate percent of the candidate's self-stake bucket
+func (p *Protocol) slashSelfStake(csm CandidateStateManager, cand *Candidate) (*big.Int, error) {
+	slashed := big.NewInt(0)
+	if p.config.DoubleSignSlashRate == 0 || !cand.isSelfStakeBucketSettled() || cand.SelfStake.Sign() == 0 {
+		return slashed, nil
+	}
+	bucket, rErr := p.fetchBucket(csm, cand.SelfStakeBucketIdx)
+	if rErr != nil {
+		return nil, rErr
+	}
+	slashed.Mul(bucket.StakedAmount, big.NewInt(int64(p.config.DoubleSignSlashRate)))
+	slashed.Div(slashed, big.NewInt(100))
+	if slashed.Sign() == 0 {
+		return slashed, nil
+	}
+
+	prevWeightedVote := p.calculateVoteWeight(bucket, true)
+	bucket.StakedAmount = new(big.Int).Sub(bucket.StakedAmount, slashed)
+	if err := csm.updateBucket(cand.SelfStakeBucketIdx, bucket); err != nil {
+		return nil, errors.Wrapf(err, "failed to update bucket %d", cand.SelfStakeBucketIdx)
+	}
+	if err := cand.SubVote(prevWeightedVote); err != nil {
+		return nil, err
+	}
+	if err := cand.AddVote(p.calculateVoteWeight(bucket, true)); err != nil {
+		return nil, err
+	}
+	if err := cand.SubSelfStake(slashed); err != nil {
+		return nil, err
+	}
+	if err := csm.Upsert(cand); err != nil {
+		return nil, csmErrorToHandleError(cand.Owner.String(), err)
+	}
+	// the slashed amount is removed from the bucket pool without crediting anyone
+	if err := csm.CreditBucketPool(slashed); err != nil {
+		return nil, &handleError{
+			err:           errors.Wrapf(err, "failed to update staking bucket pool %s", err.Error()),
+			failureStatus: iotextypes.ReceiptStatus_ErrWriteAccount,
+		}
+	}
+	return slashed, nil
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/mohae/deepcopy"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestProtocol_HandleReportDoubleSign(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	sm, p, buckets, _ := initTestState(t, ctrl, []*bucketConfig{
+		{identityset.Address(1), identityset.Address(1), "1200000000000000000000000", 30, true, true, nil, 0},
+	}, []*candidateConfig{
+		{identityset.Address(1), identityset.Address(7), identityset.Address(1), "test1"},
+	})
+	p.config.DoubleSignSlashRate = 10
+	p.config.DoubleSignEvidenceMaxAge = 1
+	p.config.DoubleSignJailEpochs = 2
+	reporter := identityset.Address(3)
+	require.NoError(setupAccount(sm, reporter, 1000))
+
+	var (
+		sk = identityset.PrivateKey(7)
+		ts = time.Unix(1700000000, 0)
+	)
+	newEvidence := func(height uint64) []byte {
+		evidence := NewDoubleSignEvidence(
+			newTestConsensusVoteMessage(t, sk, height, iotextypes.ConsensusVote_LOCK, ts, []byte("block1")),
+			newTestConsensusVoteMessage(t, sk, height, iotextypes.ConsensusVote_LOCK, ts, []byte("block2")),
+		)
+		data, err := evidence.EncodeABIBinary()
+		require.NoError(err)
+		return data
+	}
+	data := newEvidence(10)
+
+	// an epoch lasts for 12 blocks, starting from height 1
+	reg := protocol.NewRegistry()
+	require.NoError(reg.Register("rolldpos", rolldpos.NewProtocol(23, 4, 3)))
+	cfg := deepcopy.Copy(genesis.Default).(genesis.Genesis)
+	cfg.TsunamiBlockHeight = 1
+	height := uint64(11)
+	newCtx := func(act *action.Execution) context.Context {
+		intrinsicGas, err := act.IntrinsicGas()
+		require.NoError(err)
+		ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{
+			Caller:       reporter,
+			GasPrice:     act.GasPrice(),
+			IntrinsicGas: intrinsicGas,
+			Nonce:        act.Nonce(),
+		})
+		ctx = protocol.WithBlockCtx(protocol.WithRegistry(ctx, reg), protocol.BlockCtx{
+			BlockHeight:    height,
+			BlockTimeStamp: timeBlock,
+			GasLimit:       1000000,
+		})
+		ctx = genesis.WithGenesisContext(ctx, cfg)
+		return protocol.WithFeatureCtx(protocol.WithFeatureWithHeightCtx(ctx))
+	}
+
+	// reports are not handled before the double-sign slashing is enabled
+	act, err := action.NewExecution(p.addr.String(), 1, big.NewInt(0), 1000000, big.NewInt(1000), data)
+	require.NoError(err)
+	ctx := newCtx(act)
+	require.NoError(p.Validate(ctx, act, sm))
+	r, err := p.Handle(ctx, act, sm)
+	require.NoError(err)
+	require.Nil(r)
+	cfg.ToBeEnabledBlockHeight = 1
+
+	// executions to other contracts are not handled
+	act, err = action.NewExecution(identityset.Address(4).String(), 1, big.NewInt(0), 1000000, big.NewInt(1000), data)
+	require.NoError(err)
+	ctx = newCtx(act)
+	require.NoError(p.Validate(ctx, act, sm))
+	r, err = p.Handle(ctx, act, sm)
+	require.NoError(err)
+	require.Nil(r)
+
+	// report cannot carry amount
+	act, err = action.NewExecution(p.addr.String(), 1, big.NewInt(1), 1000000, big.NewInt(1000), data)
+	require.NoError(err)
+	require.ErrorIs(p.Validate(newCtx(act), act, sm), action.ErrInvalidAmount)
+
+	act, err = action.NewExecution(p.addr.String(), 1, big.NewInt(0), 1000000, big.NewInt(1000), data)
+	require.NoError(err)
+	ctx = newCtx(act)
+	require.NoError(p.Validate(ctx, act, sm))
+	r, err = p.Handle(ctx, act, sm)
+	require.NoError(err)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), r.Status)
+
+	// candidate is jailed for 2 epochs and 10% of the self-stake is burnt
+	jailed, err := IsJailed(sm, identityset.Address(1), 24)
+	require.NoError(err)
+	require.True(jailed)
+	jailed, err = IsJailed(sm, identityset.Address(1), 25)
+	require.NoError(err)
+	require.False(jailed)
+	jail, err := getJail(sm, identityset.Address(1))
+	require.NoError(err)
+	require.Equal(uint64(11), jail.Height)
+	require.Equal(uint64(10), jail.EvidenceHeight)
+	require.Equal(uint64(25), jail.ReleaseHeight)
+	require.Equal("120000000000000000000000", jail.SlashedAmount.String())
+	csm, err := NewCandidateStateManager(sm, false)
+	require.NoError(err)
+	bucket, err := csm.getBucket(buckets[0].Index)
+	require.NoError(err)
+	require.Equal("1080000000000000000000000", bucket.StakedAmount.String())
+	cand := csm.GetByOwner(identityset.Address(1))
+	require.Equal("1080000000000000000000000", cand.SelfStake.String())
+	require.Equal(p.calculateVoteWeight(bucket, true), cand.Votes)
+	csr, err := ConstructBaseView(sm)
+	require.NoError(err)
+	active, err := p.isActiveCandidate(ctx, csr, cand, 13)
+	require.NoError(err)
+	require.False(active)
+
+	report := func(nonce uint64, data []byte) iotextypes.ReceiptStatus {
+		act, err := action.NewExecution(p.addr.String(), nonce, big.NewInt(0), 1000000, big.NewInt(1000), data)
+		require.NoError(err)
+		r, err := p.Handle(newCtx(act), act, sm)
+		require.NoError(err)
+		return iotextypes.ReceiptStatus(r.Status)
+	}
+	// candidate cannot be jailed twice
+	height = 12
+	require.Equal(iotextypes.ReceiptStatus_Failure, report(2, data))
+	// evidence from the future is rejected
+	require.Equal(iotextypes.ReceiptStatus_Failure, report(3, newEvidence(13)))
+
+	// evidence expires after 1 epoch
+	height = 25
+	require.Equal(iotextypes.ReceiptStatus_Failure, report(4, data))
+	// the double-sign punished cannot be reported again
+	p.config.DoubleSignEvidenceMaxAge = 2
+	require.Equal(iotextypes.ReceiptStatus_Failure, report(5, data))
+	jail, err = getJail(sm, identityset.Address(1))
+	require.NoError(err)
+	require.Equal(uint64(11), jail.Height)
+
+	// a later double-sign jails the released candidate again
+	p.config.DoubleSignSlashRate = 0
+	require.Equal(iotextypes.ReceiptStatus_Success, report(6, newEvidence(20)))
+	jail, err = getJail(sm, identityset.Address(1))
+	require.NoError(err)
+	require.Equal(uint64(25), jail.Height)
+	require.Equal(uint64(20), jail.EvidenceHeight)
+	require.Equal(uint64(49), jail.ReleaseHeight)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"math/big"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/staking/stakingpb"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+// Jail records the candidate jailed for double-signing, the candidate is released at the release height
+type Jail struct {
+	Height         uint64
+	EvidenceHeight uint64
+	ReleaseHeight  uint64
+	SlashedAmount  *big.Int
+}
+
+// Serialize serializes jail to bytes
+func (j *Jail) Serialize() ([]byte, error) {
+	return proto.Marshal(&stakingpb.Jail{
+		Height:         j.Height,
+		SlashedAmount:  j.SlashedAmount.String(),
+		EvidenceHeight: j.EvidenceHeight,
+		ReleaseHeight:  j.ReleaseHeight,
+	})
+}
+
+// Deserialize deserializes bytes to jail
+func (j *Jail) Deserialize(buf []byte) error {
+	pb := &stakingpb.Jail{}
+	if err := proto.Unmarshal(buf, pb); err != nil {
+		return errors.Wrap(err, "failed to unmarshal jail")
+	}
+	amount, ok := new(big.Int).SetString(pb.SlashedAmount, 10)
+	if !ok {
+		return errors.Errorf("invalid slashed amount %s", pb.SlashedAmount)
+	}
+	j.Height = pb.Height
+	j.EvidenceHeight = pb.EvidenceHeight
+	j.ReleaseHeight = pb.ReleaseHeight
+	j.SlashedAmount = amount
+	return nil
+}
+
+// IsJailed returns true if the candidate is jailed at the height
+func IsJailed(sr protocol.StateReader, owner address.Address, height uint64) (bool, error) {
+	j, err := getJail(sr, owner)
+	switch errors.Cause(err) {
+	case nil:
+		return height < j.ReleaseHeight, nil
+	case state.ErrStateNotExist:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func getJail(sr protocol.StateReader, owner address.Address) (*Jail, error) {
+	j := Jail{}
+	if _, err := sr.State(&j, protocol.NamespaceOption(_stakingNameSpace), protocol.KeyOption(jailKey(owner))); err != nil {
+		return nil, err
+	}
+	return &j, nil
+}
+
+func putJail(sm protocol.StateManager, owner address.Address, j *Jail) error {
+	_, err := sm.PutState(j, protocol.NamespaceOption(_stakingNameSpace), protocol.KeyOption(jailKey(owner)))
+	return err
+}
+
+func jailKey(owner address.Address) []byte {
+	return append([]byte{_jail}, owner.Bytes()...)
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/state"
+)
+
+const _precompileABI = `[
+	{
+		"inputs": [],
+		"name": "totalStakingAmount",
+		"outputs": [
+			{"internalType": "uint256", "name": "", "type": "uint256"}
+		],
+		"stateMutability": "view",
+		"type": "function"
+	},
+	{
+		"inputs": [
+			{"internalType": "address", "name": "owner", "type": "address"}
+		],
+		"name": "candidateVotes",
+		"outputs": [
+			{"internalType": "uint256", "name": "votes", "type": "uint256"},
+			{"internalType": "uint256", "name": "selfStake", "type": "uint256"}
+		],
+		"stateMutability": "view",
+		"type": "function"
+	},
+	{
+		"inputs": [],
+		"name": "bucketsCount",
+		"outputs": [
+			{"internalType": "uint64", "name": "", "type": "uint64"}
+		],
+		"stateMutability": "view",
+		"type": "function"
+	},
+	{
+		"inputs": [
+			{"internalType": "uint64", "name": "index", "type": "uint64"}
+		],
+		"name": "bucket",
+		"outputs": [
+			{"internalType": "address", "name": "candidate", "type": "address"},
+			{"internalType": "address", "name": "owner", "type": "address"},
+			{"internalType": "uint256", "name": "stakedAmount", "type": "uint256"},
+			{"internalType": "uint32", "name": "stakedDuration", "type": "uint32"},
+			{"internalType": "bool", "name": "autoStake", "type": "bool"},
+			{"internalType": "bool", "name": "unstaked", "type": "bool"}
+		],
+		"stateMutability": "view",
+		"type": "function"
+	}
+]`
+
+// gas cost of each method of the staking precompile
+const (
+	_precompileTotalStakingAmountGas = 2000
+	_precompileCandidateVotesGas     = 3000
+	_precompileBucketsCountGas       = 2000
+	_precompileBucketGas             = 5000
+)
+
+var (
+	// PrecompileAddress is the address of the staking precompiled contract
+	PrecompileAddress = common.HexToAddress("0x0000000000000000000000000000000000001000")
+
+	// ErrPrecompileInput indicates the call data to the staking precompile is invalid
+	ErrPrecompileInput = errors.New("invalid staking precompile input")
+
+	_precompileInterface abi.ABI
+	_precompileGas       = map[string]uint64{
+		"totalStakingAmount": _precompileTotalStakingAmountGas,
+		"candidateVotes":     _precompileCandidateVotesGas,
+		"bucketsCount":       _precompileBucketsCountGas,
+		"bucket":             _precompileBucketGas,
+	}
+)
+
+func init() {
+	var err error
+	_precompileInterface, err = abi.JSON(strings.NewReader(_precompileABI))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Precompile is a read-only precompiled contract, which exposes the native staking
+// buckets and candidates of a CandidateStateReader to the EVM
+type Precompile struct {
+	csr CandidateStateReader
+}
+
+// NewPrecompile creates a staking precompile reading from the candidate state reader
+func NewPrecompile(csr CandidateStateReader) *Precompile {
+	return &Precompile{csr: csr}
+}
+
+// RequiredGas returns the gas cost of the call
+func (p *Precompile) RequiredGas(input []byte) uint64 {
+	method, err := precompileMethod(input)
+	if err != nil {
+		return 0
+	}
+	return _precompileGas[method.Name]
+}
+
+// Run executes the call and returns the abi-encoded result
+func (p *Precompile) Run(input []byte) ([]byte, error) {
+	method, err := precompileMethod(input)
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, errors.Wrap(ErrPrecompileInput, err.Error())
+	}
+	switch method.Name {
+	case "totalStakingAmount":
+		return method.Outputs.Pack(p.csr.TotalStakedAmount())
+	case "candidateVotes":
+		owner, err := address.FromBytes(args[0].(common.Address).Bytes())
+		if err != nil {
+			return nil, errors.Wrap(ErrPrecompileInput, err.Error())
+		}
+		votes, selfStake := big.NewInt(0), big.NewInt(0)
+		if c := p.csr.GetCandidateByOwner(owner); c != nil {
+			votes, selfStake = c.Votes, c.SelfStake
+		}
+		return method.Outputs.Pack(votes, selfStake)
+	case "bucketsCount":
+		count, err := p.csr.getTotalBucketCount()
+		if err != nil && errors.Cause(err) != state.ErrStateNotExist {
+			return nil, err
+		}
+		return method.Outputs.Pack(count)
+	case "bucket":
+		vb, err := p.csr.getBucket(args[0].(uint64))
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(
+			common.BytesToAddress(vb.Candidate.Bytes()),
+			common.BytesToAddress(vb.Owner.Bytes()),
+			vb.StakedAmount,
+			uint32(vb.StakedDuration.Hours()/24),
+			vb.AutoStake,
+			vb.isUnstaked(),
+		)
+	default:
+		return nil, ErrPrecompileInput
+	}
+}
+
+func precompileMethod(input []byte) (*abi.Method, error) {
+	if len(input) < 4 {
+		return nil, ErrPrecompileInput
+	}
+	method, err := _precompileInterface.MethodById(input[:4])
+	if err != nil {
+		return nil, errors.Wrap(ErrPrecompileInput, err.Error())
+	}
+	return method, nil
+}
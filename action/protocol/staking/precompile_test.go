@@ -0,0 +1,102 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil/testdb"
+)
+
+func TestStakingPrecompile(t *testing.T) {
+	r := require.New(t)
+	ctrl := gomock.NewController(t)
+	sm := testdb.NewMockStateManager(ctrl)
+
+	owner, voter := identityset.Address(1), identityset.Address(2)
+	csm := newCandidateStateManager(sm)
+	r.NoError(csm.putCandidate(&Candidate{
+		Owner:     owner,
+		Operator:  owner,
+		Reward:    owner,
+		Name:      "test",
+		Votes:     big.NewInt(3000),
+		SelfStake: big.NewInt(1000),
+	}))
+	_, err := csm.putBucket(NewVoteBucket(owner, owner, big.NewInt(1000), 7, time.Now(), true))
+	r.NoError(err)
+	_, err = csm.putBucket(NewVoteBucket(owner, voter, big.NewInt(2000), 91, time.Now(), false))
+	r.NoError(err)
+	view, _, err := CreateBaseView(sm, false)
+	r.NoError(err)
+	r.NoError(sm.WriteView(_protocolID, view))
+	csr, err := ConstructBaseView(sm)
+	r.NoError(err)
+	p := NewPrecompile(csr)
+
+	call := func(method string, args ...interface{}) ([]interface{}, uint64, error) {
+		input, err := _precompileInterface.Pack(method, args...)
+		r.NoError(err)
+		ret, err := p.Run(input)
+		if err != nil {
+			return nil, p.RequiredGas(input), err
+		}
+		out, err := _precompileInterface.Unpack(method, ret)
+		r.NoError(err)
+		return out, p.RequiredGas(input), nil
+	}
+
+	out, gas, err := call("totalStakingAmount")
+	r.NoError(err)
+	r.EqualValues(_precompileTotalStakingAmountGas, gas)
+	r.Equal(big.NewInt(3000), out[0])
+
+	out, gas, err = call("candidateVotes", common.BytesToAddress(owner.Bytes()))
+	r.NoError(err)
+	r.EqualValues(_precompileCandidateVotesGas, gas)
+	r.Equal(big.NewInt(3000), out[0])
+	r.Equal(big.NewInt(1000), out[1])
+	// unknown candidate has no votes
+	out, _, err = call("candidateVotes", common.BytesToAddress(voter.Bytes()))
+	r.NoError(err)
+	r.Zero(out[0].(*big.Int).Sign())
+	r.Zero(out[1].(*big.Int).Sign())
+
+	out, gas, err = call("bucketsCount")
+	r.NoError(err)
+	r.EqualValues(_precompileBucketsCountGas, gas)
+	r.EqualValues(2, out[0])
+
+	out, gas, err = call("bucket", uint64(1))
+	r.NoError(err)
+	r.EqualValues(_precompileBucketGas, gas)
+	r.Equal(common.BytesToAddress(owner.Bytes()), out[0])
+	r.Equal(common.BytesToAddress(voter.Bytes()), out[1])
+	r.Equal(big.NewInt(2000), out[2])
+	r.EqualValues(91, out[3])
+	r.False(out[4].(bool))
+	r.False(out[5].(bool))
+	_, _, err = call("bucket", uint64(2))
+	r.Error(err)
+
+	// invalid input
+	for _, input := range [][]byte{nil, {1, 2, 3}, {1, 2, 3, 4}} {
+		r.Zero(p.RequiredGas(input))
+		_, err = p.Run(input)
+		r.ErrorIs(err, ErrPrecompileInput)
+	}
+	input, err := _precompileInterface.Pack("bucket", uint64(1))
+	r.NoError(err)
+	_, err = p.Run(input[:20])
+	r.ErrorIs(err, ErrPrecompileInput)
+}
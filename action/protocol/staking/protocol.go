@@ -52,6 +52,7 @@ const (
 	_voterIndex
 	_candIndex
 	_endorsement
+	_jail
 )
 
 // Errors
@@ -94,6 +95,9 @@ type (
 		BootstrapCandidates              []genesis.BootstrapCandidate
 		PersistStakingPatchBlock         uint64
 		EndorsementWithdrawWaitingBlocks uint64
+		DoubleSignSlashRate              uint32
+		DoubleSignEvidenceMaxAge         uint64
+		DoubleSignJailEpochs             uint64
 	}
 
 	// DepositGas deposits gas to some pool
@@ -146,6 +150,10 @@ func NewProtocol(
 		return nil, action.ErrInvalidAmount
 	}
 
+	if cfg.Staking.DoubleSignSlashRate > 100 {
+		return nil, errors.Errorf("invalid double-sign slash rate %d", cfg.Staking.DoubleSignSlashRate)
+	}
+
 	// new vote reviser, revise ate greenland
 	voteReviser := NewVoteReviser(cfg.Staking.VoteWeightCalConsts, correctCandsHeight, reviseHeights...)
 
@@ -162,6 +170,9 @@ func NewProtocol(
 			BootstrapCandidates:              cfg.Staking.BootstrapCandidates,
 			PersistStakingPatchBlock:         cfg.PersistStakingPatchBlock,
 			EndorsementWithdrawWaitingBlocks: cfg.Staking.EndorsementWithdrawWaitingBlocks,
+			DoubleSignSlashRate:              cfg.Staking.DoubleSignSlashRate,
+			DoubleSignEvidenceMaxAge:         cfg.Staking.DoubleSignEvidenceMaxAge,
+			DoubleSignJailEpochs:             cfg.Staking.DoubleSignJailEpochs,
 		},
 		depositGas:             depositGas,
 		candBucketsIndexer:     candBucketsIndexer,
@@ -425,6 +436,15 @@ func (p *Protocol) handle(ctx context.Context, act action.Action, csm CandidateS
 		rLog, tLogs, err = p.handleCandidateActivate(ctx, act, csm)
 	case *action.CandidateEndorsement:
 		rLog, tLogs, err = p.handleCandidateEndorsement(ctx, act, csm)
+	case *action.Execution:
+		switch {
+		case p.isDoubleSignReport(ctx, act):
+			rLog, tLogs, err = p.handleReportDoubleSign(ctx, act, csm)
+		case p.isBLSKeyUpdate(ctx, act):
+			rLog, err = p.handleCandidateUpdateBLSKey(ctx, act, csm)
+		default:
+			return nil, nil
+		}
 	default:
 		return nil, nil
 	}
@@ -473,15 +493,31 @@ func (p *Protocol) Validate(ctx context.Context, act action.Action, sr protocol.
 		return p.validateCandidateActivate(ctx, act)
 	case *action.CandidateEndorsement:
 		return p.validateCandidateEndorsement(ctx, act)
+	case *action.Execution:
+		switch {
+		case p.isDoubleSignReport(ctx, act):
+			return p.validateReportDoubleSign(act)
+		case p.isBLSKeyUpdate(ctx, act):
+			return p.validateCandidateUpdateBLSKey(act)
+		}
 	}
 	return nil
 }
 
-func (p *Protocol) isActiveCandidate(ctx context.Context, csr CandidateStateReader, cand *Candidate) (bool, error) {
+func (p *Protocol) isActiveCandidate(ctx context.Context, csr CandidateStateReader, cand *Candidate, height uint64) (bool, error) {
 	if cand.SelfStake.Cmp(p.config.RegistrationConsts.MinSelfStake) < 0 {
 		return false, nil
 	}
 	featureCtx := protocol.MustGetFeatureCtx(ctx)
+	if featureCtx.EnableDoubleSignSlashing {
+		jailed, err := IsJailed(csr.SR(), cand.Owner, height)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to get jail of candidate %s", cand.Owner.String())
+		}
+		if jailed {
+			return false, nil
+		}
+	}
 	if featureCtx.DisableDelegateEndorsement {
 		// before endorsement feature, candidates with enough amount must be active
 		return true, nil
@@ -526,7 +562,7 @@ func (p *Protocol) ActiveCandidates(ctx context.Context, sr protocol.StateReader
 			}
 			list[i].Votes.Add(list[i].Votes, contractVotes)
 		}
-		active, err := p.isActiveCandidate(ctx, c, list[i])
+		active, err := p.isActiveCandidate(ctx, c, list[i], height)
 		if err != nil {
 			return nil, err
 		}
@@ -98,3 +98,27 @@ func (p *Protocol) validateCandidateActivate(ctx context.Context, act *action.Ca
 	}
 	return nil
 }
+
+func (p *Protocol) validateReportDoubleSign(act *action.Execution) error {
+	if act.Amount().Sign() != 0 {
+		return errors.Wrap(action.ErrInvalidAmount, "double-sign report cannot carry amount")
+	}
+	evidence, err := NewDoubleSignEvidenceFromABIBinary(act.Data())
+	if err != nil {
+		return err
+	}
+	_, err = evidence.Offender()
+	return err
+}
+
+func (p *Protocol) validateCandidateUpdateBLSKey(act *action.Execution) error {
+	if act.Amount().Sign() != 0 {
+		return errors.Wrap(action.ErrInvalidAmount, "BLS public key update cannot carry amount")
+	}
+	update, err := NewBLSKeyUpdateFromABIBinary(act.Data())
+	if err != nil {
+		return err
+	}
+	_, err = update.PublicKey()
+	return err
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	_protocolGRPC      = "grpc"
+	_protocolHTTP      = "http"
+	_protocolWebsocket = "websocket"
+
+	_authorizationHeader = "Authorization"
+	_bearerPrefix        = "Bearer "
+)
+
+type (
+	// AccessControl authenticates the clients and limits their requests, on all of the gRPC, http and websocket
+	// servers
+	AccessControl struct {
+		apiKeyHeader string
+		origins      []string
+		auth         *Authenticator
+		limiter      *RateLimiter
+	}
+
+	// client is the client sending a request
+	client struct {
+		protocol      string
+		id            string // the valid API key or the IP, which the requests are limited by
+		authenticated bool
+		allowed       []string
+		authErr       error
+	}
+
+	accessContextKey struct{}
+
+	clientContextKey struct{}
+
+	// accessCheck checks whether the client sending the request is allowed to call a method
+	accessCheck func(method string) error
+
+	// accessStream is a gRPC stream of which the context carries the client, so that a long stream is charged as it
+	// advances
+	accessStream struct {
+		grpc.ServerStream
+		ctx context.Context
+	}
+)
+
+// NewAccessControl creates the access control of the API servers
+func NewAccessControl(cfg Config) (*AccessControl, error) {
+	auth, err := NewAuthenticator(cfg.Auth)
+	if err != nil {
+		return nil, err
+	}
+	return &AccessControl{
+		apiKeyHeader: cfg.APIKeyHeader,
+		origins:      cfg.WebsocketAllowedOrigins,
+		auth:         auth,
+		limiter:      NewRateLimiter(cfg.RateLimit),
+	}, nil
+}
+
+func (ac *AccessControl) newClient(protocol, addr, apiKey, token string) *client {
+	c := &client{protocol: protocol, id: "ip:" + hostOf(addr)}
+	if ac.auth == nil {
+		return c
+	}
+	c.allowed, c.authenticated, c.authErr = ac.auth.allowList(apiKey, token)
+	// only a valid API key identifies the client, otherwise random keys would each get a new bucket
+	if apiKey != "" && c.authenticated {
+		c.id = "key:" + apiKey
+	}
+	return c
+}
+
+func (ac *AccessControl) check(c *client, method string) error {
+	if err := ac.auth.authorize(c, method); err != nil {
+		return err
+	}
+	return ac.limiter.check(c.protocol, c.id, method)
+}
+
+// withClient returns a context carrying the client sending the http or websocket request
+func (ac *AccessControl) withClient(ctx context.Context, protocol string, req *http.Request) context.Context {
+	if ac == nil {
+		return ctx
+	}
+	c := ac.newClient(protocol, req.RemoteAddr, req.Header.Get(ac.apiKeyHeader), bearerToken(req.Header.Get(_authorizationHeader)))
+	return withAccessCheck(ctx, c.id, func(method string) error {
+		return ac.check(c, method)
+	})
+}
+
+func withAccessCheck(ctx context.Context, id string, check accessCheck) context.Context {
+	ctx = context.WithValue(ctx, clientContextKey{}, id)
+	return context.WithValue(ctx, accessContextKey{}, check)
+}
+
+// clientIDFromContext returns the id of the client sending the request, or empty if it is unknown
+func clientIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(clientContextKey{}).(string)
+	return id
+}
+
+// checkAccess checks whether the client sending the request is allowed to call the method
+func checkAccess(ctx context.Context, method string) error {
+	check, ok := ctx.Value(accessContextKey{}).(accessCheck)
+	if !ok {
+		return nil
+	}
+	return check(method)
+}
+
+// checkOrigin checks the origin of the websocket request from a browser against the allow-list
+func (ac *AccessControl) checkOrigin(req *http.Request) bool {
+	origin := req.Header.Get("Origin")
+	if ac == nil || origin == "" {
+		return true
+	}
+	for _, o := range ac.origins {
+		if o == "*" || strings.EqualFold(o, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+func (ac *AccessControl) grpcClient(ctx context.Context) *client {
+	var addr, apiKey, token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(strings.ToLower(ac.apiKeyHeader)); len(v) > 0 {
+			apiKey = v[0]
+		}
+		if v := md.Get(strings.ToLower(_authorizationHeader)); len(v) > 0 {
+			token = bearerToken(v[0])
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+	}
+	return ac.newClient(_protocolGRPC, addr, apiKey, token)
+}
+
+func (ac *AccessControl) checkGRPC(c *client, method string) error {
+	err := ac.check(c, method)
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(codes.ResourceExhausted, err.Error())
+}
+
+// UnaryServerInterceptor checks the unary gRPC calls
+func (ac *AccessControl) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if ac != nil {
+			if err := ac.checkGRPC(ac.grpcClient(ctx), path.Base(info.FullMethod)); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor checks the opening of gRPC streams, and passes the client to the streams to be charged as
+// they advance
+func (ac *AccessControl) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if ac != nil {
+			c := ac.grpcClient(ss.Context())
+			if err := ac.checkGRPC(c, path.Base(info.FullMethod)); err != nil {
+				return err
+			}
+			ss = &accessStream{
+				ServerStream: ss,
+				ctx: withAccessCheck(ss.Context(), c.id, func(method string) error {
+					return ac.checkGRPC(c, method)
+				}),
+			}
+		}
+		return handler(srv, ss)
+	}
+}
+
+func (s *accessStream) Context() context.Context {
+	return s.ctx
+}
+
+func bearerToken(auth string) string {
+	if len(auth) < len(_bearerPrefix) || !strings.EqualFold(auth[:len(_bearerPrefix)], _bearerPrefix) {
+		return ""
+	}
+	return strings.TrimSpace(auth[len(_bearerPrefix):])
+}
+
+func hostOf(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
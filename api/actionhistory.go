@@ -0,0 +1,175 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/action"
+	apitypes "github.com/iotexproject/iotex-core/api/types"
+)
+
+const (
+	_cursorAscending  byte = 0
+	_cursorDescending byte = 1
+	_cursorLen             = 9
+)
+
+// ActionsByAddressWithCursor returns a page of the actions associated with an address, and the cursor of the next
+// page, which is empty if there are no more actions. A page scans at most RangeQueryLimit actions, so it may have
+// fewer actions than Count if they are filtered out, while the next page can still continue.
+func (core *coreService) ActionsByAddressWithCursor(addr address.Address, query *apitypes.ActionHistoryQuery) ([]*iotexapi.ActionInfo, string, error) {
+	if err := core.checkActionIndex(); err != nil {
+		return nil, "", err
+	}
+	if query.Count == 0 {
+		return nil, "", status.Error(codes.InvalidArgument, "count must be greater than zero")
+	}
+	if query.Count > core.cfg.RangeQueryLimit {
+		return nil, "", status.Error(codes.InvalidArgument, "range exceeds the limit")
+	}
+	addrHash := hash.BytesToHash160(addr.Bytes())
+	total, err := core.indexer.GetActionCountByAddress(addrHash)
+	if err != nil {
+		return nil, "", status.Error(codes.Internal, err.Error())
+	}
+	// pos is the position of the next action to scan in the index of the address
+	pos, err := decodeHistoryCursor(query.Cursor, query.Descending, total)
+	if err != nil {
+		return nil, "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	var (
+		res     []*iotexapi.ActionInfo
+		scanned uint64
+	)
+	for uint64(len(res)) < query.Count && scanned < core.cfg.RangeQueryLimit && pos < total {
+		start, count := pos, query.Count
+		if query.Descending {
+			if count > pos+1 {
+				count = pos + 1
+			}
+			start = pos + 1 - count
+		} else if count > total-pos {
+			count = total - pos
+		}
+		hashes, err := core.indexer.GetActionsByAddress(addrHash, start, count)
+		if err != nil {
+			return nil, "", status.Error(codes.NotFound, err.Error())
+		}
+		for i := range hashes {
+			h := hashes[i]
+			if query.Descending {
+				h = hashes[len(hashes)-1-i]
+				// pos wraps to math.MaxUint64 after the first action, which ends the scan
+				pos--
+			} else {
+				pos++
+			}
+			scanned++
+			act, err := core.historyAction(addr, hash.BytesToHash256(h), query)
+			if err != nil {
+				return nil, "", status.Errorf(codes.Internal, "failed to load action %x of address history: %s", h, err.Error())
+			}
+			if act != nil {
+				res = append(res, act)
+			}
+			if uint64(len(res)) == query.Count || scanned == core.cfg.RangeQueryLimit {
+				break
+			}
+		}
+	}
+	if pos >= total {
+		return res, "", nil
+	}
+	return res, encodeHistoryCursor(pos, query.Descending), nil
+}
+
+// historyAction returns the action if it matches the filters of the query, otherwise nil
+func (core *coreService) historyAction(addr address.Address, actHash hash.Hash256, query *apitypes.ActionHistoryQuery) (*iotexapi.ActionInfo, error) {
+	selp, blkHash, blkHeight, actIndex, err := core.ActionByActionHash(actHash)
+	if err != nil {
+		return nil, err
+	}
+	if len(query.ActionTypes) > 0 {
+		matched, name := false, actionTypeName(selp.Envelope.Proto())
+		for _, t := range query.ActionTypes {
+			if t == name {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return nil, nil
+		}
+	}
+	if query.Counterpart != nil && counterpart(addr, selp) != query.Counterpart.String() {
+		return nil, nil
+	}
+	act, err := core.committedAction(selp, blkHash, blkHeight)
+	if err != nil {
+		return nil, err
+	}
+	act.Index = actIndex
+	return act, nil
+}
+
+// counterpart returns the other party of the action, which is the recipient if the address is the sender
+func counterpart(addr address.Address, selp *action.SealedEnvelope) string {
+	if sender := selp.SenderAddress(); sender.String() != addr.String() {
+		return sender.String()
+	}
+	dst, _ := selp.Destination()
+	return dst
+}
+
+// actionTypeName returns the name of the action field set in the ActionCore proto
+func actionTypeName(core *iotextypes.ActionCore) string {
+	msg := core.ProtoReflect()
+	fd := msg.WhichOneof(msg.Descriptor().Oneofs().ByName("action"))
+	if fd == nil {
+		return ""
+	}
+	return string(fd.Name())
+}
+
+func encodeHistoryCursor(pos uint64, desc bool) string {
+	b := make([]byte, _cursorLen)
+	b[0] = _cursorAscending
+	if desc {
+		b[0] = _cursorDescending
+	}
+	binary.BigEndian.PutUint64(b[1:], pos)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeHistoryCursor returns the position to continue from, which is the first or the last action of the address
+// if the cursor is empty
+func decodeHistoryCursor(cursor string, desc bool, total uint64) (uint64, error) {
+	if cursor == "" {
+		if desc {
+			// wraps to math.MaxUint64 if there is no action
+			return total - 1, nil
+		}
+		return 0, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || len(b) != _cursorLen || b[0] > _cursorDescending {
+		return 0, errors.Errorf("invalid cursor %s", cursor)
+	}
+	if (b[0] == _cursorDescending) != desc {
+		return 0, errors.Errorf("cursor %s is of the other order", cursor)
+	}
+	return binary.BigEndian.Uint64(b[1:]), nil
+}
@@ -0,0 +1,292 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+// To compile the proto, run:
+//      protoc --go_out=plugins=grpc:. *.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v4.23.3
+// source: api/apipb/logstream.proto
+
+package apipb
+
+import (
+	context "context"
+	iotexapi "github.com/iotexproject/iotex-proto/golang/iotexapi"
+	iotextypes "github.com/iotexproject/iotex-proto/golang/iotextypes"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StreamLogsInRangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Logs        []*iotextypes.Log `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	ResumeToken string            `protobuf:"bytes,2,opt,name=resumeToken,proto3" json:"resumeToken,omitempty"`
+}
+
+func (x *StreamLogsInRangeResponse) Reset() {
+	*x = StreamLogsInRangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_apipb_logstream_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamLogsInRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLogsInRangeResponse) ProtoMessage() {}
+
+func (x *StreamLogsInRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_apipb_logstream_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLogsInRangeResponse.ProtoReflect.Descriptor instead.
+func (*StreamLogsInRangeResponse) Descriptor() ([]byte, []int) {
+	return file_api_apipb_logstream_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StreamLogsInRangeResponse) GetLogs() []*iotextypes.Log {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *StreamLogsInRangeResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+var File_api_apipb_logstream_proto protoreflect.FileDescriptor
+
+var file_api_apipb_logstream_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2f, 0x6c, 0x6f, 0x67, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x61, 0x70, 0x69,
+	0x70, 0x62, 0x1a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x62, 0x0a, 0x19, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x49,
+	0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23,
+	0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69,
+	0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c,
+	0x6f, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0x67, 0x0a, 0x10, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x11, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x49, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18,
+	0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x49, 0x6e, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x2e,
+	0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6f, 0x74,
+	0x65, 0x78, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x2d,
+	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x69, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_apipb_logstream_proto_rawDescOnce sync.Once
+	file_api_apipb_logstream_proto_rawDescData = file_api_apipb_logstream_proto_rawDesc
+)
+
+func file_api_apipb_logstream_proto_rawDescGZIP() []byte {
+	file_api_apipb_logstream_proto_rawDescOnce.Do(func() {
+		file_api_apipb_logstream_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_apipb_logstream_proto_rawDescData)
+	})
+	return file_api_apipb_logstream_proto_rawDescData
+}
+
+var file_api_apipb_logstream_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_api_apipb_logstream_proto_goTypes = []any{
+	(*StreamLogsInRangeResponse)(nil), // 0: apipb.StreamLogsInRangeResponse
+	(*iotextypes.Log)(nil),            // 1: iotextypes.Log
+	(*iotexapi.GetLogsRequest)(nil),   // 2: iotexapi.GetLogsRequest
+}
+var file_api_apipb_logstream_proto_depIdxs = []int32{
+	1, // 0: apipb.StreamLogsInRangeResponse.logs:type_name -> iotextypes.Log
+	2, // 1: apipb.LogStreamService.StreamLogsInRange:input_type -> iotexapi.GetLogsRequest
+	0, // 2: apipb.LogStreamService.StreamLogsInRange:output_type -> apipb.StreamLogsInRangeResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_api_apipb_logstream_proto_init() }
+func file_api_apipb_logstream_proto_init() {
+	if File_api_apipb_logstream_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_apipb_logstream_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*StreamLogsInRangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_apipb_logstream_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_apipb_logstream_proto_goTypes,
+		DependencyIndexes: file_api_apipb_logstream_proto_depIdxs,
+		MessageInfos:      file_api_apipb_logstream_proto_msgTypes,
+	}.Build()
+	File_api_apipb_logstream_proto = out.File
+	file_api_apipb_logstream_proto_rawDesc = nil
+	file_api_apipb_logstream_proto_goTypes = nil
+	file_api_apipb_logstream_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// LogStreamServiceClient is the client API for LogStreamService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LogStreamServiceClient interface {
+	StreamLogsInRange(ctx context.Context, in *iotexapi.GetLogsRequest, opts ...grpc.CallOption) (LogStreamService_StreamLogsInRangeClient, error)
+}
+
+type logStreamServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLogStreamServiceClient(cc grpc.ClientConnInterface) LogStreamServiceClient {
+	return &logStreamServiceClient{cc}
+}
+
+func (c *logStreamServiceClient) StreamLogsInRange(ctx context.Context, in *iotexapi.GetLogsRequest, opts ...grpc.CallOption) (LogStreamService_StreamLogsInRangeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_LogStreamService_serviceDesc.Streams[0], "/apipb.LogStreamService/StreamLogsInRange", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &logStreamServiceStreamLogsInRangeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LogStreamService_StreamLogsInRangeClient interface {
+	Recv() (*StreamLogsInRangeResponse, error)
+	grpc.ClientStream
+}
+
+type logStreamServiceStreamLogsInRangeClient struct {
+	grpc.ClientStream
+}
+
+func (x *logStreamServiceStreamLogsInRangeClient) Recv() (*StreamLogsInRangeResponse, error) {
+	m := new(StreamLogsInRangeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// LogStreamServiceServer is the server API for LogStreamService service.
+type LogStreamServiceServer interface {
+	StreamLogsInRange(*iotexapi.GetLogsRequest, LogStreamService_StreamLogsInRangeServer) error
+}
+
+// UnimplementedLogStreamServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedLogStreamServiceServer struct {
+}
+
+func (*UnimplementedLogStreamServiceServer) StreamLogsInRange(*iotexapi.GetLogsRequest, LogStreamService_StreamLogsInRangeServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLogsInRange not implemented")
+}
+
+func RegisterLogStreamServiceServer(s *grpc.Server, srv LogStreamServiceServer) {
+	s.RegisterService(&_LogStreamService_serviceDesc, srv)
+}
+
+func _LogStreamService_StreamLogsInRange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(iotexapi.GetLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LogStreamServiceServer).StreamLogsInRange(m, &logStreamServiceStreamLogsInRangeServer{stream})
+}
+
+type LogStreamService_StreamLogsInRangeServer interface {
+	Send(*StreamLogsInRangeResponse) error
+	grpc.ServerStream
+}
+
+type logStreamServiceStreamLogsInRangeServer struct {
+	grpc.ServerStream
+}
+
+func (x *logStreamServiceStreamLogsInRangeServer) Send(m *StreamLogsInRangeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _LogStreamService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "apipb.LogStreamService",
+	HandlerType: (*LogStreamServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLogsInRange",
+			Handler:       _LogStreamService_StreamLogsInRange_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/apipb/logstream.proto",
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"strings"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-antenna-go/v2/jwt"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type (
+	// AuthConfig is the config of authenticating the clients calling the restricted methods
+	AuthConfig struct {
+		Enabled bool `yaml:"enabled"`
+		// RestrictedMethods are the methods allowed only to the authenticated clients, a name ending with "*"
+		// matches all the methods with the prefix
+		RestrictedMethods []string `yaml:"restrictedMethods"`
+		// AllowLists are the named lists of the restricted methods allowed, in the same format as RestrictedMethods
+		AllowLists map[string][]string `yaml:"allowLists"`
+		// APIKeys maps the static API keys to the names of their allow-lists
+		APIKeys map[string]string `yaml:"apiKeys"`
+		// JWTIssuers maps the addresses signing the JWTs to the names of their allow-lists
+		JWTIssuers map[string]string `yaml:"jwtIssuers"`
+	}
+
+	// Authenticator checks whether a client is allowed to call a restricted method
+	Authenticator struct {
+		restricted []string
+		apiKeys    map[string][]string
+		issuers    map[string][]string
+	}
+)
+
+// DefaultAuthConfig is the default config of authentication
+var DefaultAuthConfig = AuthConfig{
+	Enabled: false,
+	RestrictedMethods: []string{
+		"debug_*",
+		"eth_sendRawTransaction",
+		"SendAction",
+		"TraceTransactionStructLogs",
+	},
+	AllowLists: map[string][]string{},
+	APIKeys:    map[string]string{},
+	JWTIssuers: map[string]string{},
+}
+
+// NewAuthenticator creates an authenticator, which is nil if authentication is disabled
+func NewAuthenticator(cfg AuthConfig) (*Authenticator, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	a := &Authenticator{
+		restricted: cfg.RestrictedMethods,
+		apiKeys:    make(map[string][]string, len(cfg.APIKeys)),
+		issuers:    make(map[string][]string, len(cfg.JWTIssuers)),
+	}
+	for key, name := range cfg.APIKeys {
+		list, ok := cfg.AllowLists[name]
+		if !ok {
+			return nil, errors.Errorf("allow-list %s of API key is not defined", name)
+		}
+		a.apiKeys[key] = list
+	}
+	for issuer, name := range cfg.JWTIssuers {
+		list, ok := cfg.AllowLists[name]
+		if !ok {
+			return nil, errors.Errorf("allow-list %s of JWT issuer %s is not defined", name, issuer)
+		}
+		if _, err := address.FromString(issuer); err != nil {
+			return nil, errors.Wrapf(err, "invalid JWT issuer %s", issuer)
+		}
+		a.issuers[issuer] = list
+	}
+	return a, nil
+}
+
+// allowList returns the restricted methods allowed by the API key, or else by the JWT, and whether the client is
+// authenticated
+func (a *Authenticator) allowList(apiKey, token string) ([]string, bool, error) {
+	if apiKey != "" {
+		list, ok := a.apiKeys[apiKey]
+		if !ok {
+			return nil, false, status.Error(codes.Unauthenticated, "invalid API key")
+		}
+		return list, true, nil
+	}
+	if token == "" {
+		return nil, false, nil
+	}
+	claims, err := verifyJWT(token)
+	if err != nil {
+		return nil, false, status.Errorf(codes.Unauthenticated, "invalid JWT: %s", err.Error())
+	}
+	pk, err := crypto.HexStringToPublicKey(strings.TrimPrefix(strings.TrimPrefix(claims.Issuer, "0x"), "0X"))
+	if err != nil {
+		return nil, false, status.Errorf(codes.Unauthenticated, "invalid JWT issuer: %s", err.Error())
+	}
+	issuer := pk.Address().String()
+	list, ok := a.issuers[issuer]
+	if !ok {
+		return nil, false, status.Errorf(codes.Unauthenticated, "JWT issuer %s is not trusted", issuer)
+	}
+	return list, true, nil
+}
+
+// authorize checks whether the client is allowed to call the method
+func (a *Authenticator) authorize(c *client, method string) error {
+	if a == nil || !matchMethod(a.restricted, method) {
+		return nil
+	}
+	if c.authErr != nil {
+		return c.authErr
+	}
+	if !c.authenticated {
+		return status.Errorf(codes.Unauthenticated, "method %s requires authentication", method)
+	}
+	if !matchMethod(c.allowed, method) {
+		return status.Errorf(codes.PermissionDenied, "method %s is not allowed", method)
+	}
+	return nil
+}
+
+// verifyJWT verifies the JWT signed by its issuer, and recovers from the panic of VerifyJWT on a short issuer
+func verifyJWT(token string) (claims *jwt.JWT, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			claims, err = nil, errors.Errorf("malformed token: %v", r)
+		}
+	}()
+	return jwt.VerifyJWT(token)
+}
+
+func matchMethod(patterns []string, method string) bool {
+	for _, p := range patterns {
+		if p == method || (strings.HasSuffix(p, "*") && strings.HasPrefix(method, p[:len(p)-1])) {
+			return true
+		}
+	}
+	return false
+}
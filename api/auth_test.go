@@ -0,0 +1,160 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/iotex-antenna-go/v2/jwt"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_apicoreservice"
+)
+
+func testAuthConfig() Config {
+	cfg := DefaultConfig
+	cfg.Auth = AuthConfig{
+		Enabled:           true,
+		RestrictedMethods: DefaultAuthConfig.RestrictedMethods,
+		AllowLists: map[string][]string{
+			"partner": {"debug_*", "SendAction", "eth_sendRawTransaction"},
+			"tracer":  {"debug_traceCall"},
+		},
+		APIKeys: map[string]string{
+			"partner-key": "partner",
+			"tracer-key":  "tracer",
+		},
+		JWTIssuers: map[string]string{
+			identityset.Address(1).String(): "partner",
+		},
+	}
+	cfg.WebsocketAllowedOrigins = []string{"https://iotex.io"}
+	return cfg
+}
+
+func TestAuthenticator(t *testing.T) {
+	require := require.New(t)
+
+	a, err := NewAuthenticator(DefaultAuthConfig)
+	require.NoError(err)
+	require.Nil(a)
+	cfg := testAuthConfig().Auth
+	cfg.APIKeys = map[string]string{"key": "unknown"}
+	_, err = NewAuthenticator(cfg)
+	require.EqualError(err, "allow-list unknown of API key is not defined")
+	cfg = testAuthConfig().Auth
+	cfg.JWTIssuers = map[string]string{"io1invalid": "partner"}
+	_, err = NewAuthenticator(cfg)
+	require.ErrorContains(err, "invalid JWT issuer io1invalid")
+
+	ac, err := NewAccessControl(testAuthConfig())
+	require.NoError(err)
+	now := time.Now().Unix()
+	token := func(key int, exp int64) string {
+		tok, err := jwt.SignJWT(now, exp, "partner", "", identityset.PrivateKey(key))
+		require.NoError(err)
+		return tok
+	}
+	for _, c := range []struct {
+		apiKey, token, method string
+		code                  codes.Code
+	}{
+		{"", "", "eth_blockNumber", codes.OK},
+		{"", "", "debug_traceCall", codes.Unauthenticated},
+		{"", "", "SendAction", codes.Unauthenticated},
+		{"partner-key", "", "debug_traceTransaction", codes.OK},
+		{"partner-key", "", "eth_sendRawTransaction", codes.OK},
+		{"tracer-key", "", "debug_traceCall", codes.OK},
+		{"tracer-key", "", "debug_traceTransaction", codes.PermissionDenied},
+		{"tracer-key", "", "eth_blockNumber", codes.OK},
+		{"unknown-key", "", "debug_traceCall", codes.Unauthenticated},
+		{"unknown-key", "", "eth_blockNumber", codes.OK},
+		{"", token(1, 0), "SendAction", codes.OK},
+		{"", token(1, now+3600), "debug_traceCall", codes.OK},
+		{"", token(1, now-3600), "debug_traceCall", codes.Unauthenticated},
+		{"", token(2, 0), "debug_traceCall", codes.Unauthenticated},
+		{"", "malformed", "debug_traceCall", codes.Unauthenticated},
+		{"", "malformed", "eth_blockNumber", codes.OK},
+	} {
+		err := ac.check(ac.newClient(_protocolHTTP, "1.1.1.1:1000", c.apiKey, c.token), c.method)
+		require.Equal(c.code, status.Code(err), "%s %s", c.apiKey, c.method)
+	}
+	// a JWT with a short issuer does not panic
+	_, _, err = ac.auth.allowList("", "eyJhbGciOiJFUzI1NiIsInR5cCI6IkpXVCJ9.eyJpc3MiOiIwIn0.c2ln")
+	require.Equal(codes.Unauthenticated, status.Code(err))
+}
+
+func TestAuthHTTP(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	core.EXPECT().Track(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return().AnyTimes()
+
+	ac, err := NewAccessControl(testAuthConfig())
+	require.NoError(err)
+	svr := newHTTPHandler(NewWeb3Handler(core, "", _defaultBatchRequestLimit), ac)
+	req, _ := http.NewRequest(http.MethodPost, "http://url.com", strings.NewReader(`{"jsonrpc":"2.0","method":"debug_traceCall","params":[],"id":1}`))
+	req.Header.Set(_authorizationHeader, "Bearer malformed")
+	resp := httptest.NewRecorder()
+	svr.ServeHTTP(resp, req)
+	raw, _ := io.ReadAll(resp.Body)
+	res := gjson.ParseBytes(raw)
+	require.EqualValues(codes.Unauthenticated, res.Get("error.code").Int())
+	require.Contains(res.Get("error.message").String(), "invalid JWT")
+
+	// the origin of websocket is checked against the allow-list
+	for _, c := range []struct {
+		origin string
+		ok     bool
+	}{
+		{"", true},
+		{"https://iotex.io", true},
+		{"https://evil.io", false},
+	} {
+		req, _ := http.NewRequest(http.MethodGet, "http://url.com", nil)
+		if c.origin != "" {
+			req.Header.Set("Origin", c.origin)
+		}
+		require.Equal(c.ok, ac.checkOrigin(req), c.origin)
+	}
+	require.True((*AccessControl)(nil).checkOrigin(&http.Request{Header: http.Header{"Origin": {"https://evil.io"}}}))
+}
+
+func TestAuthGRPC(t *testing.T) {
+	require := require.New(t)
+
+	ac, err := NewAccessControl(testAuthConfig())
+	require.NoError(err)
+	interceptor := ac.UnaryServerInterceptor()
+	handler := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+	call := func(ctx context.Context, method string) error {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/iotexapi.APIService/" + method}, handler)
+		return err
+	}
+
+	require.NoError(call(context.Background(), "GetChainMeta"))
+	require.Equal(codes.Unauthenticated, status.Code(call(context.Background(), "SendAction")))
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-api-key", "tracer-key"))
+	require.Equal(codes.PermissionDenied, status.Code(call(ctx, "SendAction")))
+	tok, err := jwt.SignJWT(time.Now().Unix(), 0, "", "", identityset.PrivateKey(1))
+	require.NoError(err)
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+tok))
+	require.NoError(call(ctx, "SendAction"))
+}
@@ -16,6 +16,7 @@ type Config struct {
 	GRPCPort        int               `yaml:"port"`
 	HTTPPort        int               `yaml:"web3port"`
 	WebSocketPort   int               `yaml:"webSocketPort"`
+	GraphQLPort     int               `yaml:"graphqlPort"`
 	RedisCacheURL   string            `yaml:"redisCacheURL"`
 	TpsWindow       int               `yaml:"tpsWindow"`
 	GasStation      gasstation.Config `yaml:"gasStation"`
@@ -23,16 +24,34 @@ type Config struct {
 	Tracer          tracer.Config     `yaml:"tracer"`
 	// BatchRequestLimit is the maximum number of requests in a batch.
 	BatchRequestLimit int `yaml:"batchRequestLimit"`
+	// APIKeyHeader is the http header, or the gRPC metadata, carrying the API key of a client
+	APIKeyHeader string `yaml:"apiKeyHeader"`
+	// RateLimit is the config of limiting the requests of each client
+	RateLimit RateLimitConfig `yaml:"rateLimit"`
+	// Auth is the config of authenticating the clients calling the restricted methods
+	Auth AuthConfig `yaml:"auth"`
+	// WebsocketAllowedOrigins are the origins allowed to open websocket connections from browsers, "*" for any
+	WebsocketAllowedOrigins []string `yaml:"websocketAllowedOrigins"`
+	// Filter is the config of the filters installed by the clients
+	Filter FilterConfig `yaml:"filter"`
+	// SlowRequest is the config of logging the slow requests
+	SlowRequest SlowRequestConfig `yaml:"slowRequest"`
 }
 
 // DefaultConfig is the default config
 var DefaultConfig = Config{
-	UseRDS:            false,
-	GRPCPort:          14014,
-	HTTPPort:          15014,
-	WebSocketPort:     16014,
-	TpsWindow:         10,
-	GasStation:        gasstation.DefaultConfig,
-	RangeQueryLimit:   1000,
-	BatchRequestLimit: _defaultBatchRequestLimit,
+	UseRDS:                  false,
+	GRPCPort:                14014,
+	HTTPPort:                15014,
+	WebSocketPort:           16014,
+	TpsWindow:               10,
+	GasStation:              gasstation.DefaultConfig,
+	RangeQueryLimit:         1000,
+	BatchRequestLimit:       _defaultBatchRequestLimit,
+	APIKeyHeader:            "X-API-Key",
+	RateLimit:               DefaultRateLimitConfig,
+	Auth:                    DefaultAuthConfig,
+	WebsocketAllowedOrigins: []string{"*"},
+	Filter:                  DefaultFilterConfig,
+	SlowRequest:             DefaultSlowRequestConfig,
 }
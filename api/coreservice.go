@@ -12,10 +12,14 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"reflect"
 	"strconv"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 
 	// Force-load the tracer engines to trigger registration
@@ -57,6 +61,8 @@ import (
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/blockindex"
 	"github.com/iotexproject/iotex-core/blocksync"
+	"github.com/iotexproject/iotex-core/consensus"
+	"github.com/iotexproject/iotex-core/consensus/scheme"
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/gasstation"
 	"github.com/iotexproject/iotex-core/pkg/log"
@@ -73,6 +79,14 @@ const (
 	// defaultTraceTimeout is the amount of time a single transaction can execute
 	// by default before being forcefully aborted.
 	defaultTraceTimeout = 5 * time.Second
+
+	// _streamLogsWindow is the number of blocks filtered by the bloom filters at a time when streaming logs, each
+	// of which is charged as a call of _streamLogsMethod
+	_streamLogsWindow = 10000
+	_streamLogsMethod = "StreamLogsInRange"
+
+	// _maxAccessListIterations is the maximum number of executions to find the access list of an execution
+	_maxAccessListIterations = 10
 )
 
 type (
@@ -80,6 +94,8 @@ type (
 	CoreService interface {
 		// Account returns the metadata of an account
 		Account(addr address.Address) (*iotextypes.AccountMeta, *iotextypes.BlockIdentifier, error)
+		// AccountAtHeight returns the metadata of an account at a past height in archive mode
+		AccountAtHeight(addr address.Address, height uint64) (*iotextypes.AccountMeta, *iotextypes.BlockIdentifier, error)
 		// ChainMeta returns blockchain metadata
 		ChainMeta() (*iotextypes.ChainMeta, string, error)
 		// ServerMeta gets the server metadata
@@ -118,6 +134,8 @@ type (
 		Action(actionHash string, checkPending bool) (*iotexapi.ActionInfo, error)
 		// ActionsByAddress returns all actions associated with an address
 		ActionsByAddress(addr address.Address, start uint64, count uint64) ([]*iotexapi.ActionInfo, error)
+		// ActionsByAddressWithCursor returns a page of the actions associated with an address, and the cursor of the next page
+		ActionsByAddressWithCursor(addr address.Address, query *apitypes.ActionHistoryQuery) ([]*iotexapi.ActionInfo, string, error)
 		// ActionByActionHash returns action by action hash
 		ActionByActionHash(h hash.Hash256) (*action.SealedEnvelope, hash.Hash256, uint64, uint32, error)
 		// PendingActionByActionHash returns action by action hash
@@ -140,6 +158,9 @@ type (
 		LogsInBlockByHash(filter *logfilter.LogFilter, blockHash hash.Hash256) ([]*action.Log, error)
 		// LogsInRange filter logs among [start, end] blocks
 		LogsInRange(filter *logfilter.LogFilter, start, end, paginationSize uint64) ([]*action.Log, []hash.Hash256, error)
+		// StreamLogsInRange walks the blocks among [start, end] and sends the logs found in each block, along with
+		// the height to resume from, until all the blocks are walked or the context is done
+		StreamLogsInRange(ctx context.Context, filter *logfilter.LogFilter, start, end uint64, send func(logs []*action.Log, blkHash hash.Hash256, next uint64) error) error
 		// Genesis returns the genesis of the chain
 		Genesis() genesis.Genesis
 		// EVMNetworkID returns the network id of evm
@@ -148,10 +169,18 @@ type (
 		ChainID() uint32
 		// ReadContractStorage reads contract's storage
 		ReadContractStorage(ctx context.Context, addr address.Address, key []byte) ([]byte, error)
+		// ReadContractStorageAtHeight reads contract's storage at a past height in archive mode
+		ReadContractStorageAtHeight(ctx context.Context, addr address.Address, key []byte, height uint64) ([]byte, error)
 		// ChainListener returns the instance of Listener
 		ChainListener() apitypes.Listener
 		// SimulateExecution simulates execution
 		SimulateExecution(context.Context, address.Address, *action.Execution) ([]byte, *action.Receipt, error)
+		// SimulateExecutions simulates a sequence of executions in one working set, with the state overridden before
+		// the first one, and the executions split into the blocks one after another
+		SimulateExecutions(context.Context, []address.Address, []*action.Execution, evm.StateOverride, []evm.SimulationBlock) ([][]byte, []*action.Receipt, error)
+		// CreateAccessList returns the access list of the execution, along with the receipt of the execution with the
+		// list and the gas used without it
+		CreateAccessList(context.Context, address.Address, *action.Execution) (types.AccessList, *action.Receipt, uint64, error)
 		// SyncingProgress returns the syncing status of node
 		SyncingProgress() (uint64, uint64, uint64)
 		// TipHeight returns the tip of the chain
@@ -175,6 +204,17 @@ type (
 			data []byte,
 			config *tracers.TraceConfig) ([]byte, *action.Receipt, any, error)
 
+		// ConsensusTimeline returns the consensus timeline of a recent height
+		ConsensusTimeline(height uint64) (*scheme.ConsensusTimeline, error)
+		// DevMine mints a block at once in dev mode, from the timestamp if it is not zero
+		DevMine(timestamp time.Time) error
+		// DevIncreaseTime moves the time of the following blocks forward in dev mode, and returns the total time moved
+		DevIncreaseTime(delta time.Duration) (time.Duration, error)
+		// DevSnapshot takes a snapshot of the chain in dev mode
+		DevSnapshot() (uint64, error)
+		// DevRevert reverts the chain to the snapshot in dev mode, and returns false if the snapshot does not exist
+		DevRevert(id uint64) (bool, error)
+
 		// Track tracks the api call
 		Track(ctx context.Context, start time.Time, method string, size int64, success bool)
 	}
@@ -199,6 +239,7 @@ type (
 		apiStats          *nodestats.APILocalStats
 		sgdIndexer        blockindex.SGDRegistry
 		getBlockTime      evm.GetBlockTime
+		consensus         consensus.Consensus
 	}
 
 	// jobDesc provides a struct to get and store logs in core.LogsInRange
@@ -242,6 +283,13 @@ func WithSGDIndexer(sgdIndexer blockindex.SGDRegistry) Option {
 	}
 }
 
+// WithConsensus is the option to inspect the consensus of the node
+func WithConsensus(cons consensus.Consensus) Option {
+	return func(svr *coreService) {
+		svr.consensus = cons
+	}
+}
+
 type intrinsicGasCalculator interface {
 	IntrinsicGas() (uint64, error)
 }
@@ -265,7 +313,7 @@ func newCoreService(
 	getBlockTime evm.GetBlockTime,
 	opts ...Option,
 ) (CoreService, error) {
-	if cfg == (Config{}) {
+	if reflect.DeepEqual(cfg, Config{}) {
 		log.L().Warn("API server is not configured.")
 		cfg = DefaultConfig
 	}
@@ -359,6 +407,45 @@ func (core *coreService) Account(addr address.Address) (*iotextypes.AccountMeta,
 	}, nil
 }
 
+// AccountAtHeight returns the metadata of an account at a past height in archive mode
+func (core *coreService) AccountAtHeight(addr address.Address, height uint64) (*iotextypes.AccountMeta, *iotextypes.BlockIdentifier, error) {
+	if height > core.bc.TipHeight() {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "height %d is higher than tip height", height)
+	}
+	ctx := genesis.WithGenesisContext(context.Background(), core.bc.Genesis())
+	state, err := accountutil.AccountState(ctx, factory.NewHistoryStateReader(core.sf, height), addr)
+	if err != nil {
+		if errors.Cause(err) == factory.ErrNoArchiveData {
+			return nil, nil, status.Error(codes.Unavailable, err.Error())
+		}
+		return nil, nil, status.Error(codes.NotFound, err.Error())
+	}
+	accountMeta := &iotextypes.AccountMeta{
+		Address:      addr.String(),
+		Balance:      state.Balance.String(),
+		PendingNonce: state.PendingNonce(),
+		IsContract:   state.IsContract(),
+	}
+	if state.IsContract() {
+		// the code is stored by its hash, which never changes
+		var code protocol.SerializableBytes
+		_, err = core.sf.State(&code, protocol.NamespaceOption(evm.CodeKVNameSpace), protocol.KeyOption(state.CodeHash))
+		if err != nil {
+			return nil, nil, status.Error(codes.NotFound, err.Error())
+		}
+		accountMeta.ContractByteCode = code
+	}
+	header, err := core.bc.BlockHeaderByHeight(height)
+	if err != nil {
+		return nil, nil, status.Error(codes.NotFound, err.Error())
+	}
+	hash := header.HashBlock()
+	return accountMeta, &iotextypes.BlockIdentifier{
+		Hash:   hex.EncodeToString(hash[:]),
+		Height: height,
+	}, nil
+}
+
 // ChainMeta returns blockchain metadata
 func (core *coreService) ChainMeta() (*iotextypes.ChainMeta, string, error) {
 	tipHeight := core.bc.TipHeight()
@@ -445,6 +532,8 @@ func (core *coreService) ServerMeta() (packageVersion string, packageCommitID st
 
 // SendAction is the API to send an action to blockchain.
 func (core *coreService) SendAction(ctx context.Context, in *iotextypes.Action) (string, error) {
+	ctx, span := tracer.NewSpan(ctx, "coreService.SendAction")
+	defer span.End()
 	log.Logger("api").Debug("receive send action request")
 	selp, err := (&action.Deserializer{}).SetEvmNetworkID(core.EVMNetworkID()).ActionToSealedEnvelope(in)
 	if err != nil {
@@ -526,10 +615,14 @@ func (core *coreService) validateChainID(chainID uint32) error {
 
 // ReadContract reads the state in a contract address specified by the slot
 func (core *coreService) ReadContract(ctx context.Context, callerAddr address.Address, sc *action.Execution) (string, *iotextypes.Receipt, error) {
+	ctx, span := tracer.NewSpan(ctx, "coreService.ReadContract")
+	defer span.End()
 	log.Logger("api").Debug("receive read smart contract request")
 	key := hash.Hash160b(append([]byte(sc.Contract()), sc.Data()...))
+	// the result on an overridden state is not cached
+	_, overridden := evm.GetSimulationCtx(ctx)
 	// TODO: either moving readcache into the upper layer or change the storage format
-	if d, ok := core.readCache.Get(key); ok {
+	if d, ok := core.readCache.Get(key); ok && !overridden {
 		res := iotexapi.ReadContractResponse{}
 		if err := proto.Unmarshal(d, &res); err == nil {
 			return res.Data, res.Receipt, nil
@@ -572,7 +665,7 @@ func (core *coreService) ReadContract(ctx context.Context, callerAddr address.Ad
 		Data:    hex.EncodeToString(retval),
 		Receipt: receipt.ConvertToReceiptPb(),
 	}
-	if d, err := proto.Marshal(&res); err == nil {
+	if d, err := proto.Marshal(&res); err == nil && !overridden {
 		core.readCache.Put(key, d)
 	}
 	return res.Data, res.Receipt, nil
@@ -1462,6 +1555,59 @@ func (core *coreService) LogsInRange(filter *logfilter.LogFilter, start, end, pa
 	return logs, hashes, nil
 }
 
+// StreamLogsInRange walks the blocks among [start, end] window by window. The logs of a block are sent at once,
+// and a window without logs at its end is sent with no logs, so that the height to resume from keeps moving. The
+// opening of the stream pays for the first window, and each of the following windows is charged before it is walked.
+func (core *coreService) StreamLogsInRange(ctx context.Context, filter *logfilter.LogFilter, start, end uint64, send func([]*action.Log, hash.Hash256, uint64) error) error {
+	start, end, err := core.correctQueryRange(start, end)
+	if err != nil {
+		return err
+	}
+	for from := start; from <= end; {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if from != start {
+			if err := checkAccess(ctx, _streamLogsMethod); err != nil {
+				return err
+			}
+		}
+		to := from + _streamLogsWindow - 1
+		if to > end {
+			to = end
+		}
+		blockNumbers, err := core.bfIndexer.FilterBlocksInRange(filter, from, to, 0)
+		if err != nil {
+			return err
+		}
+		sent := from - 1
+		for _, blkNum := range blockNumbers {
+			logs, err := core.logsInBlock(filter, blkNum)
+			if err != nil {
+				return err
+			}
+			if len(logs) == 0 {
+				continue
+			}
+			blkHash, err := core.dao.GetBlockHash(blkNum)
+			if err != nil {
+				return err
+			}
+			if err := send(logs, blkHash, blkNum+1); err != nil {
+				return err
+			}
+			sent = blkNum
+		}
+		if sent != to {
+			if err := send(nil, hash.ZeroHash256, to+1); err != nil {
+				return err
+			}
+		}
+		from = to + 1
+	}
+	return nil
+}
+
 func (core *coreService) correctQueryRange(start, end uint64) (uint64, uint64, error) {
 	if start == 0 {
 		start = core.bc.TipHeight()
@@ -1492,6 +1638,8 @@ func (core *coreService) EstimateGasForNonExecution(actType action.Action) (uint
 
 // EstimateExecutionGasConsumption estimate gas consumption for execution action
 func (core *coreService) EstimateExecutionGasConsumption(ctx context.Context, sc *action.Execution, callerAddr address.Address) (uint64, error) {
+	ctx, span := tracer.NewSpan(ctx, "coreService.EstimateExecutionGasConsumption")
+	defer span.End()
 	ctx = genesis.WithGenesisContext(ctx, core.bc.Genesis())
 	state, err := accountutil.AccountState(ctx, core.sf, callerAddr)
 	if err != nil {
@@ -1690,6 +1838,8 @@ func (core *coreService) ChainID() uint32 {
 
 // ReadContractStorage reads contract's storage
 func (core *coreService) ReadContractStorage(ctx context.Context, addr address.Address, key []byte) ([]byte, error) {
+	ctx, span := tracer.NewSpan(ctx, "coreService.ReadContractStorage")
+	defer span.End()
 	ctx, err := core.bc.Context(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -1697,6 +1847,19 @@ func (core *coreService) ReadContractStorage(ctx context.Context, addr address.A
 	return core.sf.ReadContractStorage(ctx, addr, key)
 }
 
+// ReadContractStorageAtHeight reads contract's storage at a past height in archive mode
+func (core *coreService) ReadContractStorageAtHeight(ctx context.Context, addr address.Address, key []byte, height uint64) ([]byte, error) {
+	ctx, err := core.bc.Context(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	b, err := core.sf.ReadContractStorageAtHeight(ctx, height, addr, key)
+	if errors.Cause(err) == factory.ErrNoArchiveData {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return b, err
+}
+
 func (core *coreService) ReceiveBlock(blk *block.Block) error {
 	core.readCache.Clear()
 	return core.chainListener.ReceiveBlock(blk)
@@ -1731,6 +1894,186 @@ func (core *coreService) SimulateExecution(ctx context.Context, addr address.Add
 	return core.simulateExecution(ctx, addr, exec, core.dao.GetBlockHash, core.getBlockTime)
 }
 
+func (core *coreService) SimulateExecutions(ctx context.Context, callers []address.Address, execs []*action.Execution, stateOverride evm.StateOverride, blocks []evm.SimulationBlock) ([][]byte, []*action.Receipt, error) {
+	ctx, span := tracer.NewSpan(ctx, "coreService.SimulateExecutions")
+	defer span.End()
+	if len(callers) != len(execs) {
+		return nil, nil, errors.Errorf("%d callers of %d executions", len(callers), len(execs))
+	}
+	ctx = genesis.WithGenesisContext(ctx, core.bc.Genesis())
+	ctx, err := core.bc.Context(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx = protocol.WithFeatureCtx(protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+		BlockHeight: core.bc.TipHeight(),
+	}))
+	// the executions of a block take at most the gas of a block, which the block override can lower but not raise
+	g := core.bc.Genesis()
+	if len(blocks) == 0 {
+		blocks = []evm.SimulationBlock{{Size: len(execs)}}
+	}
+	var start int
+	for _, blk := range blocks {
+		if blk.Size <= 0 || start+blk.Size > len(execs) {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "invalid size %d of a block", blk.Size)
+		}
+		gasCap := g.BlockGasLimitByHeight(core.bc.TipHeight())
+		if blk.Override != nil && blk.Override.GasLimit != nil && *blk.Override.GasLimit < gasCap {
+			gasCap = *blk.Override.GasLimit
+		}
+		if err := allotBundleGas(execs[start:start+blk.Size], gasCap); err != nil {
+			return nil, nil, err
+		}
+		start += blk.Size
+	}
+	if start != len(execs) {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "%d executions in the blocks of %d executions", start, len(execs))
+	}
+	nonces := make(map[string]uint64)
+	for i, exec := range execs {
+		caller := callers[i].String()
+		nonce, ok := nonces[caller]
+		if !ok {
+			if nonce, err = core.simulationNonce(ctx, callers[i], stateOverride); err != nil {
+				return nil, nil, err
+			}
+		}
+		// the nonce of the caller moves on with each of its executions
+		nonces[caller] = nonce + 1
+		exec.SetNonce(nonce)
+	}
+	ctx = evm.WithHelperCtx(ctx, evm.HelperContext{
+		GetBlockHash:   core.dao.GetBlockHash,
+		GetBlockTime:   core.getBlockTime,
+		DepositGasFunc: rewarding.DepositGasWithSGD,
+		Sgd:            core.sgdIndexer,
+	})
+	ctx = evm.WithSimulationCtx(ctx, evm.SimulationContext{
+		StateOverride: stateOverride,
+		Blocks:        blocks,
+	})
+	return core.sf.SimulateExecutions(ctx, callers, execs)
+}
+
+// allotBundleGas checks that the total gas limit of the executions is within the cap, and shares the gas left by the
+// executions with a gas limit among the ones without
+func allotBundleGas(execs []*action.Execution, gasCap uint64) error {
+	var (
+		total     uint64
+		unlimited int
+	)
+	for _, exec := range execs {
+		if exec.GasLimit() == 0 {
+			unlimited++
+			continue
+		}
+		if total += exec.GasLimit(); total > gasCap {
+			return status.Errorf(codes.InvalidArgument, "total gas of the bundle exceeds the cap %d", gasCap)
+		}
+	}
+	if unlimited == 0 {
+		return nil
+	}
+	share := (gasCap - total) / uint64(unlimited)
+	if share == 0 {
+		return status.Errorf(codes.InvalidArgument, "no gas left within the cap %d", gasCap)
+	}
+	for _, exec := range execs {
+		if exec.GasLimit() == 0 {
+			exec.SetGasLimit(share)
+		}
+	}
+	return nil
+}
+
+func (core *coreService) CreateAccessList(ctx context.Context, callerAddr address.Address, exec *action.Execution) (types.AccessList, *action.Receipt, uint64, error) {
+	ctx, span := tracer.NewSpan(ctx, "coreService.CreateAccessList")
+	defer span.End()
+	// the access list is priced by the gas, so the execution is read-only like ReadContract()
+	newExecution := func(list types.AccessList) (*action.Execution, error) {
+		return action.NewExecutionWithAccessList(exec.Contract(), 0, exec.Amount(), exec.GasLimit(), big.NewInt(0), exec.Data(), list)
+	}
+	sc, err := newExecution(nil)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	_, receipt, err := core.SimulateExecution(ctx, callerAddr, sc)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	gasWithoutList := receipt.GasConsumed
+
+	// the sender, the recipient and the precompiles active at the simulated height are warm anyway, so they are
+	// excluded from the list
+	precompiles, err := core.simulationPrecompiles(ctx)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	var (
+		from = common.BytesToAddress(callerAddr.Bytes())
+		to   common.Address
+	)
+	if exec.Contract() == action.EmptyAddress {
+		// the nonce of the sender is set by the simulation
+		to = crypto.CreateAddress(from, sc.Nonce())
+	} else {
+		contract, err := address.FromString(exec.Contract())
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		to = common.BytesToAddress(contract.Bytes())
+	}
+	prevTracer := logger.NewAccessListTracer(exec.AccessList(), from, to, precompiles)
+	for i := 0; i < _maxAccessListIterations; i++ {
+		list := prevTracer.AccessList()
+		if sc, err = newExecution(list); err != nil {
+			return nil, nil, 0, err
+		}
+		tracer := logger.NewAccessListTracer(list, from, to, precompiles)
+		_, receipt, err = core.SimulateExecution(protocol.WithVMConfigCtx(ctx, vm.Config{
+			Tracer:    tracer,
+			NoBaseFee: true,
+		}), callerAddr, sc)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		// the list converges once running with it touches nothing more
+		if tracer.Equal(prevTracer) {
+			return list, receipt, gasWithoutList, nil
+		}
+		prevTracer = tracer
+	}
+	return nil, nil, 0, errors.Errorf("access list does not converge in %d iterations", _maxAccessListIterations)
+}
+
+// simulationPrecompiles returns the precompiles active in the block simulated by SimulateExecution()
+func (core *coreService) simulationPrecompiles(ctx context.Context) ([]common.Address, error) {
+	ctx, err := core.bc.Context(genesis.WithGenesisContext(ctx, core.bc.Genesis()))
+	if err != nil {
+		return nil, err
+	}
+	return evm.SimulationPrecompiles(evm.WithHelperCtx(ctx, evm.HelperContext{
+		GetBlockHash: core.dao.GetBlockHash,
+		GetBlockTime: core.getBlockTime,
+	}))
+}
+
+// simulationNonce returns the nonce of the first execution of the caller in a simulation
+func (core *coreService) simulationNonce(ctx context.Context, caller address.Address, stateOverride evm.StateOverride) (uint64, error) {
+	if override, ok := stateOverride[common.BytesToAddress(caller.Bytes())]; ok && override != nil && override.Nonce != nil {
+		return *override.Nonce, nil
+	}
+	state, err := accountutil.AccountState(ctx, core.sf, caller)
+	if err != nil {
+		return 0, err
+	}
+	if protocol.MustGetFeatureCtx(ctx).RefactorFreshAccountConversion {
+		return state.PendingNonceConsideringFreshAccount(), nil
+	}
+	return state.PendingNonce(), nil
+}
+
 // SyncingProgress returns the syncing status of node
 func (core *coreService) SyncingProgress() (uint64, uint64, uint64) {
 	startingHeight, currentHeight, targetHeight, _ := core.bs.SyncStatus()
@@ -1739,6 +2082,8 @@ func (core *coreService) SyncingProgress() (uint64, uint64, uint64) {
 
 // TraceTransaction returns the trace result of transaction
 func (core *coreService) TraceTransaction(ctx context.Context, actHash string, config *tracers.TraceConfig) ([]byte, *action.Receipt, any, error) {
+	ctx, span := tracer.NewSpan(ctx, "coreService.TraceTransaction")
+	defer span.End()
 	actInfo, err := core.Action(util.Remove0xPrefix(actHash), false)
 	if err != nil {
 		return nil, nil, nil, err
@@ -1769,6 +2114,8 @@ func (core *coreService) TraceCall(ctx context.Context,
 	gasLimit uint64,
 	data []byte,
 	config *tracers.TraceConfig) ([]byte, *action.Receipt, any, error) {
+	ctx, span := tracer.NewSpan(ctx, "coreService.TraceCall")
+	defer span.End()
 	var (
 		g             = core.bc.Genesis()
 		blockGasLimit = g.BlockGasLimitByHeight(core.bc.TipHeight())
@@ -1813,6 +2160,83 @@ func (core *coreService) TraceCall(ctx context.Context,
 	return retval, receipt, tracer, err
 }
 
+// ConsensusTimeline returns the consensus timeline of a recent height
+func (core *coreService) ConsensusTimeline(height uint64) (*scheme.ConsensusTimeline, error) {
+	if core.consensus == nil {
+		return nil, status.Error(codes.Unavailable, "consensus is not available")
+	}
+	timeline, err := core.consensus.Timeline(height)
+	if err != nil {
+		if errors.Cause(err) == scheme.ErrTimelineNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return timeline, nil
+}
+
+// DevMine mints a block at once in dev mode, from the timestamp if it is not zero
+func (core *coreService) DevMine(timestamp time.Time) error {
+	dev, err := core.devChain()
+	if err != nil {
+		return err
+	}
+	if err := dev.Mine(timestamp); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// DevIncreaseTime moves the time of the following blocks forward in dev mode, and returns the total time moved
+func (core *coreService) DevIncreaseTime(delta time.Duration) (time.Duration, error) {
+	dev, err := core.devChain()
+	if err != nil {
+		return 0, err
+	}
+	offset, err := dev.IncreaseTime(delta)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return offset, nil
+}
+
+// DevSnapshot takes a snapshot of the chain in dev mode
+func (core *coreService) DevSnapshot() (uint64, error) {
+	dev, err := core.devChain()
+	if err != nil {
+		return 0, err
+	}
+	return dev.Snapshot(), nil
+}
+
+// DevRevert reverts the chain to the snapshot in dev mode, and returns false if the snapshot does not exist
+func (core *coreService) DevRevert(id uint64) (bool, error) {
+	dev, err := core.devChain()
+	if err != nil {
+		return false, err
+	}
+	ok, err := dev.Revert(id)
+	if err != nil {
+		return false, status.Error(codes.Internal, err.Error())
+	}
+	if ok {
+		// the cached results may be read from the reverted blocks
+		core.readCache.Clear()
+	}
+	return ok, nil
+}
+
+func (core *coreService) devChain() (*scheme.DevChain, error) {
+	if core.consensus == nil {
+		return nil, status.Error(codes.Unavailable, "consensus is not available")
+	}
+	dev, err := core.consensus.DevChain()
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return dev, nil
+}
+
 // Track tracks the api call
 func (core *coreService) Track(ctx context.Context, start time.Time, method string, size int64, success bool) {
 	if core.apiStats == nil {
@@ -14,16 +14,23 @@ import (
 	"time"
 
 	. "github.com/agiledragon/gomonkey/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	"github.com/iotexproject/iotex-core/actpool"
 	"github.com/iotexproject/iotex-core/api/logfilter"
 	"github.com/iotexproject/iotex-core/blockchain"
@@ -161,8 +168,10 @@ func getTopicsAddress(addr []string, topics [][]string) (*iotexapi.LogsFilter, e
 }
 
 func setupTestCoreService() (CoreService, blockchain.Blockchain, blockdao.BlockDAO, actpool.ActPool, func()) {
-	cfg := newConfig()
+	return setupTestCoreServiceWithConfig(newConfig())
+}
 
+func setupTestCoreServiceWithConfig(cfg testConfig) (CoreService, blockchain.Blockchain, blockdao.BlockDAO, actpool.ActPool, func()) {
 	// TODO (zhi): revise
 	bc, dao, indexer, bfIndexer, sf, ap, registry, bfIndexFile, err := setupChain(cfg)
 	if err != nil {
@@ -237,6 +246,90 @@ func TestEstimateExecutionGasConsumption(t *testing.T) {
 
 }
 
+func TestAllotBundleGas(t *testing.T) {
+	require := require.New(t)
+	bundle := func(gasLimits ...uint64) []*action.Execution {
+		execs := make([]*action.Execution, 0, len(gasLimits))
+		for _, gas := range gasLimits {
+			exec, err := action.NewExecution("", 0, big.NewInt(0), gas, big.NewInt(0), nil)
+			require.NoError(err)
+			execs = append(execs, exec)
+		}
+		return execs
+	}
+	gasLimits := func(execs []*action.Execution) []uint64 {
+		ret := make([]uint64, 0, len(execs))
+		for _, exec := range execs {
+			ret = append(ret, exec.GasLimit())
+		}
+		return ret
+	}
+
+	execs := bundle(100, 200)
+	require.NoError(allotBundleGas(execs, 300))
+	require.Equal([]uint64{100, 200}, gasLimits(execs))
+	// the gas left is shared by the executions without a gas limit
+	execs = bundle(0, 400, 0)
+	require.NoError(allotBundleGas(execs, 1000))
+	require.Equal([]uint64{300, 400, 300}, gasLimits(execs))
+	// the total gas of the bundle is capped
+	require.Equal(codes.InvalidArgument, status.Code(allotBundleGas(bundle(200, 200), 300)))
+	require.Equal(codes.InvalidArgument, status.Code(allotBundleGas(bundle(300, 0), 300)))
+}
+
+func TestCreateAccessList(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+	// the access list is priced since the Okhotsk height, and the heights before it are activated first
+	cfg.genesis.AleutianBlockHeight = 1
+	cfg.genesis.BeringBlockHeight = 1
+	cfg.genesis.CookBlockHeight = 1
+	cfg.genesis.DardanellesBlockHeight = 1
+	cfg.genesis.DaytonaBlockHeight = 1
+	cfg.genesis.EasterBlockHeight = 1
+	cfg.genesis.FbkMigrationBlockHeight = 1
+	cfg.genesis.FairbankBlockHeight = 1
+	cfg.genesis.GreenlandBlockHeight = 1
+	cfg.genesis.HawaiiBlockHeight = 1
+	cfg.genesis.IcelandBlockHeight = 1
+	cfg.genesis.JutlandBlockHeight = 1
+	cfg.genesis.KamchatkaBlockHeight = 1
+	cfg.genesis.LordHoweBlockHeight = 1
+	cfg.genesis.MidwayBlockHeight = 1
+	cfg.genesis.NewfoundlandBlockHeight = 1
+	cfg.genesis.OkhotskBlockHeight = 1
+	svr, _, _, _, cleanCallback := setupTestCoreServiceWithConfig(cfg)
+	defer cleanCallback()
+
+	// a simple storage contract, of which get() reads the slot 0
+	code, err := hex.DecodeString("6080604052600436106049576000357c0100000000000000000000000000000000000000000000000000000000900463ffffffff16806360fe47b114604e5780636d4ce63c146078575b600080fd5b348015605957600080fd5b5060766004803603810190808035906020019092919050505060a0565b005b348015608357600080fd5b50608a60aa565b6040518082815260200191505060405180910390f35b8060008190555050565b600080549050905600a165627a7a7230582002faabbefbbda99b20217cf33cb8ab8100caf1542bf1f48117d72e2c59139aea0029")
+	require.NoError(err)
+	contract := identityset.Address(30)
+	ctx := evm.WithSimulationCtx(context.Background(), evm.SimulationContext{
+		StateOverride: evm.StateOverride{
+			common.BytesToAddress(contract.Bytes()): &evm.AccountOverride{Code: code},
+		},
+	})
+	sc, err := action.NewExecution(contract.String(), 0, big.NewInt(0), 0, big.NewInt(0), []byte{0x6d, 0x4c, 0xe6, 0x3c})
+	require.NoError(err)
+	list, receipt, gasWithoutList, err := svr.CreateAccessList(ctx, identityset.Address(29), sc)
+	require.NoError(err)
+	require.Equal(types.AccessList{{
+		Address:     common.BytesToAddress(contract.Bytes()),
+		StorageKeys: []common.Hash{{}},
+	}}, list)
+	require.EqualValues(iotextypes.ReceiptStatus_Success, receipt.Status)
+	// the list costs the gas of an address and a key, while the slot read with it is warm
+	require.Equal(gasWithoutList+action.TxAccessListAddressGas+action.TxAccessListStorageKeyGas-
+		(params.ColdSloadCostEIP2929-params.WarmStorageReadCostEIP2929), receipt.GasConsumed)
+
+	// the precompiles excluded from the list are the Berlin ones, since Cancun and the staking precompile are not
+	// activated at the simulated height
+	precompiles, err := svr.(*coreService).simulationPrecompiles(ctx)
+	require.NoError(err)
+	require.Equal(vm.PrecompiledAddressesBerlin, precompiles)
+}
+
 func TestTraceTransaction(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
@@ -0,0 +1,236 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/routine"
+)
+
+const (
+	_filterNS          = "filters"
+	_clientFilterKey   = "client:"
+	_filterExpireAtLen = 8
+)
+
+type (
+	// FilterConfig is the config of the filters installed by eth_newFilter and eth_newBlockFilter
+	FilterConfig struct {
+		// DBPath is the path of the local db storing the filters, such as filter.db under the data dir of the node,
+		// for the filters to survive restarts. The filters are kept in memory if it is empty, which is the default,
+		// and stored in redis instead if UseRDS is set or RedisCacheURL is not empty.
+		DBPath string `yaml:"dbPath"`
+		// Expiry is the duration after which a filter which is not polled is removed
+		Expiry time.Duration `yaml:"expiry"`
+		// MaxPerClient is the maximum number of filters installed by a client, 0 for no limit. A client is identified
+		// by its valid API key, or else by its IP, so behind a load balancer or a proxy all the clients without an API
+		// key share the IP of the proxy, and MaxPerClient becomes a cap of the filters of all of them.
+		MaxPerClient int `yaml:"maxPerClient"`
+	}
+
+	// filterStore stores the filters and the cursors of their changes, and bounds the filters of each client
+	filterStore struct {
+		cache        apiCache
+		maxPerClient int
+		mutex        sync.Mutex
+	}
+
+	// kvCache stores the data in a KVStore, which expires if not accessed for expireTime
+	kvCache struct {
+		kvStore    db.KVStore
+		expireTime time.Duration
+		sweeper    *routine.RecurringTask
+	}
+)
+
+var (
+	// DefaultFilterConfig is the default config of the filters
+	DefaultFilterConfig = FilterConfig{
+		Expiry:       15 * time.Minute,
+		MaxPerClient: 100,
+	}
+
+	errTooManyFilters = errors.New("too many filters installed by the client")
+)
+
+// newFilterCache creates the cache of the filters in the backend chosen by the config
+func newFilterCache(cfg Config) apiCache {
+	if cfg.Filter.Expiry <= 0 {
+		cfg.Filter.Expiry = DefaultFilterConfig.Expiry
+	}
+	if cfg.UseRDS || cfg.RedisCacheURL != "" {
+		return newAPICache(cfg.Filter.Expiry, cfg.RedisCacheURL)
+	}
+	if cfg.Filter.DBPath == "" {
+		return newLocalCache(cfg.Filter.Expiry)
+	}
+	dbCfg := db.DefaultConfig
+	dbCfg.DbPath = cfg.Filter.DBPath
+	return newKVCache(db.NewBoltDB(dbCfg), cfg.Filter.Expiry)
+}
+
+func newFilterStore(cache apiCache, maxPerClient int) *filterStore {
+	return &filterStore{
+		cache:        cache,
+		maxPerClient: maxPerClient,
+	}
+}
+
+// add stores the filter and returns its id, which is the hash of the filter
+func (fs *filterStore) add(filter *filterObject) (string, error) {
+	objInByte, _ := json.Marshal(*filter)
+	keyHash := hash.Hash256b(objInByte)
+	filterID := hex.EncodeToString(keyHash[:])
+	if filter.Client == "" || fs.maxPerClient <= 0 {
+		return filterID, fs.cache.Set(filterID, objInByte)
+	}
+
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+	// the ids of the filters of the client, excluding the expired and uninstalled ones
+	var (
+		ids   []string
+		key   = _clientFilterKey + filter.Client
+		found bool
+	)
+	if data, ok := fs.cache.Get(key); ok {
+		var prev []string
+		if err := json.Unmarshal(data, &prev); err != nil {
+			return "", err
+		}
+		for _, id := range prev {
+			if id == filterID {
+				found = true
+			}
+			if fs.cache.Exists(id) {
+				ids = append(ids, id)
+			}
+		}
+	}
+	if !found {
+		if len(ids) >= fs.maxPerClient {
+			return "", errTooManyFilters
+		}
+		ids = append(ids, filterID)
+	}
+	if err := fs.cache.Set(filterID, objInByte); err != nil {
+		return "", err
+	}
+	data, _ := json.Marshal(ids)
+	return filterID, fs.cache.Set(key, data)
+}
+
+func (fs *filterStore) get(filterID string) (filterObject, error) {
+	return loadFilterFromCache(fs.cache, filterID)
+}
+
+// update stores the filter with a new cursor of its changes
+func (fs *filterStore) update(filterID string, filter filterObject) error {
+	objInByte, _ := json.Marshal(filter)
+	return fs.cache.Set(filterID, objInByte)
+}
+
+func (fs *filterStore) remove(filterID string) bool {
+	return fs.cache.Del(filterID)
+}
+
+func newKVCache(kvStore db.KVStore, expireTime time.Duration) *kvCache {
+	c := &kvCache{
+		kvStore:    kvStore,
+		expireTime: expireTime,
+	}
+	c.sweeper = routine.NewRecurringTask(c.sweep, expireTime)
+	return c
+}
+
+func (c *kvCache) Start(ctx context.Context) error {
+	if err := c.kvStore.Start(ctx); err != nil {
+		return err
+	}
+	// removes the data expired while the node was down
+	c.sweep()
+	return c.sweeper.Start(ctx)
+}
+
+func (c *kvCache) Stop(ctx context.Context) error {
+	if err := c.sweeper.Stop(ctx); err != nil {
+		return err
+	}
+	return c.kvStore.Stop(ctx)
+}
+
+func (c *kvCache) Set(key string, data []byte) error {
+	value := make([]byte, _filterExpireAtLen, _filterExpireAtLen+len(data))
+	binary.BigEndian.PutUint64(value, uint64(time.Now().Add(c.expireTime).UnixNano()))
+	return c.kvStore.Put(_filterNS, []byte(key), append(value, data...))
+}
+
+func (c *kvCache) Del(key string) bool {
+	if _, ok := c.load(key); !ok {
+		return false
+	}
+	return c.kvStore.Delete(_filterNS, []byte(key)) == nil
+}
+
+func (c *kvCache) Get(key string) ([]byte, bool) {
+	data, ok := c.load(key)
+	if !ok {
+		return nil, false
+	}
+	// renews the expiry like the remote cache does
+	if err := c.Set(key, data); err != nil {
+		log.L().Warn("failed to renew the expiry of api cache.", zap.Error(err))
+	}
+	return data, true
+}
+
+func (c *kvCache) Exists(key string) bool {
+	_, ok := c.load(key)
+	return ok
+}
+
+// load returns the data if it has not expired
+func (c *kvCache) load(key string) ([]byte, bool) {
+	value, err := c.kvStore.Get(_filterNS, []byte(key))
+	if err != nil || len(value) < _filterExpireAtLen || expired(value, time.Now()) {
+		return nil, false
+	}
+	return value[_filterExpireAtLen:], true
+}
+
+func (c *kvCache) sweep() {
+	now := time.Now()
+	keys, _, err := c.kvStore.Filter(_filterNS, func(_, v []byte) bool {
+		return len(v) < _filterExpireAtLen || expired(v, now)
+	}, nil, nil)
+	if err != nil {
+		if errors.Cause(err) != db.ErrNotExist && errors.Cause(err) != db.ErrBucketNotExist {
+			log.L().Warn("failed to find the expired data of api cache.", zap.Error(err))
+		}
+		return
+	}
+	for _, k := range keys {
+		if err := c.kvStore.Delete(_filterNS, k); err != nil {
+			log.L().Warn("failed to remove the expired data of api cache.", zap.Error(err))
+		}
+	}
+}
+
+func expired(value []byte, now time.Time) bool {
+	return int64(binary.BigEndian.Uint64(value[:_filterExpireAtLen])) <= now.UnixNano()
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestKVCache(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	path, err := testutil.PathOfTempFile("filter.db")
+	require.NoError(err)
+	defer testutil.CleanupPath(path)
+	dbCfg := db.DefaultConfig
+	dbCfg.DbPath = path
+
+	cache := newKVCache(db.NewBoltDB(dbCfg), time.Hour)
+	require.NoError(cache.Start(ctx))
+	_, ok := cache.Get("filter")
+	require.False(ok)
+	require.NoError(cache.Set("filter", []byte("data")))
+	data, ok := cache.Get("filter")
+	require.True(ok)
+	require.Equal([]byte("data"), data)
+	require.True(cache.Exists("filter"))
+	require.True(cache.Del("filter"))
+	require.False(cache.Exists("filter"))
+	require.False(cache.Del("filter"))
+
+	// the data survives restarts
+	require.NoError(cache.Set("filter", []byte("data")))
+	require.NoError(cache.Stop(ctx))
+	cache = newKVCache(db.NewBoltDB(dbCfg), time.Hour)
+	require.NoError(cache.Start(ctx))
+	data, ok = cache.Get("filter")
+	require.True(ok)
+	require.Equal([]byte("data"), data)
+
+	// the data expires
+	cache.expireTime = -time.Second
+	require.NoError(cache.Set("expired", []byte("data")))
+	require.False(cache.Exists("expired"))
+	_, ok = cache.Get("expired")
+	require.False(ok)
+	cache.sweep()
+	_, err = cache.kvStore.Get(_filterNS, []byte("expired"))
+	require.ErrorIs(err, db.ErrNotExist)
+	require.True(cache.Exists("filter"))
+	require.NoError(cache.Stop(ctx))
+}
+
+func TestFilterStore(t *testing.T) {
+	require := require.New(t)
+	fs := newFilterStore(newLocalCache(time.Hour), 2)
+
+	filter := func(client, from string) *filterObject {
+		return &filterObject{FilterType: "log", FromBlock: from, Client: client}
+	}
+	id1, err := fs.add(filter("ip:1.1.1.1", "0x1"))
+	require.NoError(err)
+	id2, err := fs.add(filter("ip:1.1.1.1", "0x2"))
+	require.NoError(err)
+	_, err = fs.add(filter("ip:1.1.1.1", "0x3"))
+	require.ErrorIs(err, errTooManyFilters)
+
+	// installing the same filter again does not count
+	id, err := fs.add(filter("ip:1.1.1.1", "0x1"))
+	require.NoError(err)
+	require.Equal(id1, id)
+	// the same filter of another client is apart
+	id, err = fs.add(filter("ip:2.2.2.2", "0x1"))
+	require.NoError(err)
+	require.NotEqual(id1, id)
+	// the filters without a client are not bounded
+	for _, from := range []string{"0x1", "0x2", "0x3"} {
+		_, err = fs.add(filter("", from))
+		require.NoError(err)
+	}
+
+	// uninstalling a filter makes room for another
+	require.True(fs.remove(id2))
+	_, err = fs.get(id2)
+	require.ErrorIs(err, errInvalidFilterID)
+	_, err = fs.add(filter("ip:1.1.1.1", "0x3"))
+	require.NoError(err)
+
+	obj, err := fs.get(id1)
+	require.NoError(err)
+	obj.LogHeight = 10
+	require.NoError(fs.update(id1, obj))
+	obj, err = fs.get(id1)
+	require.NoError(err)
+	require.EqualValues(10, obj.LogHeight)
+}
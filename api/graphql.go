@@ -0,0 +1,1124 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/api/logfilter"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+const (
+	_protocolGraphQL = "graphql"
+
+	_graphqlMaxDepth       = 10
+	_graphqlMaxParallelism = 16
+	_graphqlDefaultPage    = 100
+	_graphqlMaxPage        = 1000
+)
+
+type (
+	// graphqlHandler serves the GraphQL queries over CoreService
+	graphqlHandler struct {
+		core    CoreService
+		schema  *graphql.Schema
+		access  *AccessControl
+		monitor *requestMonitor
+	}
+
+	queryResolver struct {
+		core CoreService
+	}
+
+	blockResolver struct {
+		core     CoreService
+		blk      *block.Block
+		receipts []*action.Receipt
+	}
+
+	transactionResolver struct {
+		core    CoreService
+		selp    *action.SealedEnvelope
+		receipt *action.Receipt // nil if the transaction is pending
+	}
+
+	accountResolver struct {
+		core   CoreService
+		addr   address.Address
+		height *uint64 // nil for the tip
+
+		once sync.Once
+		meta *iotextypes.AccountMeta
+		err  error
+	}
+
+	logResolver struct {
+		core CoreService
+		log  *action.Log
+	}
+
+	syncStateResolver struct {
+		start, curr, highest uint64
+	}
+
+	bucketResolver struct {
+		core   CoreService
+		bucket *iotextypes.VoteBucket
+	}
+
+	candidateResolver struct {
+		core CoreService
+		cand *iotextypes.CandidateV2
+	}
+
+	blockArgs struct {
+		Block *hexutil.Uint64
+	}
+
+	pageArgs struct {
+		Offset *int32
+		Limit  *int32
+	}
+
+	blockFilterCriteria struct {
+		Addresses *[]common.Address
+		Topics    *[][]common.Hash
+	}
+
+	filterCriteria struct {
+		FromBlock *hexutil.Uint64
+		ToBlock   *hexutil.Uint64
+		Addresses *[]common.Address
+		Topics    *[][]common.Hash
+	}
+)
+
+// NewGraphQLHandler creates the handler of the GraphQL queries
+func NewGraphQLHandler(core CoreService, ac *AccessControl, monitor *requestMonitor) (http.Handler, error) {
+	schema, err := graphql.ParseSchema(
+		_graphqlSchema,
+		&queryResolver{core: core},
+		graphql.MaxDepth(_graphqlMaxDepth),
+		graphql.MaxParallelism(_graphqlMaxParallelism),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse graphql schema")
+	}
+	return &graphqlHandler{
+		core:    core,
+		schema:  schema,
+		access:  ac,
+		monitor: monitor,
+	}, nil
+}
+
+func (h *graphqlHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "POST" {
+		w.Write([]byte("IoTeX GraphQL endpoint is ready."))
+		return
+	}
+	var (
+		params struct {
+			Query         string                 `json:"query"`
+			OperationName string                 `json:"operationName"`
+			Variables     map[string]interface{} `json:"variables"`
+		}
+		resp *graphql.Response
+		size int
+		ctx  = h.access.withClient(req.Context(), _protocolGraphQL, req)
+		code = http.StatusOK
+	)
+	defer func(start time.Time) {
+		success := resp != nil && len(resp.Errors) == 0
+		h.core.Track(ctx, start, _protocolGraphQL, int64(size), success)
+		// the errors of a valid query are responded with 200 as well
+		label := _codeOK
+		switch {
+		case code != http.StatusOK:
+			label = strconv.Itoa(code)
+		case !success:
+			label = _codeError
+		}
+		h.monitor.observe(ctx, _protocolGraphQL, _protocolGraphQL, label, start, func() string {
+			return params.Query
+		})
+	}(time.Now())
+
+	if err := checkAccess(ctx, _protocolGraphQL); err != nil {
+		resp, code = graphqlError(err), http.StatusTooManyRequests
+		switch status.Code(err) {
+		case codes.Unauthenticated:
+			code = http.StatusUnauthorized
+		case codes.PermissionDenied:
+			code = http.StatusForbidden
+		}
+	} else if err := json.NewDecoder(req.Body).Decode(&params); err != nil {
+		resp, code = graphqlError(err), http.StatusBadRequest
+	} else {
+		resp = h.schema.Exec(ctx, params.Query, params.OperationName, params.Variables)
+	}
+	raw, err := json.Marshal(resp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(code)
+	if size, err = w.Write(raw); err != nil {
+		log.Logger("api").Warn("fail to respond graphql request.", zap.Error(err))
+	}
+}
+
+// chargeField takes the tokens of resolving a field of the query that reads the chain, either a root field or a nested
+// one, from the bucket of the client, so that a query with many such fields, aliases of an expensive one, or fields
+// nested in a list costs as many calls
+func chargeField(ctx context.Context, field string) error {
+	return checkAccess(ctx, _protocolGraphQL+"_"+field)
+}
+
+func graphqlError(err error) *graphql.Response {
+	return &graphql.Response{Errors: []*gqlerrors.QueryError{{Message: err.Error()}}}
+}
+
+// Block fetches a block by number or by hash, or the tip block if neither is supplied
+func (r *queryResolver) Block(ctx context.Context, args struct {
+	Number *hexutil.Uint64
+	Hash   *common.Hash
+}) (*blockResolver, error) {
+	if err := chargeField(ctx, "block"); err != nil {
+		return nil, err
+	}
+	var (
+		blk *blockResolver
+		err error
+	)
+	switch {
+	case args.Hash != nil:
+		blk, err = r.blockByHash(*args.Hash)
+	case args.Number != nil:
+		blk, err = newBlockResolver(r.core, uint64(*args.Number))
+	default:
+		blk, err = newBlockResolver(r.core, r.core.TipHeight())
+	}
+	if errors.Cause(err) == ErrNotFound {
+		return nil, nil
+	}
+	return blk, err
+}
+
+func (r *queryResolver) blockByHash(h common.Hash) (*blockResolver, error) {
+	blk, err := r.core.BlockByHash(hex.EncodeToString(h[:]))
+	if err != nil {
+		return nil, err
+	}
+	return &blockResolver{core: r.core, blk: blk.Block, receipts: blk.Receipts}, nil
+}
+
+// Blocks returns the blocks between two heights, inclusive
+func (r *queryResolver) Blocks(ctx context.Context, args struct {
+	From hexutil.Uint64
+	To   *hexutil.Uint64
+}) ([]*blockResolver, error) {
+	if err := chargeField(ctx, "blocks"); err != nil {
+		return nil, err
+	}
+	from, to := uint64(args.From), r.core.TipHeight()
+	if args.To != nil && uint64(*args.To) < to {
+		to = uint64(*args.To)
+	}
+	if from > to {
+		return []*blockResolver{}, nil
+	}
+	blks, err := r.core.BlockByHeightRange(from, to-from+1)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*blockResolver, 0, len(blks))
+	for _, blk := range blks {
+		ret = append(ret, &blockResolver{core: r.core, blk: blk.Block, receipts: blk.Receipts})
+	}
+	return ret, nil
+}
+
+// Transaction returns a confirmed or pending transaction by its hash
+func (r *queryResolver) Transaction(ctx context.Context, args struct{ Hash common.Hash }) (*transactionResolver, error) {
+	if err := chargeField(ctx, "transaction"); err != nil {
+		return nil, err
+	}
+	actHash := hash.BytesToHash256(args.Hash[:])
+	selp, _, _, _, err := r.core.ActionByActionHash(actHash)
+	if err == nil {
+		receipt, err := r.core.ReceiptByActionHash(actHash)
+		if err != nil {
+			if errors.Cause(err) == ErrNotFound {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return &transactionResolver{core: r.core, selp: selp, receipt: receipt}, nil
+	}
+	if errors.Cause(err) != ErrNotFound {
+		return nil, err
+	}
+	selp, err = r.core.PendingActionByActionHash(actHash)
+	if err != nil {
+		if errors.Cause(err) == ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &transactionResolver{core: r.core, selp: selp}, nil
+}
+
+// Logs returns the logs matching the filter
+func (r *queryResolver) Logs(ctx context.Context, args struct{ Filter filterCriteria }) ([]*logResolver, error) {
+	if err := chargeField(ctx, "logs"); err != nil {
+		return nil, err
+	}
+	from, to := r.core.TipHeight(), r.core.TipHeight()
+	if args.Filter.FromBlock != nil {
+		from = uint64(*args.Filter.FromBlock)
+	}
+	if args.Filter.ToBlock != nil {
+		to = uint64(*args.Filter.ToBlock)
+	}
+	filter, err := newGraphQLLogFilter(args.Filter.Addresses, args.Filter.Topics)
+	if err != nil {
+		return nil, err
+	}
+	logs, _, err := r.core.LogsInRange(filter, from, to, 0)
+	if err != nil {
+		return nil, err
+	}
+	return newLogResolvers(r.core, logs), nil
+}
+
+// GasPrice returns the suggested gas price
+func (r *queryResolver) GasPrice(ctx context.Context) (hexutil.Big, error) {
+	if err := chargeField(ctx, "gasPrice"); err != nil {
+		return hexutil.Big{}, err
+	}
+	price, err := r.core.SuggestGasPrice()
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*new(big.Int).SetUint64(price)), nil
+}
+
+// Syncing returns the syncing progress, or nil if the node is synced
+func (r *queryResolver) Syncing(ctx context.Context) (*syncStateResolver, error) {
+	if err := chargeField(ctx, "syncing"); err != nil {
+		return nil, err
+	}
+	start, curr, highest := r.core.SyncingProgress()
+	if curr >= highest {
+		return nil, nil
+	}
+	return &syncStateResolver{start, curr, highest}, nil
+}
+
+// ChainID returns the chain id of evm
+func (r *queryResolver) ChainID(ctx context.Context) (hexutil.Big, error) {
+	if err := chargeField(ctx, "chainID"); err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*new(big.Int).SetUint64(uint64(r.core.EVMNetworkID()))), nil
+}
+
+// Bucket returns a native staking bucket by its index
+func (r *queryResolver) Bucket(ctx context.Context, args struct{ Index hexutil.Uint64 }) (*bucketResolver, error) {
+	if err := chargeField(ctx, "bucket"); err != nil {
+		return nil, err
+	}
+	return bucketByIndex(r.core, uint64(args.Index))
+}
+
+// Buckets returns the native staking buckets, of the voter if it is supplied
+func (r *queryResolver) Buckets(ctx context.Context, args struct {
+	Voter *common.Address
+	pageArgs
+}) ([]*bucketResolver, error) {
+	if err := chargeField(ctx, "buckets"); err != nil {
+		return nil, err
+	}
+	var (
+		method = iotexapi.ReadStakingDataMethod_BUCKETS
+		req    = &iotexapi.ReadStakingDataRequest{
+			Request: &iotexapi.ReadStakingDataRequest_Buckets{
+				Buckets: &iotexapi.ReadStakingDataRequest_VoteBuckets{Pagination: args.pagination()},
+			},
+		}
+	)
+	if args.Voter != nil {
+		voter, err := address.FromBytes(args.Voter.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		method = iotexapi.ReadStakingDataMethod_BUCKETS_BY_VOTER
+		req.Request = &iotexapi.ReadStakingDataRequest_BucketsByVoter{
+			BucketsByVoter: &iotexapi.ReadStakingDataRequest_VoteBucketsByVoter{
+				VoterAddress: voter.String(),
+				Pagination:   args.pagination(),
+			},
+		}
+	}
+	return readBuckets(r.core, method, req)
+}
+
+// Candidate returns a staking candidate by its name
+func (r *queryResolver) Candidate(ctx context.Context, args struct{ Name string }) (*candidateResolver, error) {
+	if err := chargeField(ctx, "candidate"); err != nil {
+		return nil, err
+	}
+	return readCandidate(r.core, iotexapi.ReadStakingDataMethod_CANDIDATE_BY_NAME, &iotexapi.ReadStakingDataRequest{
+		Request: &iotexapi.ReadStakingDataRequest_CandidateByName_{
+			CandidateByName: &iotexapi.ReadStakingDataRequest_CandidateByName{CandName: args.Name},
+		},
+	})
+}
+
+// Candidates returns the staking candidates
+func (r *queryResolver) Candidates(ctx context.Context, args pageArgs) ([]*candidateResolver, error) {
+	if err := chargeField(ctx, "candidates"); err != nil {
+		return nil, err
+	}
+	var cands iotextypes.CandidateListV2
+	if err := readStakingData(r.core, iotexapi.ReadStakingDataMethod_CANDIDATES, &iotexapi.ReadStakingDataRequest{
+		Request: &iotexapi.ReadStakingDataRequest_Candidates_{
+			Candidates: &iotexapi.ReadStakingDataRequest_Candidates{Pagination: args.pagination()},
+		},
+	}, &cands); err != nil {
+		return nil, err
+	}
+	ret := make([]*candidateResolver, 0, len(cands.Candidates))
+	for _, cand := range cands.Candidates {
+		ret = append(ret, &candidateResolver{core: r.core, cand: cand})
+	}
+	return ret, nil
+}
+
+func newBlockResolver(core CoreService, height uint64) (*blockResolver, error) {
+	blk, err := core.BlockByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	return &blockResolver{core: core, blk: blk.Block, receipts: blk.Receipts}, nil
+}
+
+// Number returns the height of the block
+func (b *blockResolver) Number() hexutil.Uint64 {
+	return hexutil.Uint64(b.blk.Height())
+}
+
+// Hash returns the hash of the block
+func (b *blockResolver) Hash() common.Hash {
+	if b.blk.Height() == 0 {
+		return common.Hash(block.GenesisHash())
+	}
+	return common.Hash(b.blk.HashBlock())
+}
+
+// Parent returns the previous block
+func (b *blockResolver) Parent(ctx context.Context) (*blockResolver, error) {
+	if b.blk.Height() == 0 {
+		return nil, nil
+	}
+	if err := chargeField(ctx, "block"); err != nil {
+		return nil, err
+	}
+	return newBlockResolver(b.core, b.blk.Height()-1)
+}
+
+// TransactionsRoot returns the root hash of the actions
+func (b *blockResolver) TransactionsRoot() common.Hash {
+	return common.Hash(b.blk.TxRoot())
+}
+
+// TransactionCount returns the number of actions
+func (b *blockResolver) TransactionCount() *hexutil.Uint64 {
+	count := hexutil.Uint64(len(b.blk.Actions))
+	return &count
+}
+
+// StateRoot returns the digest of the state changes
+func (b *blockResolver) StateRoot() common.Hash {
+	return common.Hash(b.blk.DeltaStateDigest())
+}
+
+// ReceiptsRoot returns the root hash of the receipts
+func (b *blockResolver) ReceiptsRoot() common.Hash {
+	return common.Hash(b.blk.ReceiptRoot())
+}
+
+// Miner returns the producer of the block
+func (b *blockResolver) Miner(args blockArgs) (*accountResolver, error) {
+	producer := address.ZeroAddress
+	if b.blk.Height() > 0 {
+		producer = b.blk.ProducerAddress()
+	}
+	addr, err := address.FromString(producer)
+	if err != nil {
+		return nil, err
+	}
+	return newAccountResolver(b.core, addr, args.Block), nil
+}
+
+// ExtraData is always empty
+func (b *blockResolver) ExtraData() hexutil.Bytes {
+	return hexutil.Bytes{}
+}
+
+// GasLimit returns the total gas limit of the actions
+func (b *blockResolver) GasLimit() hexutil.Uint64 {
+	var gasLimit uint64
+	for _, selp := range b.blk.Actions {
+		gasLimit += selp.GasLimit()
+	}
+	return hexutil.Uint64(gasLimit)
+}
+
+// GasUsed returns the total gas consumed by the actions
+func (b *blockResolver) GasUsed() hexutil.Uint64 {
+	var gasUsed uint64
+	for _, r := range b.receipts {
+		gasUsed += r.GasConsumed
+	}
+	return hexutil.Uint64(gasUsed)
+}
+
+// Timestamp returns the unix timestamp of the block
+func (b *blockResolver) Timestamp() hexutil.Uint64 {
+	return hexutil.Uint64(b.blk.Timestamp().Unix())
+}
+
+// LogsBloom returns the bloom filter of the logs
+func (b *blockResolver) LogsBloom() hexutil.Bytes {
+	if bf := b.blk.LogsBloomfilter(); bf != nil {
+		return bf.Bytes()
+	}
+	return make([]byte, (len(_zeroLogsBloom)-2)/2)
+}
+
+// Transactions returns the actions of the block
+func (b *blockResolver) Transactions() *[]*transactionResolver {
+	ret := make([]*transactionResolver, 0, len(b.blk.Actions))
+	for i := range b.blk.Actions {
+		ret = append(ret, b.transactionAt(i))
+	}
+	return &ret
+}
+
+// TransactionAt returns the action at the index
+func (b *blockResolver) TransactionAt(args struct{ Index hexutil.Uint64 }) *transactionResolver {
+	if uint64(args.Index) >= uint64(len(b.blk.Actions)) {
+		return nil
+	}
+	return b.transactionAt(int(args.Index))
+}
+
+func (b *blockResolver) transactionAt(i int) *transactionResolver {
+	tx := &transactionResolver{core: b.core, selp: b.blk.Actions[i]}
+	if i < len(b.receipts) {
+		tx.receipt = b.receipts[i]
+	}
+	return tx
+}
+
+// Logs returns the logs in the block matching the filter
+func (b *blockResolver) Logs(args struct{ Filter blockFilterCriteria }) ([]*logResolver, error) {
+	filter, err := newGraphQLLogFilter(args.Filter.Addresses, args.Filter.Topics)
+	if err != nil {
+		return nil, err
+	}
+	return newLogResolvers(b.core, filter.MatchLogs(b.receipts)), nil
+}
+
+// Account returns the account at the state of the block
+func (b *blockResolver) Account(args struct{ Address common.Address }) (*accountResolver, error) {
+	addr, err := address.FromBytes(args.Address.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	height := hexutil.Uint64(b.blk.Height())
+	return newAccountResolver(b.core, addr, &height), nil
+}
+
+// Hash returns the hash of the action
+func (t *transactionResolver) Hash() (common.Hash, error) {
+	h, err := t.selp.Hash()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.Hash(h), nil
+}
+
+// Nonce returns the nonce of the action
+func (t *transactionResolver) Nonce() hexutil.Uint64 {
+	return hexutil.Uint64(t.selp.Nonce())
+}
+
+// Index returns the index of the action in the block
+func (t *transactionResolver) Index() *hexutil.Uint64 {
+	if t.receipt == nil {
+		return nil
+	}
+	index := hexutil.Uint64(t.receipt.TxIndex)
+	return &index
+}
+
+// From returns the sender of the action
+func (t *transactionResolver) From(args blockArgs) *accountResolver {
+	return newAccountResolver(t.core, t.selp.SenderAddress(), args.Block)
+}
+
+// To returns the recipient of the action, which is nil for contract creation
+func (t *transactionResolver) To(args blockArgs) (*accountResolver, error) {
+	to, err := t.recipient()
+	if err != nil || to == nil {
+		return nil, err
+	}
+	addr, err := address.FromBytes(to.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return newAccountResolver(t.core, addr, args.Block), nil
+}
+
+func (t *transactionResolver) recipient() (*common.Address, error) {
+	act, ok := t.selp.Action().(action.EthCompatibleAction)
+	if !ok {
+		return nil, nil
+	}
+	tx, err := act.ToEthTx(0)
+	if err != nil {
+		return nil, err
+	}
+	return tx.To(), nil
+}
+
+// Value returns the amount transferred by the action
+func (t *transactionResolver) Value() (hexutil.Big, error) {
+	value := new(big.Int)
+	if act, ok := t.selp.Action().(action.EthCompatibleAction); ok {
+		tx, err := act.ToEthTx(0)
+		if err != nil {
+			return hexutil.Big{}, err
+		}
+		value = tx.Value()
+	}
+	return hexutil.Big(*value), nil
+}
+
+// GasPrice returns the gas price of the action
+func (t *transactionResolver) GasPrice() hexutil.Big {
+	return hexutil.Big(*t.selp.GasPrice())
+}
+
+// Gas returns the gas limit of the action
+func (t *transactionResolver) Gas() hexutil.Uint64 {
+	return hexutil.Uint64(t.selp.GasLimit())
+}
+
+// InputData returns the data of the action
+func (t *transactionResolver) InputData() (hexutil.Bytes, error) {
+	act, ok := t.selp.Action().(action.EthCompatibleAction)
+	if !ok {
+		return hexutil.Bytes{}, nil
+	}
+	tx, err := act.ToEthTx(0)
+	if err != nil {
+		return nil, err
+	}
+	return tx.Data(), nil
+}
+
+// Block returns the block containing the action, which is nil if the action is pending
+func (t *transactionResolver) Block(ctx context.Context) (*blockResolver, error) {
+	if t.receipt == nil {
+		return nil, nil
+	}
+	if err := chargeField(ctx, "block"); err != nil {
+		return nil, err
+	}
+	return newBlockResolver(t.core, t.receipt.BlockHeight)
+}
+
+// Status returns the status of the receipt
+func (t *transactionResolver) Status() *hexutil.Uint64 {
+	if t.receipt == nil {
+		return nil
+	}
+	s := hexutil.Uint64(t.receipt.Status)
+	return &s
+}
+
+// GasUsed returns the gas consumed by the action
+func (t *transactionResolver) GasUsed() *hexutil.Uint64 {
+	if t.receipt == nil {
+		return nil
+	}
+	gas := hexutil.Uint64(t.receipt.GasConsumed)
+	return &gas
+}
+
+// CumulativeGasUsed returns the gas consumed by the action, the same as GasUsed
+func (t *transactionResolver) CumulativeGasUsed() *hexutil.Uint64 {
+	return t.GasUsed()
+}
+
+// CreatedContract returns the contract created by the action
+func (t *transactionResolver) CreatedContract(args blockArgs) (*accountResolver, error) {
+	if t.receipt == nil {
+		return nil, nil
+	}
+	if exec, ok := t.selp.Action().(*action.Execution); !ok || len(exec.Contract()) != 0 {
+		return nil, nil
+	}
+	addr, err := address.FromString(t.receipt.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	return newAccountResolver(t.core, addr, args.Block), nil
+}
+
+// Logs returns the logs emitted by the action, which is nil if the action is pending
+func (t *transactionResolver) Logs() *[]*logResolver {
+	if t.receipt == nil {
+		return nil
+	}
+	logs := newLogResolvers(t.core, t.receipt.Logs())
+	return &logs
+}
+
+// R returns the r of the signature
+func (t *transactionResolver) R() (*hexutil.Big, error) {
+	_, r, _, err := t.signature()
+	return r, err
+}
+
+// S returns the s of the signature
+func (t *transactionResolver) S() (*hexutil.Big, error) {
+	_, _, s, err := t.signature()
+	return s, err
+}
+
+// V returns the v of the signature
+func (t *transactionResolver) V() (*hexutil.Big, error) {
+	v, _, _, err := t.signature()
+	return v, err
+}
+
+func (t *transactionResolver) signature() (v, r, s *hexutil.Big, err error) {
+	tx, err := newGetTransactionResult(nil, t.selp, nil, t.core.EVMNetworkID())
+	if err != nil {
+		if errors.Cause(err) == errUnsupportedAction {
+			return nil, nil, nil, nil
+		}
+		return nil, nil, nil, err
+	}
+	bv, br, bs := tx.ethTx.RawSignatureValues()
+	return (*hexutil.Big)(bv), (*hexutil.Big)(br), (*hexutil.Big)(bs), nil
+}
+
+func newAccountResolver(core CoreService, addr address.Address, height *hexutil.Uint64) *accountResolver {
+	a := &accountResolver{core: core, addr: addr}
+	if height != nil {
+		h := uint64(*height)
+		a.height = &h
+	}
+	return a
+}
+
+func (a *accountResolver) account(ctx context.Context) (*iotextypes.AccountMeta, error) {
+	a.once.Do(func() {
+		if a.err = chargeField(ctx, "account"); a.err != nil {
+			return
+		}
+		if a.height == nil || *a.height >= a.core.TipHeight() {
+			a.meta, _, a.err = a.core.Account(a.addr)
+		} else {
+			a.meta, _, a.err = a.core.AccountAtHeight(a.addr, *a.height)
+		}
+	})
+	return a.meta, a.err
+}
+
+// Address returns the address of the account
+func (a *accountResolver) Address() common.Address {
+	return common.BytesToAddress(a.addr.Bytes())
+}
+
+// IoAddress returns the address of the account in the io1 format
+func (a *accountResolver) IoAddress() string {
+	return a.addr.String()
+}
+
+// Balance returns the balance of the account
+func (a *accountResolver) Balance(ctx context.Context) (hexutil.Big, error) {
+	meta, err := a.account(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	balance, ok := new(big.Int).SetString(meta.Balance, 10)
+	if !ok {
+		return hexutil.Big{}, errors.Errorf("invalid balance %s", meta.Balance)
+	}
+	return hexutil.Big(*balance), nil
+}
+
+// TransactionCount returns the nonce of the next action of the account
+func (a *accountResolver) TransactionCount(ctx context.Context) (hexutil.Uint64, error) {
+	meta, err := a.account(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(meta.PendingNonce), nil
+}
+
+// Code returns the byte code of the contract
+func (a *accountResolver) Code(ctx context.Context) (hexutil.Bytes, error) {
+	meta, err := a.account(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return meta.ContractByteCode, nil
+}
+
+// Storage returns the value in the storage slot of the contract
+func (a *accountResolver) Storage(ctx context.Context, args struct{ Slot common.Hash }) (common.Hash, error) {
+	if err := chargeField(ctx, "storage"); err != nil {
+		return common.Hash{}, err
+	}
+	var (
+		val []byte
+		err error
+	)
+	if a.height == nil || *a.height >= a.core.TipHeight() {
+		val, err = a.core.ReadContractStorage(ctx, a.addr, args.Slot[:])
+	} else {
+		val, err = a.core.ReadContractStorageAtHeight(ctx, a.addr, args.Slot[:], *a.height)
+	}
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(val), nil
+}
+
+func newLogResolvers(core CoreService, logs []*action.Log) []*logResolver {
+	ret := make([]*logResolver, 0, len(logs))
+	for _, l := range logs {
+		ret = append(ret, &logResolver{core: core, log: l})
+	}
+	return ret
+}
+
+// Index returns the index of the log in the block
+func (l *logResolver) Index() hexutil.Uint64 {
+	return hexutil.Uint64(l.log.Index)
+}
+
+// Account returns the contract emitting the log
+func (l *logResolver) Account(args blockArgs) (*accountResolver, error) {
+	addr, err := address.FromString(l.log.Address)
+	if err != nil {
+		return nil, err
+	}
+	return newAccountResolver(l.core, addr, args.Block), nil
+}
+
+// Topics returns the topics of the log
+func (l *logResolver) Topics() []common.Hash {
+	topics := make([]common.Hash, 0, len(l.log.Topics))
+	for _, t := range l.log.Topics {
+		topics = append(topics, common.Hash(t))
+	}
+	return topics
+}
+
+// Data returns the data of the log
+func (l *logResolver) Data() hexutil.Bytes {
+	return l.log.Data
+}
+
+// Transaction returns the action emitting the log
+func (l *logResolver) Transaction(ctx context.Context) (*transactionResolver, error) {
+	if err := chargeField(ctx, "transaction"); err != nil {
+		return nil, err
+	}
+	selp, _, _, _, err := l.core.ActionByActionHash(l.log.ActionHash)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := l.core.ReceiptByActionHash(l.log.ActionHash)
+	if err != nil {
+		return nil, err
+	}
+	return &transactionResolver{core: l.core, selp: selp, receipt: receipt}, nil
+}
+
+// StartingBlock returns the height at which the syncing started
+func (s *syncStateResolver) StartingBlock() hexutil.Uint64 {
+	return hexutil.Uint64(s.start)
+}
+
+// CurrentBlock returns the height synced
+func (s *syncStateResolver) CurrentBlock() hexutil.Uint64 {
+	return hexutil.Uint64(s.curr)
+}
+
+// HighestBlock returns the height to sync to
+func (s *syncStateResolver) HighestBlock() hexutil.Uint64 {
+	return hexutil.Uint64(s.highest)
+}
+
+// Index returns the index of the bucket
+func (b *bucketResolver) Index() hexutil.Uint64 {
+	return hexutil.Uint64(b.bucket.Index)
+}
+
+// Candidate returns the candidate the bucket votes for
+func (b *bucketResolver) Candidate(ctx context.Context) (*candidateResolver, error) {
+	if err := chargeField(ctx, "candidate"); err != nil {
+		return nil, err
+	}
+	return readCandidate(b.core, iotexapi.ReadStakingDataMethod_CANDIDATE_BY_ADDRESS, &iotexapi.ReadStakingDataRequest{
+		Request: &iotexapi.ReadStakingDataRequest_CandidateByAddress_{
+			CandidateByAddress: &iotexapi.ReadStakingDataRequest_CandidateByAddress{OwnerAddr: b.bucket.CandidateAddress},
+		},
+	})
+}
+
+// Owner returns the owner of the bucket
+func (b *bucketResolver) Owner() (*accountResolver, error) {
+	addr, err := address.FromString(b.bucket.Owner)
+	if err != nil {
+		return nil, err
+	}
+	return newAccountResolver(b.core, addr, nil), nil
+}
+
+// StakedAmount returns the amount staked
+func (b *bucketResolver) StakedAmount() (hexutil.Big, error) {
+	amount, ok := new(big.Int).SetString(b.bucket.StakedAmount, 10)
+	if !ok {
+		return hexutil.Big{}, errors.Errorf("invalid staked amount %s", b.bucket.StakedAmount)
+	}
+	return hexutil.Big(*amount), nil
+}
+
+// StakedDuration returns the number of days staked
+func (b *bucketResolver) StakedDuration() hexutil.Uint64 {
+	return hexutil.Uint64(b.bucket.StakedDuration)
+}
+
+// AutoStake returns whether the staked duration is kept from decreasing
+func (b *bucketResolver) AutoStake() bool {
+	return b.bucket.AutoStake
+}
+
+// CreateTime returns the unix timestamp at which the bucket was created
+func (b *bucketResolver) CreateTime() hexutil.Uint64 {
+	return hexutil.Uint64(b.bucket.CreateTime.GetSeconds())
+}
+
+// StakeStartTime returns the unix timestamp at which the stake started
+func (b *bucketResolver) StakeStartTime() hexutil.Uint64 {
+	return hexutil.Uint64(b.bucket.StakeStartTime.GetSeconds())
+}
+
+// UnstakeStartTime returns the unix timestamp at which the unstake started
+func (b *bucketResolver) UnstakeStartTime() hexutil.Uint64 {
+	if b.bucket.UnstakeStartTime.GetSeconds() < 0 {
+		return 0
+	}
+	return hexutil.Uint64(b.bucket.UnstakeStartTime.GetSeconds())
+}
+
+// Name returns the name of the candidate
+func (c *candidateResolver) Name() string {
+	return c.cand.Name
+}
+
+// Owner returns the owner of the candidate
+func (c *candidateResolver) Owner() (*accountResolver, error) {
+	addr, err := address.FromString(c.cand.OwnerAddress)
+	if err != nil {
+		return nil, err
+	}
+	return newAccountResolver(c.core, addr, nil), nil
+}
+
+// Operator returns the operator address of the candidate
+func (c *candidateResolver) Operator() (common.Address, error) {
+	return ioToCommonAddress(c.cand.OperatorAddress)
+}
+
+// Reward returns the reward address of the candidate
+func (c *candidateResolver) Reward() (common.Address, error) {
+	return ioToCommonAddress(c.cand.RewardAddress)
+}
+
+// TotalWeightedVotes returns the total weighted votes of the candidate
+func (c *candidateResolver) TotalWeightedVotes() (hexutil.Big, error) {
+	votes, ok := new(big.Int).SetString(c.cand.TotalWeightedVotes, 10)
+	if !ok {
+		return hexutil.Big{}, errors.Errorf("invalid total weighted votes %s", c.cand.TotalWeightedVotes)
+	}
+	return hexutil.Big(*votes), nil
+}
+
+// SelfStakingTokens returns the amount staked by the candidate itself
+func (c *candidateResolver) SelfStakingTokens() (hexutil.Big, error) {
+	amount, ok := new(big.Int).SetString(c.cand.SelfStakingTokens, 10)
+	if !ok {
+		return hexutil.Big{}, errors.Errorf("invalid self staking tokens %s", c.cand.SelfStakingTokens)
+	}
+	return hexutil.Big(*amount), nil
+}
+
+// SelfStakeBucket returns the bucket staked by the candidate itself
+func (c *candidateResolver) SelfStakeBucket(ctx context.Context) (*bucketResolver, error) {
+	if err := chargeField(ctx, "bucket"); err != nil {
+		return nil, err
+	}
+	return bucketByIndex(c.core, c.cand.SelfStakeBucketIdx)
+}
+
+// Buckets returns the buckets voting for the candidate
+func (c *candidateResolver) Buckets(ctx context.Context, args pageArgs) ([]*bucketResolver, error) {
+	if err := chargeField(ctx, "buckets"); err != nil {
+		return nil, err
+	}
+	return readBuckets(c.core, iotexapi.ReadStakingDataMethod_BUCKETS_BY_CANDIDATE, &iotexapi.ReadStakingDataRequest{
+		Request: &iotexapi.ReadStakingDataRequest_BucketsByCandidate{
+			BucketsByCandidate: &iotexapi.ReadStakingDataRequest_VoteBucketsByCandidate{
+				CandName:   c.cand.Name,
+				Pagination: args.pagination(),
+			},
+		},
+	})
+}
+
+func (args pageArgs) pagination() *iotexapi.PaginationParam {
+	p := &iotexapi.PaginationParam{Limit: _graphqlDefaultPage}
+	if args.Offset != nil && *args.Offset > 0 {
+		p.Offset = uint32(*args.Offset)
+	}
+	if args.Limit != nil && *args.Limit >= 0 {
+		p.Limit = uint32(*args.Limit)
+	}
+	if p.Limit > _graphqlMaxPage {
+		p.Limit = _graphqlMaxPage
+	}
+	return p
+}
+
+func readStakingData(core CoreService, method iotexapi.ReadStakingDataMethod_Name, req *iotexapi.ReadStakingDataRequest, out proto.Message) error {
+	methodName, err := proto.Marshal(&iotexapi.ReadStakingDataMethod{Method: method})
+	if err != nil {
+		return err
+	}
+	arg, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	resp, err := core.ReadState("staking", "", methodName, [][]byte{arg})
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(resp.Data, out)
+}
+
+func readBuckets(core CoreService, method iotexapi.ReadStakingDataMethod_Name, req *iotexapi.ReadStakingDataRequest) ([]*bucketResolver, error) {
+	var buckets iotextypes.VoteBucketList
+	if err := readStakingData(core, method, req, &buckets); err != nil {
+		return nil, err
+	}
+	ret := make([]*bucketResolver, 0, len(buckets.Buckets))
+	for _, bucket := range buckets.Buckets {
+		ret = append(ret, &bucketResolver{core: core, bucket: bucket})
+	}
+	return ret, nil
+}
+
+func bucketByIndex(core CoreService, index uint64) (*bucketResolver, error) {
+	buckets, err := readBuckets(core, iotexapi.ReadStakingDataMethod_BUCKETS_BY_INDEXES, &iotexapi.ReadStakingDataRequest{
+		Request: &iotexapi.ReadStakingDataRequest_BucketsByIndexes{
+			BucketsByIndexes: &iotexapi.ReadStakingDataRequest_VoteBucketsByIndexes{Index: []uint64{index}},
+		},
+	})
+	if err != nil || len(buckets) == 0 {
+		return nil, err
+	}
+	return buckets[0], nil
+}
+
+// readCandidate reads a candidate, which is nil if it does not exist
+func readCandidate(core CoreService, method iotexapi.ReadStakingDataMethod_Name, req *iotexapi.ReadStakingDataRequest) (*candidateResolver, error) {
+	var cand iotextypes.CandidateV2
+	if err := readStakingData(core, method, req, &cand); err != nil {
+		return nil, err
+	}
+	if cand.Name == "" {
+		return nil, nil
+	}
+	return &candidateResolver{core: core, cand: &cand}, nil
+}
+
+func newGraphQLLogFilter(addrs *[]common.Address, topics *[][]common.Hash) (*logfilter.LogFilter, error) {
+	var (
+		addrStrs  []string
+		topicStrs [][]string
+	)
+	if addrs != nil {
+		for _, addr := range *addrs {
+			addrStrs = append(addrStrs, addr.Hex())
+		}
+	}
+	if topics != nil {
+		for _, tp := range *topics {
+			strs := make([]string, 0, len(tp))
+			for _, t := range tp {
+				strs = append(strs, t.Hex())
+			}
+			topicStrs = append(topicStrs, strs)
+		}
+	}
+	return newLogFilterFrom(addrStrs, topicStrs)
+}
+
+func ioToCommonAddress(ioAddr string) (common.Address, error) {
+	addr, err := address.FromString(ioAddr)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(addr.Bytes()), nil
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package api
+
+// _graphqlSchema follows the read-only part of EIP-1767 (https://eips.ethereum.org/EIPS/eip-1767) where it applies
+// to IoTeX, and adds the types of the staking buckets and candidates
+const _graphqlSchema = `
+    # Bytes32 is a 32 byte binary string, represented as 0x-prefixed hexadecimal.
+    scalar Bytes32
+    # Address is a 20 byte address, represented as 0x-prefixed hexadecimal.
+    scalar Address
+    # Bytes is an arbitrary length binary string, represented as 0x-prefixed hexadecimal.
+    scalar Bytes
+    # BigInt is a large integer, represented as 0x-prefixed hexadecimal.
+    scalar BigInt
+    # Long is a 64 bit unsigned integer, represented as 0x-prefixed hexadecimal.
+    scalar Long
+
+    schema {
+        query: Query
+    }
+
+    # Account is an account at a particular block.
+    type Account {
+        # Address is the address owning the account.
+        address: Address!
+        # IoAddress is the address in the io1 format.
+        ioAddress: String!
+        # Balance is the balance of the account, in Rau.
+        balance: BigInt!
+        # TransactionCount is the nonce of the next transaction sent from this account.
+        transactionCount: Long!
+        # Code contains the smart contract code of this account, if it is a contract.
+        code: Bytes!
+        # Storage provides access to the storage of a contract account, indexed by its 32 byte slot identifier.
+        storage(slot: Bytes32!): Bytes32!
+    }
+
+    # Log is an event log.
+    type Log {
+        # Index is the index of this log in the block.
+        index: Long!
+        # Account is the contract which generated this log.
+        account(block: Long): Account!
+        # Topics is a list of 0-4 indexed topics for the log.
+        topics: [Bytes32!]!
+        # Data is unindexed data for this log.
+        data: Bytes!
+        # Transaction is the transaction that generated this log entry.
+        transaction: Transaction!
+    }
+
+    # Transaction is an action on the chain.
+    type Transaction {
+        # Hash is the hash of this transaction.
+        hash: Bytes32!
+        # Nonce is the nonce of the account this transaction was generated with.
+        nonce: Long!
+        # Index is the index of this transaction in the parent block, which is null if it is pending.
+        index: Long
+        # From is the account that sent this transaction.
+        from(block: Long): Account!
+        # To is the account the transaction was sent to, which is null for contract-creating transactions.
+        to(block: Long): Account
+        # Value is the value, in Rau, sent along with this transaction.
+        value: BigInt!
+        # GasPrice is the price offered for gas, in Rau per unit.
+        gasPrice: BigInt!
+        # Gas is the maximum amount of gas this transaction can consume.
+        gas: Long!
+        # InputData is the data supplied to the target of the transaction.
+        inputData: Bytes!
+        # Block is the block this transaction was mined in, which is null if it is pending.
+        block: Block
+        # Status is 1 if the transaction succeeded, or 0 if it failed, which is null if it is pending.
+        status: Long
+        # GasUsed is the amount of gas used processing this transaction, which is null if it is pending.
+        gasUsed: Long
+        # CumulativeGasUsed is the same as gasUsed, which is null if it is pending.
+        cumulativeGasUsed: Long
+        # CreatedContract is the contract created by the transaction, which is null if it did not create one.
+        createdContract(block: Long): Account
+        # Logs is a list of log entries emitted by this transaction, which is null if it is pending.
+        logs: [Log!]
+        # R, S and V are the signature values, which are null if the transaction is not Ethereum compatible.
+        r: BigInt
+        s: BigInt
+        v: BigInt
+    }
+
+    # BlockFilterCriteria encapsulates log filter criteria for a filter applied to a single block.
+    input BlockFilterCriteria {
+        # Addresses is a list of addresses that are of interest.
+        addresses: [Address!]
+        # Topics list restricts matches to particular event topics.
+        topics: [[Bytes32!]!]
+    }
+
+    # Block is a block on the chain.
+    type Block {
+        # Number is the number of this block, starting at 0 for the genesis block.
+        number: Long!
+        # Hash is the block hash of this block.
+        hash: Bytes32!
+        # Parent is the parent block of this block.
+        parent: Block
+        # TransactionsRoot is the hash of the root of the transactions in this block.
+        transactionsRoot: Bytes32!
+        # TransactionCount is the number of transactions in this block.
+        transactionCount: Long
+        # StateRoot is the digest of the state changes of this block.
+        stateRoot: Bytes32!
+        # ReceiptsRoot is the hash of the root of the receipts in this block.
+        receiptsRoot: Bytes32!
+        # Miner is the delegate that produced this block.
+        miner(block: Long): Account!
+        # ExtraData is always empty.
+        extraData: Bytes!
+        # GasLimit is the total gas limit of the transactions in this block.
+        gasLimit: Long!
+        # GasUsed is the amount of gas that was used executing transactions in this block.
+        gasUsed: Long!
+        # Timestamp is the unix timestamp at which this block was produced.
+        timestamp: Long!
+        # LogsBloom is a bloom filter that can be used to check if a block may contain log entries matching a filter.
+        logsBloom: Bytes!
+        # Transactions is a list of transactions in this block.
+        transactions: [Transaction!]
+        # TransactionAt returns the transaction at the specified index, or null if the index is out of bounds.
+        transactionAt(index: Long!): Transaction
+        # Logs returns a filtered set of logs from this block.
+        logs(filter: BlockFilterCriteria!): [Log!]!
+        # Account fetches an account at the state of this block.
+        account(address: Address!): Account!
+    }
+
+    # FilterCriteria encapsulates log filter criteria for searching log entries.
+    input FilterCriteria {
+        # FromBlock is the block at which to start searching, inclusive, defaulting to the latest block.
+        fromBlock: Long
+        # ToBlock is the block at which to stop searching, inclusive, defaulting to the latest block.
+        toBlock: Long
+        # Addresses is a list of addresses that are of interest.
+        addresses: [Address!]
+        # Topics list restricts matches to particular event topics.
+        topics: [[Bytes32!]!]
+    }
+
+    # SyncState contains the current synchronisation state of the node.
+    type SyncState {
+        # StartingBlock is the block number at which synchronisation started.
+        startingBlock: Long!
+        # CurrentBlock is the point at which synchronisation has presently reached.
+        currentBlock: Long!
+        # HighestBlock is the latest known block number.
+        highestBlock: Long!
+    }
+
+    # Bucket is a native staking bucket.
+    type Bucket {
+        # Index is the index of the bucket.
+        index: Long!
+        # Candidate is the candidate the bucket votes for.
+        candidate: Candidate
+        # Owner is the account owning the bucket.
+        owner: Account!
+        # StakedAmount is the amount staked, in Rau.
+        stakedAmount: BigInt!
+        # StakedDuration is the number of days staked.
+        stakedDuration: Long!
+        # AutoStake is whether the staked duration is kept from decreasing.
+        autoStake: Boolean!
+        # CreateTime is the unix timestamp at which the bucket was created.
+        createTime: Long!
+        # StakeStartTime is the unix timestamp at which the stake started.
+        stakeStartTime: Long!
+        # UnstakeStartTime is the unix timestamp at which the unstake started, which is 0 if it is not unstaked.
+        unstakeStartTime: Long!
+    }
+
+    # Candidate is a staking candidate.
+    type Candidate {
+        # Name is the name of the candidate.
+        name: String!
+        # Owner is the account owning the candidate.
+        owner: Account!
+        # Operator is the address operating the delegate node.
+        operator: Address!
+        # Reward is the address receiving the rewards.
+        reward: Address!
+        # TotalWeightedVotes is the total weighted votes of the candidate.
+        totalWeightedVotes: BigInt!
+        # SelfStakingTokens is the amount staked by the candidate itself, in Rau.
+        selfStakingTokens: BigInt!
+        # SelfStakeBucket is the bucket staked by the candidate itself.
+        selfStakeBucket: Bucket
+        # Buckets are the buckets voting for the candidate.
+        buckets(offset: Int, limit: Int): [Bucket!]!
+    }
+
+    type Query {
+        # Block fetches a block by number or by hash, or the most recent block if neither is supplied.
+        block(number: Long, hash: Bytes32): Block
+        # Blocks returns all the blocks between two numbers, inclusive, to defaulting to the most recent block.
+        blocks(from: Long!, to: Long): [Block!]!
+        # Transaction returns a transaction specified by its hash.
+        transaction(hash: Bytes32!): Transaction
+        # Logs returns log entries matching the provided filter.
+        logs(filter: FilterCriteria!): [Log!]!
+        # GasPrice returns the suggested gas price.
+        gasPrice: BigInt!
+        # Syncing returns the current synchronisation state, or null if the node is synced.
+        syncing: SyncState
+        # ChainID returns the chain ID of the EVM.
+        chainID: BigInt!
+        # Bucket returns a native staking bucket specified by its index.
+        bucket(index: Long!): Bucket
+        # Buckets returns the native staking buckets, of the voter if it is supplied.
+        buckets(voter: Address, offset: Int, limit: Int): [Bucket!]!
+        # Candidate returns a staking candidate specified by its name.
+        candidate(name: String!): Candidate
+        # Candidates returns the staking candidates.
+        candidates(offset: Int, limit: Int): [Candidate!]!
+    }
+`
@@ -0,0 +1,225 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/action"
+	apitypes "github.com/iotexproject/iotex-core/api/types"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_apicoreservice"
+)
+
+func TestGraphQL(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	core.EXPECT().Track(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return().AnyTimes()
+	core.EXPECT().TipHeight().Return(uint64(1)).AnyTimes()
+	core.EXPECT().EVMNetworkID().Return(uint32(4689)).AnyTimes()
+
+	handler, err := NewGraphQLHandler(core, nil, nil)
+	require.NoError(err)
+	query := func(q string) (int, gjson.Result) {
+		body, _ := json.Marshal(map[string]string{"query": q})
+		req, _ := http.NewRequest(http.MethodPost, "http://url.com", strings.NewReader(string(body)))
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		raw, _ := io.ReadAll(resp.Body)
+		return resp.Code, gjson.ParseBytes(raw)
+	}
+
+	t.Run("WrongHTTPMethod", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "http://url.com", nil)
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		require.Equal("IoTeX GraphQL endpoint is ready.", resp.Body.String())
+	})
+
+	t.Run("Block", func(t *testing.T) {
+		tsf, err := action.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), 1, big.NewInt(10), []byte{}, 100000, big.NewInt(0))
+		require.NoError(err)
+		tsfHash, err := tsf.Hash()
+		require.NoError(err)
+		receipts := []*action.Receipt{{Status: 1, BlockHeight: 1, ActionHash: tsfHash, GasConsumed: 10000}}
+		blk, err := block.NewTestingBuilder().
+			SetHeight(1).
+			SetPrevBlockHash(hash.ZeroHash256).
+			SetTimeStamp(time.Unix(1700000000, 0)).
+			SetReceipts(receipts).
+			AddActions(tsf).
+			SignAndBuild(identityset.PrivateKey(0))
+		require.NoError(err)
+		core.EXPECT().BlockByHeight(uint64(1)).Return(&apitypes.BlockWithReceipts{Block: &blk, Receipts: receipts}, nil).Times(1)
+		core.EXPECT().Account(identityset.Address(27)).Return(&iotextypes.AccountMeta{Balance: "100", PendingNonce: 2}, nil, nil).Times(1)
+
+		code, res := query(`{ block { number timestamp gasUsed transactionCount
+			transactions { hash nonce index value gas status from { address balance transactionCount } to { ioAddress } }
+		} }`)
+		require.Equal(http.StatusOK, code)
+		require.False(res.Get("errors").Exists(), res.Raw)
+		require.Equal("0x1", res.Get("data.block.number").String())
+		require.Equal("0x6553f100", res.Get("data.block.timestamp").String())
+		require.Equal("0x2710", res.Get("data.block.gasUsed").String())
+		require.Equal("0x1", res.Get("data.block.transactionCount").String())
+		tx := res.Get("data.block.transactions.0")
+		require.Equal("0x"+hex.EncodeToString(tsfHash[:]), tx.Get("hash").String())
+		require.Equal("0x1", tx.Get("nonce").String())
+		require.Equal("0x0", tx.Get("index").String())
+		require.Equal("0xa", tx.Get("value").String())
+		require.Equal("0x186a0", tx.Get("gas").String())
+		require.Equal("0x1", tx.Get("status").String())
+		require.Equal(identityset.Address(27).Hex(), tx.Get("from.address").String())
+		require.Equal("0x64", tx.Get("from.balance").String())
+		require.Equal("0x2", tx.Get("from.transactionCount").String())
+		require.Equal(identityset.Address(28).String(), tx.Get("to.ioAddress").String())
+	})
+
+	t.Run("TransactionNotFound", func(t *testing.T) {
+		core.EXPECT().ActionByActionHash(gomock.Any()).Return(nil, hash.ZeroHash256, uint64(0), uint32(0), ErrNotFound).Times(1)
+		core.EXPECT().PendingActionByActionHash(gomock.Any()).Return(nil, ErrNotFound).Times(1)
+		code, res := query(`{ transaction(hash: "0x0000000000000000000000000000000000000000000000000000000000000001") { hash } }`)
+		require.Equal(http.StatusOK, code)
+		require.False(res.Get("errors").Exists(), res.Raw)
+		require.Equal(gjson.Null, res.Get("data.transaction").Type)
+	})
+
+	t.Run("Staking", func(t *testing.T) {
+		cand := &iotextypes.CandidateV2{
+			Name:               "delegate",
+			OwnerAddress:       identityset.Address(1).String(),
+			OperatorAddress:    identityset.Address(2).String(),
+			RewardAddress:      identityset.Address(3).String(),
+			TotalWeightedVotes: "1000",
+			SelfStakingTokens:  "100",
+		}
+		buckets := &iotextypes.VoteBucketList{Buckets: []*iotextypes.VoteBucket{{
+			Index:            7,
+			CandidateAddress: identityset.Address(1).String(),
+			Owner:            identityset.Address(4).String(),
+			StakedAmount:     "500",
+			StakedDuration:   91,
+			AutoStake:        true,
+		}}}
+		core.EXPECT().ReadState("staking", "", gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_, _ string, methodName []byte, args [][]byte) (*iotexapi.ReadStateResponse, error) {
+				var (
+					method iotexapi.ReadStakingDataMethod
+					req    iotexapi.ReadStakingDataRequest
+					data   []byte
+					err    error
+				)
+				require.NoError(proto.Unmarshal(methodName, &method))
+				require.NoError(proto.Unmarshal(args[0], &req))
+				switch method.Method {
+				case iotexapi.ReadStakingDataMethod_CANDIDATE_BY_NAME:
+					if req.GetCandidateByName().GetCandName() == cand.Name {
+						data, err = proto.Marshal(cand)
+					} else {
+						data, err = proto.Marshal(&iotextypes.CandidateV2{})
+					}
+				case iotexapi.ReadStakingDataMethod_BUCKETS_BY_CANDIDATE:
+					require.Equal(cand.Name, req.GetBucketsByCandidate().GetCandName())
+					require.EqualValues(2, req.GetBucketsByCandidate().GetPagination().GetLimit())
+					data, err = proto.Marshal(buckets)
+				default:
+					t.Fatalf("unexpected method %s", method.Method)
+				}
+				return &iotexapi.ReadStateResponse{Data: data}, err
+			}).Times(3)
+
+		code, res := query(`{ candidate(name: "delegate") { name operator totalWeightedVotes
+			buckets(limit: 2) { index stakedAmount stakedDuration autoStake owner { ioAddress } } }
+			unknown: candidate(name: "unknown") { name } }`)
+		require.Equal(http.StatusOK, code)
+		require.False(res.Get("errors").Exists(), res.Raw)
+		require.Equal("delegate", res.Get("data.candidate.name").String())
+		require.Equal(identityset.Address(2).Hex(), res.Get("data.candidate.operator").String())
+		require.Equal("0x3e8", res.Get("data.candidate.totalWeightedVotes").String())
+		bucket := res.Get("data.candidate.buckets.0")
+		require.Equal("0x7", bucket.Get("index").String())
+		require.Equal("0x1f4", bucket.Get("stakedAmount").String())
+		require.Equal("0x5b", bucket.Get("stakedDuration").String())
+		require.True(bucket.Get("autoStake").Bool())
+		require.Equal(identityset.Address(4).String(), bucket.Get("owner.ioAddress").String())
+		require.Equal(gjson.Null, res.Get("data.unknown").Type)
+	})
+
+	t.Run("InvalidQuery", func(t *testing.T) {
+		code, res := query(`{ block { unknownField } }`)
+		require.Equal(http.StatusOK, code)
+		require.Contains(res.Get("errors.0.message").String(), "unknownField")
+	})
+
+	t.Run("RateLimited", func(t *testing.T) {
+		cfg := DefaultConfig
+		cfg.RateLimit.Enabled = true
+		cfg.RateLimit.RequestsPerSecond = 0.001
+		cfg.RateLimit.Burst = 3
+		ac, err := NewAccessControl(cfg)
+		require.NoError(err)
+		limited, err := NewGraphQLHandler(core, ac, nil)
+		require.NoError(err)
+		post := func(q string) (int, gjson.Result) {
+			req, _ := http.NewRequest(http.MethodPost, "http://url.com", strings.NewReader(q))
+			req.RemoteAddr = "1.1.1.1:1000"
+			resp := httptest.NewRecorder()
+			limited.ServeHTTP(resp, req)
+			return resp.Code, gjson.ParseBytes(resp.Body.Bytes())
+		}
+		// the query and each of its root fields are charged, including the aliases
+		code, res := post(`{"query":"{ a: chainID b: chainID c: chainID }"}`)
+		require.Equal(http.StatusOK, code)
+		require.Len(res.Get("errors").Array(), 1)
+		require.Contains(res.Get("errors.0.message").String(), "rate limit exceeded")
+		code, _ = post(`{"query":"{ chainID }"}`)
+		require.Equal(http.StatusTooManyRequests, code)
+
+		// the nested fields reading the chain are charged as well, once for each element of a list
+		ac, err = NewAccessControl(cfg)
+		require.NoError(err)
+		limited, err = NewGraphQLHandler(core, ac, nil)
+		require.NoError(err)
+		var acts []*action.SealedEnvelope
+		for i := uint64(1); i <= 2; i++ {
+			tsf, err := action.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), i, big.NewInt(10), []byte{}, 100000, big.NewInt(0))
+			require.NoError(err)
+			acts = append(acts, tsf)
+		}
+		blk, err := block.NewTestingBuilder().
+			SetHeight(1).
+			SetPrevBlockHash(hash.ZeroHash256).
+			SetTimeStamp(time.Unix(1700000000, 0)).
+			AddActions(acts...).
+			SignAndBuild(identityset.PrivateKey(0))
+		require.NoError(err)
+		core.EXPECT().BlockByHeight(uint64(1)).Return(&apitypes.BlockWithReceipts{Block: &blk}, nil).Times(1)
+		core.EXPECT().Account(identityset.Address(27)).Return(&iotextypes.AccountMeta{Balance: "100", PendingNonce: 3}, nil, nil).Times(1)
+		code, res = post(`{"query":"{ block { transactions { from { balance } } } }"}`)
+		require.Equal(http.StatusOK, code)
+		require.Len(res.Get("errors").Array(), 1)
+		require.Contains(res.Get("errors.0.message").String(), "rate limit exceeded")
+	})
+}
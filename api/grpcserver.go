@@ -33,14 +33,17 @@ import (
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/api/apipb"
 	"github.com/iotexproject/iotex-core/api/logfilter"
 	apitypes "github.com/iotexproject/iotex-core/api/types"
 	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/consensus/scheme"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/recovery"
 	"github.com/iotexproject/iotex-core/pkg/tracer"
@@ -59,6 +62,20 @@ type (
 	}
 )
 
+// HeightMetadataKey is the key of the gRPC metadata to read the states at a past height in archive mode, which
+// is supported by GetAccount and ReadContractStorage
+const HeightMetadataKey = "height"
+
+// The keys of the gRPC metadata to page the actions of an address by cursor in GetActions, where the cursor of the
+// next page is returned in the NextCursorMetadataKey header, which is empty if there are no more actions
+const (
+	CursorMetadataKey      = "cursor"
+	OrderMetadataKey       = "order"
+	ActionTypesMetadataKey = "action-types"
+	CounterpartMetadataKey = "counterpart"
+	NextCursorMetadataKey  = "next-cursor"
+)
+
 // TODO: move this into config
 var (
 	kaep = keepalive.EnforcementPolicy{
@@ -81,7 +98,7 @@ func RecoveryInterceptor() grpc_recovery.Option {
 }
 
 // NewGRPCServer creates a new grpc server
-func NewGRPCServer(core CoreService, grpcPort int) *GRPCServer {
+func NewGRPCServer(core CoreService, grpcPort int, ac *AccessControl, monitor *requestMonitor) *GRPCServer {
 	if grpcPort == 0 {
 		return nil
 	}
@@ -91,11 +108,14 @@ func NewGRPCServer(core CoreService, grpcPort int) *GRPCServer {
 			grpc_prometheus.StreamServerInterceptor,
 			otelgrpc.StreamServerInterceptor(),
 			grpc_recovery.StreamServerInterceptor(RecoveryInterceptor()),
+			ac.StreamServerInterceptor(),
 		)),
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			grpc_prometheus.UnaryServerInterceptor,
 			otelgrpc.UnaryServerInterceptor(),
+			monitor.UnaryServerInterceptor(),
 			grpc_recovery.UnaryServerInterceptor(RecoveryInterceptor()),
+			ac.UnaryServerInterceptor(),
 		)),
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
@@ -104,6 +124,8 @@ func NewGRPCServer(core CoreService, grpcPort int) *GRPCServer {
 	//serviceName: grpc.health.v1.Health
 	grpc_health_v1.RegisterHealthServer(gSvr, health.NewServer())
 	iotexapi.RegisterAPIServiceServer(gSvr, newGRPCHandler(core))
+	apipb.RegisterLogStreamServiceServer(gSvr, newGRPCHandler(core))
+	apipb.RegisterConsensusServiceServer(gSvr, newGRPCHandler(core))
 	grpc_prometheus.Register(gSvr)
 	reflection.Register(gSvr)
 	return &GRPCServer{
@@ -158,7 +180,19 @@ func (svr *gRPCHandler) GetAccount(ctx context.Context, in *iotexapi.GetAccountR
 	if err != nil {
 		return nil, err
 	}
-	accountMeta, blockIdentifier, err := svr.coreService.Account(addr)
+	height, ok, err := heightFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		accountMeta     *iotextypes.AccountMeta
+		blockIdentifier *iotextypes.BlockIdentifier
+	)
+	if ok {
+		accountMeta, blockIdentifier, err = svr.coreService.AccountAtHeight(addr, height)
+	} else {
+		accountMeta, blockIdentifier, err = svr.coreService.Account(addr)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -192,7 +226,23 @@ func (svr *gRPCHandler) GetActions(ctx context.Context, in *iotexapi.GetActionsR
 		if err != nil {
 			return nil, err
 		}
-		ret, err = svr.coreService.ActionsByAddress(addr, request.Start, request.Count)
+		var query *apitypes.ActionHistoryQuery
+		query, err = historyQueryFromMetadata(ctx, request.Count)
+		if err != nil {
+			return nil, err
+		}
+		if query == nil {
+			ret, err = svr.coreService.ActionsByAddress(addr, request.Start, request.Count)
+			break
+		}
+		var next string
+		ret, next, err = svr.coreService.ActionsByAddressWithCursor(addr, query)
+		if err != nil {
+			return nil, err
+		}
+		if err = grpc.SetHeader(ctx, metadata.Pairs(NextCursorMetadataKey, next)); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
 	case in.GetUnconfirmedByAddr() != nil:
 		request := in.GetUnconfirmedByAddr()
 		ret, err = svr.coreService.UnconfirmedActionsByAddress(request.Address, request.Start, request.Count)
@@ -660,8 +710,20 @@ func (svr *gRPCHandler) ReadContractStorage(ctx context.Context, in *iotexapi.Re
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	b, err := svr.coreService.ReadContractStorage(ctx, addr, in.GetKey())
+	height, ok, err := heightFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var b []byte
+	if ok {
+		b, err = svr.coreService.ReadContractStorageAtHeight(ctx, addr, in.GetKey(), height)
+	} else {
+		b, err = svr.coreService.ReadContractStorage(ctx, addr, in.GetKey())
+	}
 	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	return &iotexapi.ReadContractStorageResponse{Data: b}, nil
@@ -709,6 +771,65 @@ func (svr *gRPCHandler) TraceTransactionStructLogs(ctx context.Context, in *iote
 	}, nil
 }
 
+// GetConsensusTimeline returns the consensus timeline of a recent height
+func (svr *gRPCHandler) GetConsensusTimeline(ctx context.Context, in *apipb.GetConsensusTimelineRequest) (*apipb.GetConsensusTimelineResponse, error) {
+	height := in.GetHeight()
+	if height == 0 {
+		// the height in consensus
+		height = svr.coreService.TipHeight() + 1
+	}
+	timeline, err := svr.coreService.ConsensusTimeline(height)
+	if err != nil {
+		return nil, err
+	}
+	return &apipb.GetConsensusTimelineResponse{
+		Timeline: consensusTimelineToPb(timeline),
+	}, nil
+}
+
+func consensusTimelineToPb(timeline *scheme.ConsensusTimeline) *apipb.ConsensusTimeline {
+	messagesToPb := func(records []*scheme.ConsensusMessageRecord) []*apipb.ConsensusMessageRecord {
+		msgs := make([]*apipb.ConsensusMessageRecord, 0, len(records))
+		for _, r := range records {
+			msgs = append(msgs, &apipb.ConsensusMessageRecord{
+				Topic:      r.Topic,
+				BlockHash:  r.BlockHash,
+				Endorser:   r.Endorser,
+				EndorsedAt: timestamppb.New(r.EndorsedAt),
+				ReceivedAt: timestamppb.New(r.ReceivedAt),
+				Round:      r.Round,
+			})
+		}
+		return msgs
+	}
+	pb := &apipb.ConsensusTimeline{
+		Height:       timeline.Height,
+		Proposals:    messagesToPb(timeline.Proposals),
+		Endorsements: messagesToPb(timeline.Endorsements),
+		FinalState:   timeline.FinalState,
+	}
+	for _, r := range timeline.Rounds {
+		pb.Rounds = append(pb.Rounds, &apipb.RoundRecord{
+			Round:              r.Round,
+			EpochNum:           r.EpochNum,
+			EpochStartHeight:   r.EpochStartHeight,
+			StartTime:          timestamppb.New(r.StartTime),
+			NextRoundStartTime: timestamppb.New(r.NextRoundStartTime),
+			Proposer:           r.Proposer,
+			Delegates:          r.Delegates,
+		})
+	}
+	for _, t := range timeline.Transitions {
+		pb.Transitions = append(pb.Transitions, &apipb.StateTransition{
+			Event: t.Event,
+			From:  t.From,
+			To:    t.To,
+			Time:  timestamppb.New(t.Time),
+		})
+	}
+	return pb
+}
+
 // generateBlockMeta generates BlockMeta from block
 func generateBlockMeta(blkStore *apitypes.BlockWithReceipts) *iotextypes.BlockMeta {
 	blk := blkStore.Block
@@ -759,3 +880,54 @@ func gasLimitAndUsed(acts []*action.SealedEnvelope, receipts []*action.Receipt)
 	}
 	return gasLimit, gasUsed
 }
+
+// heightFromMetadata returns the height in the gRPC metadata if there is one
+func heightFromMetadata(ctx context.Context) (uint64, bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, false, nil
+	}
+	values := md.Get(HeightMetadataKey)
+	if len(values) == 0 {
+		return 0, false, nil
+	}
+	height, err := strconv.ParseUint(values[0], 10, 64)
+	if err != nil {
+		return 0, false, status.Errorf(codes.InvalidArgument, "invalid height %s", values[0])
+	}
+	return height, true, nil
+}
+
+// historyQueryFromMetadata returns the cursor query in the gRPC metadata, or nil if there is no cursor
+func historyQueryFromMetadata(ctx context.Context, count uint64) (*apitypes.ActionHistoryQuery, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	cursors := md.Get(CursorMetadataKey)
+	if len(cursors) == 0 {
+		return nil, nil
+	}
+	query := &apitypes.ActionHistoryQuery{
+		Cursor:      cursors[0],
+		Count:       count,
+		ActionTypes: md.Get(ActionTypesMetadataKey),
+	}
+	if orders := md.Get(OrderMetadataKey); len(orders) > 0 {
+		switch orders[0] {
+		case "asc":
+		case "desc":
+			query.Descending = true
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid order %s", orders[0])
+		}
+	}
+	if counterparts := md.Get(CounterpartMetadataKey); len(counterparts) > 0 {
+		addr, err := address.FromString(counterparts[0])
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid counterpart %s", counterparts[0])
+		}
+		query.Counterpart = addr
+	}
+	return query, nil
+}
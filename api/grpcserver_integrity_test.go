@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"io"
 	"math"
 	"math/big"
 	"regexp"
@@ -24,7 +25,10 @@ import (
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -33,6 +37,7 @@ import (
 	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/action/protocol/poll"
 	"github.com/iotexproject/iotex-core/actpool"
+	"github.com/iotexproject/iotex-core/api/apipb"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/consensus"
@@ -1049,6 +1054,117 @@ func TestGrpcServer_GetActionsByAddressIntegrity(t *testing.T) {
 	}
 }
 
+type headerStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestGrpcServer_GetActionsByAddressWithCursorIntegrity(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+	cfg.api.GRPCPort = testutil.RandomPort()
+	svr, _, _, _, _, _, bfIndexFile, err := createServerV2(cfg, false)
+	require.NoError(err)
+	grpcHandler := newGRPCHandler(svr.core)
+	defer func() {
+		testutil.CleanupPath(bfIndexFile)
+	}()
+
+	addr := identityset.Address(30)
+	request := &iotexapi.GetActionsRequest{
+		Lookup: &iotexapi.GetActionsRequest_ByAddr{
+			ByAddr: &iotexapi.GetActionsByAddressRequest{
+				Address: addr.String(),
+				Start:   0,
+				Count:   4,
+			},
+		},
+	}
+	all, err := svr.core.ActionsByAddress(addr, 0, 9)
+	require.NoError(err)
+	require.Len(all, 9)
+	// pages through the actions, and returns their hashes
+	pages := func(pairs ...string) []string {
+		var (
+			hashes []string
+			cursor string
+		)
+		for i := 0; ; i++ {
+			require.Less(i, 10)
+			stream := &headerStream{}
+			ctx := grpc.NewContextWithServerTransportStream(
+				metadata.NewIncomingContext(context.Background(), metadata.Pairs(append(pairs, CursorMetadataKey, cursor)...)),
+				stream,
+			)
+			res, err := grpcHandler.GetActions(ctx, request)
+			require.NoError(err)
+			require.LessOrEqual(len(res.ActionInfo), 4)
+			for _, act := range res.ActionInfo {
+				hashes = append(hashes, act.ActHash)
+			}
+			next := stream.header.Get(NextCursorMetadataKey)
+			require.Len(next, 1)
+			if next[0] == "" {
+				return hashes
+			}
+			cursor = next[0]
+		}
+	}
+
+	t.Run("Ascending", func(t *testing.T) {
+		hashes := pages()
+		require.Len(hashes, len(all))
+		for i := range all {
+			require.Equal(all[i].ActHash, hashes[i])
+		}
+	})
+
+	t.Run("Descending", func(t *testing.T) {
+		hashes := pages(OrderMetadataKey, "desc")
+		require.Len(hashes, len(all))
+		for i := range all {
+			require.Equal(all[len(all)-1-i].ActHash, hashes[i])
+		}
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		var transfers, counterparts []string
+		peer := identityset.Address(27)
+		for _, act := range all {
+			if actionTypeName(act.Action.GetCore()) == "transfer" {
+				transfers = append(transfers, act.ActHash)
+			}
+			selp, err := (&action.Deserializer{}).SetEvmNetworkID(svr.core.EVMNetworkID()).ActionToSealedEnvelope(act.Action)
+			require.NoError(err)
+			if counterpart(addr, selp) == peer.String() {
+				counterparts = append(counterparts, act.ActHash)
+			}
+		}
+		require.NotEmpty(transfers)
+		require.NotEmpty(counterparts)
+		require.Equal(transfers, pages(ActionTypesMetadataKey, "transfer"))
+		require.Equal(counterparts, pages(CounterpartMetadataKey, peer.String()))
+	})
+
+	t.Run("InvalidCursor", func(t *testing.T) {
+		ascending := encodeHistoryCursor(1, false)
+		for _, pairs := range [][]string{
+			{CursorMetadataKey, "invalid"},
+			{CursorMetadataKey, ascending, OrderMetadataKey, "desc"},
+			{CursorMetadataKey, "", OrderMetadataKey, "random"},
+		} {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(pairs...))
+			_, err := grpcHandler.GetActions(ctx, request)
+			require.Equal(codes.InvalidArgument, status.Code(err))
+		}
+	})
+}
+
 func TestGrpcServer_GetUnconfirmedActionsByAddressIntegrity(t *testing.T) {
 	require := require.New(t)
 	cfg := newConfig()
@@ -2314,6 +2430,79 @@ func TestGrpcServer_GetLogsIntegrity(t *testing.T) {
 	require.Error(err)
 }
 
+func TestGrpcServer_StreamLogsInRangeIntegrity(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+	cfg.api.GRPCPort = testutil.RandomPort()
+	svr, _, _, _, _, _, bfIndexFile, err := createServerV2(cfg, false)
+	require.NoError(err)
+	defer func() {
+		testutil.CleanupPath(bfIndexFile)
+	}()
+	grpcSvr := NewGRPCServer(svr.core, cfg.api.GRPCPort, nil, nil)
+	ctx := context.Background()
+	require.NoError(grpcSvr.Start(ctx))
+	defer func() {
+		require.NoError(grpcSvr.Stop(ctx))
+	}()
+	conn, err := grpc.Dial("127.0.0.1:"+strconv.Itoa(cfg.api.GRPCPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(err)
+	defer conn.Close()
+
+	request := &iotexapi.GetLogsRequest{
+		Filter: &iotexapi.LogsFilter{},
+		Lookup: &iotexapi.GetLogsRequest_ByRange{
+			ByRange: &iotexapi.GetLogsByRange{FromBlock: 1, ToBlock: 100},
+		},
+	}
+	type response struct {
+		logs  int
+		token string
+	}
+	// streams the logs, and returns the number of logs and the resume token of each response
+	streamLogs := func(pairs ...string) ([]response, error) {
+		stream, err := apipb.NewLogStreamServiceClient(conn).StreamLogsInRange(metadata.NewOutgoingContext(ctx, metadata.Pairs(pairs...)), request)
+		require.NoError(err)
+		var ret []response
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					return ret, nil
+				}
+				return ret, err
+			}
+			ret = append(ret, response{
+				logs:  len(resp.Logs),
+				token: resp.ResumeToken,
+			})
+		}
+	}
+
+	all, err := streamLogs()
+	require.NoError(err)
+	var numLogs int
+	for _, r := range all {
+		numLogs += r.logs
+	}
+	require.Equal(_getLogsByRangeTest[0].numLogs, numLogs)
+	next, err := decodeResumeToken(all[len(all)-1].token)
+	require.NoError(err)
+	require.Equal(svr.core.TipHeight()+1, next)
+
+	// resumes after the first response
+	rest, err := streamLogs(ResumeTokenMetadataKey, all[0].token)
+	require.NoError(err)
+	require.Equal(all[1:], rest)
+	// resumes after the stream finished
+	rest, err = streamLogs(ResumeTokenMetadataKey, all[len(all)-1].token)
+	require.NoError(err)
+	require.Empty(rest)
+
+	_, err = streamLogs(ResumeTokenMetadataKey, "invalid")
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
 func TestGrpcServer_GetElectionBucketsIntegrity(t *testing.T) {
 	require := require.New(t)
 	cfg := newConfig()
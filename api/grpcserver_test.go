@@ -10,6 +10,7 @@ import (
 	"encoding/hex"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	"github.com/golang/mock/gomock"
@@ -18,10 +19,15 @@ import (
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/api/apipb"
 	apitypes "github.com/iotexproject/iotex-core/api/types"
 	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/consensus/scheme"
 	"github.com/iotexproject/iotex-core/pkg/version"
 	"github.com/iotexproject/iotex-core/test/identityset"
 	"github.com/iotexproject/iotex-core/test/mock/mock_apicoreservice"
@@ -74,6 +80,24 @@ func TestGrpcServer_GetAccount(t *testing.T) {
 		_, err := grpcSvr.GetAccount(context.Background(), request)
 		require.Contains(err.Error(), expectedErr.Error())
 	})
+
+	t.Run("get account at height", func(t *testing.T) {
+		addr := identityset.Address(1)
+		request := &iotexapi.GetAccountRequest{
+			Address: addr.String(),
+		}
+		core.EXPECT().AccountAtHeight(addr, uint64(10)).Return(&iotextypes.AccountMeta{Address: addr.String()}, &iotextypes.BlockIdentifier{Height: 10}, nil)
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(HeightMetadataKey, "10"))
+		res, err := grpcSvr.GetAccount(ctx, request)
+		require.NoError(err)
+		require.Equal(addr.String(), res.AccountMeta.Address)
+		require.Equal(uint64(10), res.BlockIdentifier.Height)
+
+		ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(HeightMetadataKey, "tip"))
+		_, err = grpcSvr.GetAccount(ctx, request)
+		require.Equal(codes.InvalidArgument, status.Code(err))
+	})
 }
 
 func TestGrpcServer_GetActions(t *testing.T) {
@@ -1063,3 +1087,44 @@ func getActionWithPayload() (act *iotextypes.Action) {
 	}
 	return
 }
+
+func TestGrpcServer_GetConsensusTimeline(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	grpcSvr := newGRPCHandler(core)
+
+	now := time.Unix(1700000000, 0)
+	timeline := &scheme.ConsensusTimeline{
+		Height: 11,
+		Rounds: []*scheme.RoundRecord{{Round: 1, StartTime: now, Proposer: identityset.Address(1).String()}},
+		Endorsements: []*scheme.ConsensusMessageRecord{
+			{Topic: "LOCK", BlockHash: "0102", Endorser: identityset.Address(2).String(), EndorsedAt: now, ReceivedAt: now, Round: 1},
+		},
+		Transitions: []*scheme.StateTransition{{Event: "E_PREPARE", From: "S_EPOCH_START", To: "S_PREPARE", Time: now}},
+		FinalState:  "S_PREPARE",
+	}
+	core.EXPECT().ConsensusTimeline(uint64(11)).Return(timeline, nil).Times(2)
+	res, err := grpcSvr.GetConsensusTimeline(context.Background(), &apipb.GetConsensusTimelineRequest{Height: 11})
+	require.NoError(err)
+	pb := res.GetTimeline()
+	require.Equal(uint64(11), pb.Height)
+	require.Len(pb.Rounds, 1)
+	require.Equal(identityset.Address(1).String(), pb.Rounds[0].Proposer)
+	require.True(pb.Rounds[0].StartTime.AsTime().Equal(now))
+	require.Len(pb.Endorsements, 1)
+	require.Equal(uint32(1), pb.Endorsements[0].Round)
+	require.Equal("LOCK", pb.Endorsements[0].Topic)
+	require.Len(pb.Transitions, 1)
+	require.Equal("S_PREPARE", pb.FinalState)
+
+	// the height in consensus is used if the height is zero
+	core.EXPECT().TipHeight().Return(uint64(10))
+	_, err = grpcSvr.GetConsensusTimeline(context.Background(), &apipb.GetConsensusTimelineRequest{})
+	require.NoError(err)
+
+	core.EXPECT().ConsensusTimeline(uint64(5)).Return(nil, status.Error(codes.NotFound, scheme.ErrTimelineNotFound.Error()))
+	_, err = grpcSvr.GetConsensusTimeline(context.Background(), &apipb.GetConsensusTimelineRequest{Height: 5})
+	require.Equal(codes.NotFound, status.Code(err))
+}
@@ -23,6 +23,7 @@ type (
 	// hTTPHandler handles requests from http protocol
 	hTTPHandler struct {
 		msgHandler Web3Handler
+		access     *AccessControl
 	}
 )
 
@@ -56,9 +57,10 @@ func (hSvr *HTTPServer) Stop(ctx context.Context) error {
 }
 
 // newHTTPHandler creates a new http handler
-func newHTTPHandler(web3Handler Web3Handler) *hTTPHandler {
+func newHTTPHandler(web3Handler Web3Handler, ac *AccessControl) *hTTPHandler {
 	return &hTTPHandler{
 		msgHandler: web3Handler,
+		access:     ac,
 	}
 }
 
@@ -68,7 +70,8 @@ func (handler *hTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request)
 		return
 	}
 
-	if err := handler.msgHandler.HandlePOSTReq(req.Context(), req.Body,
+	ctx := handler.access.withClient(withProtocol(req.Context(), _protocolHTTP), _protocolHTTP, req)
+	if err := handler.msgHandler.HandlePOSTReq(ctx, req.Body,
 		apitypes.NewResponseWriter(
 			func(resp interface{}) (int, error) {
 				w.Header().Set("Access-Control-Allow-Origin", "*")
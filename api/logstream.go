@@ -0,0 +1,92 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/api/apipb"
+	logfilter "github.com/iotexproject/iotex-core/api/logfilter"
+)
+
+// ResumeTokenMetadataKey is the key of the gRPC metadata to resume StreamLogsInRange from the resume token of the
+// last response received
+const ResumeTokenMetadataKey = "resume-token"
+
+const _resumeTokenLen = 8
+
+// StreamLogsInRange streams the logs among the range of blocks in the request as they are found. Each response
+// carries a resume token, with which a dropped stream continues after the response in the ResumeTokenMetadataKey
+// metadata of a new request.
+func (svr *gRPCHandler) StreamLogsInRange(in *iotexapi.GetLogsRequest, stream apipb.LogStreamService_StreamLogsInRangeServer) error {
+	if in.GetFilter() == nil {
+		return status.Error(codes.InvalidArgument, "empty filter")
+	}
+	req := in.GetByRange()
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "invalid StreamLogsInRange request type")
+	}
+	start, end := req.GetFromBlock(), req.GetToBlock()
+	if md, ok := metadata.FromIncomingContext(stream.Context()); ok {
+		if tokens := md.Get(ResumeTokenMetadataKey); len(tokens) > 0 {
+			next, err := decodeResumeToken(tokens[0])
+			if err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+			if (end != 0 && next > end) || next > svr.coreService.TipHeight() {
+				// the stream had finished
+				return nil
+			}
+			if next > start {
+				start = next
+			}
+		}
+	}
+	err := svr.coreService.StreamLogsInRange(stream.Context(), logfilter.NewLogFilter(in.GetFilter()), start, end, func(logs []*action.Log, blkHash hash.Hash256, next uint64) error {
+		resp := &apipb.StreamLogsInRangeResponse{
+			Logs:        make([]*iotextypes.Log, 0, len(logs)),
+			ResumeToken: encodeResumeToken(next),
+		}
+		for _, l := range logs {
+			resp.Logs = append(resp.Logs, toLogPb(l, blkHash))
+		}
+		return stream.Send(resp)
+	})
+	switch {
+	case err == nil:
+		return nil
+	case stream.Context().Err() != nil:
+		return status.FromContextError(stream.Context().Err()).Err()
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+func encodeResumeToken(next uint64) string {
+	b := make([]byte, _resumeTokenLen)
+	binary.BigEndian.PutUint64(b, next)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeResumeToken(token string) (uint64, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(b) != _resumeTokenLen {
+		return 0, errors.Errorf("invalid resume token %s", token)
+	}
+	return binary.BigEndian.Uint64(b), nil
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+const (
+	_codeOK            = "ok"
+	_codeError         = "error"
+	_methodUnknown     = "unknown"
+	_paramsRedacted    = "[redacted]"
+	_paramsTruncated   = "...(truncated)"
+	_slowRequestLogMsg = "slow api request"
+)
+
+type (
+	// SlowRequestConfig is the config of logging the requests slower than the threshold
+	SlowRequestConfig struct {
+		// Threshold is the duration beyond which a request is logged, 0 to disable the log
+		Threshold time.Duration `yaml:"threshold"`
+		// MaxParamsLength is the maximum length of the params in the log, beyond which they are truncated
+		MaxParamsLength int `yaml:"maxParamsLength"`
+	}
+
+	// requestMonitor records the latency of the requests on all of the API servers, and logs the slow ones
+	requestMonitor struct {
+		cfg SlowRequestConfig
+	}
+
+	protocolContextKey struct{}
+)
+
+var (
+	// DefaultSlowRequestConfig is the default config of the slow request log
+	DefaultSlowRequestConfig = SlowRequestConfig{
+		Threshold:       5 * time.Second,
+		MaxParamsLength: 512,
+	}
+
+	// _redactedMethods carry signed or secret payloads, of which the params are never logged
+	_redactedMethods = map[string]bool{
+		"eth_sendRawTransaction": true,
+		"eth_sendTransaction":    true,
+		"eth_sign":               true,
+		"eth_signTransaction":    true,
+		"SendAction":             true,
+	}
+
+	_apiRequestDurationMtc = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "iotex_api_request_duration_seconds",
+		Help:    "latency of api requests by protocol, method and result code.",
+		Buckets: prometheus.ExponentialBuckets(0.001, 4, 9),
+	}, []string{"protocol", "method", "code"})
+)
+
+func init() {
+	prometheus.MustRegister(_apiRequestDurationMtc)
+}
+
+// newRequestMonitor creates the monitor of the requests
+func newRequestMonitor(cfg SlowRequestConfig) *requestMonitor {
+	return &requestMonitor{cfg: cfg}
+}
+
+// observe records the latency of a request, and logs it with the sanitized params if it is slow. The params are
+// only read for a slow request.
+func (m *requestMonitor) observe(ctx context.Context, protocol, method, code string, start time.Time, params func() string) {
+	elapsed := time.Since(start)
+	_apiRequestDurationMtc.WithLabelValues(protocol, method, code).Observe(elapsed.Seconds())
+	if m == nil || m.cfg.Threshold <= 0 || elapsed < m.cfg.Threshold {
+		return
+	}
+	log.T(ctx).Warn(_slowRequestLogMsg,
+		zap.String("protocol", protocol),
+		zap.String("method", method),
+		zap.String("code", code),
+		zap.Duration("duration", elapsed),
+		zap.String("params", m.sanitize(method, params())))
+}
+
+// sanitize redacts the params of the methods carrying secrets, collapses the whitespaces so that a log entry stays
+// in one line, and truncates the params to the maximum length
+func (m *requestMonitor) sanitize(method, params string) string {
+	if _redactedMethods[method] {
+		return _paramsRedacted
+	}
+	params = strings.Join(strings.Fields(params), " ")
+	if max := m.cfg.MaxParamsLength; max > 0 && len(params) > max {
+		return strings.ToValidUTF8(params[:max], "") + _paramsTruncated
+	}
+	return params
+}
+
+// UnaryServerInterceptor records the latency of the unary gRPC calls. The streams are left out, which last as long
+// as the clients subscribe.
+func (m *requestMonitor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		code := _codeOK
+		if err != nil {
+			code = status.Code(err).String()
+		}
+		m.observe(ctx, _protocolGRPC, path.Base(info.FullMethod), code, start, func() string {
+			return fmt.Sprintf("%v", req)
+		})
+		return resp, err
+	}
+}
+
+// withProtocol returns a context carrying the protocol over which the web3 request is sent
+func withProtocol(ctx context.Context, protocol string) context.Context {
+	return context.WithValue(ctx, protocolContextKey{}, protocol)
+}
+
+// protocolFromContext returns the protocol over which the web3 request is sent, which is http if unknown
+func protocolFromContext(ctx context.Context) string {
+	if protocol, ok := ctx.Value(protocolContextKey{}).(string); ok {
+		return protocol
+	}
+	return _protocolHTTP
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSanitizeParams(t *testing.T) {
+	require := require.New(t)
+	m := newRequestMonitor(SlowRequestConfig{Threshold: time.Second, MaxParamsLength: 16})
+
+	require.Equal(`[{"to": "0x01"}, "latest"]`[:16]+_paramsTruncated, m.sanitize("eth_call", "[{\"to\":\n \"0x01\"},\t\"latest\"]"))
+	require.Equal(`["0x01", "latest"]`[:16]+_paramsTruncated, m.sanitize("eth_getBalance", "[\"0x01\",\r\n\"latest\"]"))
+	require.Equal(`["0x01"]`, m.sanitize("eth_getCode", `["0x01"]`))
+	require.Equal(_paramsRedacted, m.sanitize("eth_sendRawTransaction", `["0xf86c"]`))
+	require.Equal(_paramsRedacted, m.sanitize("SendAction", "action:<>"))
+
+	m = newRequestMonitor(SlowRequestConfig{})
+	params := strings.Repeat("a", 1024)
+	require.Equal(params, m.sanitize("eth_call", params))
+}
+
+func TestRequestMonitor(t *testing.T) {
+	t.Run("web3 protocol", func(t *testing.T) {
+		require := require.New(t)
+		ctx := context.Background()
+		require.Equal(_protocolHTTP, protocolFromContext(ctx))
+		require.Equal(_protocolWebsocket, protocolFromContext(withProtocol(ctx, _protocolWebsocket)))
+	})
+
+	t.Run("slow request reads params", func(t *testing.T) {
+		require := require.New(t)
+		var read bool
+		params := func() string {
+			read = true
+			return "[]"
+		}
+		start := time.Now().Add(-time.Minute)
+		newRequestMonitor(SlowRequestConfig{}).observe(context.Background(), _protocolHTTP, "eth_call", _codeOK, start, params)
+		require.False(read)
+		newRequestMonitor(DefaultSlowRequestConfig).observe(context.Background(), _protocolHTTP, "eth_call", _codeOK, time.Now(), params)
+		require.False(read)
+		newRequestMonitor(DefaultSlowRequestConfig).observe(context.Background(), _protocolHTTP, "eth_call", _codeOK, start, params)
+		require.True(read)
+	})
+
+	t.Run("grpc interceptor", func(t *testing.T) {
+		require := require.New(t)
+		var m *requestMonitor
+		info := &grpc.UnaryServerInfo{FullMethod: "/iotexapi.APIService/GetChainMeta"}
+		_, err := m.UnaryServerInterceptor()(context.Background(), nil, info, func(context.Context, interface{}) (interface{}, error) {
+			return nil, status.Error(codes.NotFound, "not found")
+		})
+		require.Equal(codes.NotFound, status.Code(err))
+		metric := &dto.Metric{}
+		require.NoError(_apiRequestDurationMtc.WithLabelValues(_protocolGRPC, "GetChainMeta", codes.NotFound.String()).(prometheus.Histogram).Write(metric))
+		require.Equal(uint64(1), metric.GetHistogram().GetSampleCount())
+	})
+}
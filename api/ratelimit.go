@@ -0,0 +1,127 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"sync"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/cache"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+)
+
+// error code of exceeding the limit: https://eips.ethereum.org/EIPS/eip-1474
+const _errCodeLimitExceeded = -32005
+
+type (
+	// RateLimitConfig is the config of limiting the requests of each client with a token bucket
+	RateLimitConfig struct {
+		Enabled bool `yaml:"enabled"`
+		// RequestsPerSecond is the rate of refilling the bucket of a client
+		RequestsPerSecond float64 `yaml:"requestsPerSecond"`
+		// Burst is the size of the bucket of a client
+		Burst int `yaml:"burst"`
+		// MethodCosts is the number of tokens taken by a call of the method, which is 1 if not listed. Besides the
+		// query itself, each field of a GraphQL query reading the chain, at the root or nested, is charged as the
+		// method "graphql_<data>" after the data read, e.g. "graphql_block" for both the root field block and the
+		// nested field parent of a block, and "graphql_account" for the fields of an account. A StreamLogsInRange
+		// stream is charged for each window of 10000 blocks it walks.
+		MethodCosts map[string]int `yaml:"methodCosts"`
+		// MaxClients is the max number of clients tracked, beyond which the least recently seen ones are dropped
+		MaxClients int `yaml:"maxClients"`
+	}
+
+	// RateLimiter limits the requests of each client with a token bucket
+	RateLimiter struct {
+		cfg      RateLimitConfig
+		mutex    sync.Mutex
+		limiters cache.LRUCache
+	}
+)
+
+var (
+	// DefaultRateLimitConfig is the default config of rate limiting
+	DefaultRateLimitConfig = RateLimitConfig{
+		Enabled:           false,
+		RequestsPerSecond: 50,
+		Burst:             100,
+		MethodCosts: map[string]int{
+			"eth_call":                     5,
+			"eth_estimateGas":              5,
+			"eth_getLogs":                  10,
+			"eth_getFilterLogs":            10,
+			"debug_traceTransaction":       50,
+			"debug_traceCall":              50,
+			"eth_callMany":                 20,
+			"eth_createAccessList":         20,
+			"iotex_simulateBundle":         20,
+			"ReadContract":                 5,
+			"EstimateActionGasConsumption": 5,
+			"GetLogs":                      10,
+			"StreamLogsInRange":            10,
+			"TraceTransactionStructLogs":   50,
+			"graphql_blocks":               10,
+			"graphql_logs":                 10,
+		},
+		MaxClients: 10000,
+	}
+
+	errRateLimited = errors.New("rate limit exceeded")
+
+	_apiRateLimitMtc = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "iotex_api_rate_limited",
+		Help: "number of api calls rejected by the rate limit.",
+	}, []string{"protocol", "method"})
+)
+
+func init() {
+	prometheus.MustRegister(_apiRateLimitMtc)
+}
+
+// NewRateLimiter creates a rate limiter, which is nil if rate limiting is disabled
+func NewRateLimiter(cfg RateLimitConfig) *RateLimiter {
+	if !cfg.Enabled {
+		return nil
+	}
+	return &RateLimiter{
+		cfg:      cfg,
+		limiters: cache.NewThreadSafeLruCache(cfg.MaxClients),
+	}
+}
+
+// Allow takes the tokens of calling the method from the bucket of the client, and returns false if not enough
+func (l *RateLimiter) Allow(client, method string) bool {
+	if l == nil {
+		return true
+	}
+	cost, ok := l.cfg.MethodCosts[method]
+	if !ok || cost < 1 {
+		cost = 1
+	}
+	if cost > l.cfg.Burst {
+		cost = l.cfg.Burst
+	}
+	l.mutex.Lock()
+	var limiter *rate.Limiter
+	if v, ok := l.limiters.Get(client); ok {
+		limiter = v.(*rate.Limiter)
+	} else {
+		limiter = rate.NewLimiter(rate.Limit(l.cfg.RequestsPerSecond), l.cfg.Burst)
+		l.limiters.Add(client, limiter)
+	}
+	l.mutex.Unlock()
+	return limiter.AllowN(time.Now(), cost)
+}
+
+func (l *RateLimiter) check(protocol, client, method string) error {
+	if l.Allow(client, method) {
+		return nil
+	}
+	_apiRateLimitMtc.WithLabelValues(protocol, method).Inc()
+	return errors.Wrapf(errRateLimited, "method %s", method)
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/test/mock/mock_apicoreservice"
+)
+
+func TestRateLimiter(t *testing.T) {
+	require := require.New(t)
+
+	require.Nil(NewRateLimiter(DefaultRateLimitConfig))
+	var disabled *RateLimiter
+	require.True(disabled.Allow("ip:127.0.0.1", "eth_getLogs"))
+
+	cfg := DefaultRateLimitConfig
+	cfg.Enabled = true
+	cfg.RequestsPerSecond = 0.001
+	cfg.Burst = 10
+	cfg.MaxClients = 2
+	l := NewRateLimiter(cfg)
+	// a method costs 1 token if not listed
+	for i := 0; i < 5; i++ {
+		require.True(l.Allow("ip:1.1.1.1", "eth_blockNumber"))
+	}
+	require.True(l.Allow("ip:1.1.1.1", "eth_call"))
+	require.False(l.Allow("ip:1.1.1.1", "eth_blockNumber"))
+	// the cost is capped by the burst, so that an expensive method can still be called
+	require.True(l.Allow("ip:2.2.2.2", "debug_traceCall"))
+	require.False(l.Allow("ip:2.2.2.2", "eth_blockNumber"))
+
+	// the number of clients tracked is capped, beyond which the least recently seen one is dropped
+	require.False(l.Allow("ip:1.1.1.1", "eth_blockNumber"))
+	for i := 0; i < 100; i++ {
+		require.True(l.Allow(fmt.Sprintf("ip:3.3.3.%d", i), "eth_blockNumber"))
+		require.LessOrEqual(l.limiters.Len(), cfg.MaxClients)
+	}
+	require.True(l.Allow("ip:1.1.1.1", "eth_blockNumber"))
+}
+
+func TestRateLimitHTTP(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	core.EXPECT().Track(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return().AnyTimes()
+	core.EXPECT().TipHeight().Return(uint64(1)).AnyTimes()
+
+	cfg := DefaultConfig
+	cfg.RateLimit.Enabled = true
+	cfg.RateLimit.RequestsPerSecond = 0.001
+	cfg.RateLimit.Burst = 2
+	cfg.Auth.Enabled = true
+	cfg.Auth.AllowLists = map[string][]string{"none": {}}
+	cfg.Auth.APIKeys = map[string]string{"key": "none"}
+	ac, err := NewAccessControl(cfg)
+	require.NoError(err)
+	svr := newHTTPHandler(NewWeb3Handler(core, "", _defaultBatchRequestLimit), ac)
+	post := func(remoteAddr, apiKey, body string) gjson.Result {
+		req, _ := http.NewRequest(http.MethodPost, "http://url.com", strings.NewReader(body))
+		req.RemoteAddr = remoteAddr
+		if apiKey != "" {
+			req.Header.Set(cfg.APIKeyHeader, apiKey)
+		}
+		resp := httptest.NewRecorder()
+		svr.ServeHTTP(resp, req)
+		raw, _ := io.ReadAll(resp.Body)
+		return gjson.ParseBytes(raw)
+	}
+	const blockNumber = `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`
+
+	// the requests in a batch are limited one by one
+	res := post("1.1.1.1:1000", "", "["+strings.Repeat(blockNumber+",", 2)+blockNumber+"]").Array()
+	require.Len(res, 3)
+	require.Equal("0x1", res[0].Get("result").String())
+	require.Equal("0x1", res[1].Get("result").String())
+	require.EqualValues(_errCodeLimitExceeded, res[2].Get("error.code").Int())
+	require.Contains(res[2].Get("error.message").String(), "rate limit exceeded")
+	// the client is identified by the IP regardless of the port
+	require.EqualValues(_errCodeLimitExceeded, post("1.1.1.1:2000", "", blockNumber).Get("error.code").Int())
+	require.Equal("0x1", post("2.2.2.2:1000", "", blockNumber).Get("result").String())
+	// or by the valid API key
+	require.Equal("0x1", post("1.1.1.1:1000", "key", blockNumber).Get("result").String())
+	require.Equal("0x1", post("2.2.2.2:1000", "key", blockNumber).Get("result").String())
+	require.EqualValues(_errCodeLimitExceeded, post("3.3.3.3:1000", "key", blockNumber).Get("error.code").Int())
+	// while the random keys share the bucket of the IP
+	require.EqualValues(_errCodeLimitExceeded, post("1.1.1.1:1000", "random1", blockNumber).Get("error.code").Int())
+	require.EqualValues(_errCodeLimitExceeded, post("1.1.1.1:1000", "random2", blockNumber).Get("error.code").Int())
+	require.Equal("0x1", post("4.4.4.4:1000", "random1", blockNumber).Get("result").String())
+	require.Equal("0x1", post("4.4.4.4:1000", "random2", blockNumber).Get("result").String())
+	require.EqualValues(_errCodeLimitExceeded, post("4.4.4.4:1000", "random3", blockNumber).Get("error.code").Int())
+}
+
+func TestRateLimitGRPC(t *testing.T) {
+	require := require.New(t)
+
+	cfg := DefaultConfig
+	cfg.RateLimit.Enabled = true
+	cfg.RateLimit.RequestsPerSecond = 0.001
+	cfg.RateLimit.Burst = 10
+	cfg.Auth.Enabled = true
+	cfg.Auth.AllowLists = map[string][]string{"none": {}}
+	cfg.Auth.APIKeys = map[string]string{"key": "none"}
+	ac, err := NewAccessControl(cfg)
+	require.NoError(err)
+	interceptor := ac.UnaryServerInterceptor()
+	handler := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+	call := func(ctx context.Context, method string) error {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/iotexapi.APIService/" + method}, handler)
+		return err
+	}
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(1, 1, 1, 1), Port: 1000}})
+	require.NoError(call(ctx, "GetLogs"))
+	err = call(ctx, "GetChainMeta")
+	require.Equal(codes.ResourceExhausted, status.Code(err))
+	require.Contains(err.Error(), "GetChainMeta")
+	// a random API key in the metadata does not
+	randomCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(strings.ToLower(cfg.APIKeyHeader), "random"))
+	require.Equal(codes.ResourceExhausted, status.Code(call(randomCtx, "GetChainMeta")))
+	// while the valid API key identifies the client
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(strings.ToLower(cfg.APIKeyHeader), "key"))
+	require.NoError(call(ctx, "GetChainMeta"))
+
+	// nothing is limited if disabled
+	interceptor = (*AccessControl)(nil).UnaryServerInterceptor()
+	for i := 0; i < 20; i++ {
+		require.NoError(call(context.Background(), "GetLogs"))
+	}
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestRateLimitGRPCStream(t *testing.T) {
+	require := require.New(t)
+
+	cfg := DefaultConfig
+	cfg.RateLimit.Enabled = true
+	cfg.RateLimit.RequestsPerSecond = 0.001
+	cfg.RateLimit.Burst = 25
+	ac, err := NewAccessControl(cfg)
+	require.NoError(err)
+	interceptor := ac.StreamServerInterceptor()
+	ss := &testServerStream{
+		ctx: peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(1, 1, 1, 1), Port: 1000}}),
+	}
+	info := &grpc.StreamServerInfo{FullMethod: "/apipb.LogStreamService/" + _streamLogsMethod}
+
+	// the opening of the stream pays for the first window, and each of the following ones is charged as it advances
+	var windows int
+	err = interceptor(nil, ss, info, func(_ interface{}, stream grpc.ServerStream) error {
+		for {
+			if err := checkAccess(stream.Context(), _streamLogsMethod); err != nil {
+				return err
+			}
+			windows++
+		}
+	})
+	require.Equal(codes.ResourceExhausted, status.Code(err))
+	require.Contains(err.Error(), _streamLogsMethod)
+	require.Equal(1, windows)
+	require.Equal(codes.ResourceExhausted, status.Code(interceptor(nil, ss, info, func(interface{}, grpc.ServerStream) error {
+		return nil
+	})))
+}
@@ -30,6 +30,8 @@ type ServerV2 struct {
 	grpcServer   *GRPCServer
 	httpSvr      *HTTPServer
 	websocketSvr *HTTPServer
+	graphqlSvr   *HTTPServer
+	filterCache  apiCache
 	tracer       *tracesdk.TracerProvider
 }
 
@@ -51,7 +53,9 @@ func NewServerV2(
 	if err != nil {
 		return nil, err
 	}
-	web3Handler := NewWeb3Handler(coreAPI, cfg.RedisCacheURL, cfg.BatchRequestLimit)
+	filterCache := newFilterCache(cfg)
+	monitor := newRequestMonitor(cfg.SlowRequest)
+	web3Handler := newWeb3Handler(coreAPI, newFilterStore(filterCache, cfg.Filter.MaxPerClient), cfg.BatchRequestLimit, monitor)
 
 	tp, err := tracer.NewProvider(
 		tracer.WithServiceName(cfg.Tracer.ServiceName),
@@ -63,15 +67,28 @@ func NewServerV2(
 		return nil, errors.Wrapf(err, "cannot config tracer provider")
 	}
 
-	wrappedWeb3Handler := otelhttp.NewHandler(newHTTPHandler(web3Handler), "web3.jsonrpc")
+	ac, err := NewAccessControl(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create api access control")
+	}
+
+	wrappedWeb3Handler := otelhttp.NewHandler(newHTTPHandler(web3Handler, ac), "web3.jsonrpc")
 
-	wrappedWebsocketHandler := otelhttp.NewHandler(NewWebsocketHandler(web3Handler), "web3.websocket")
+	wrappedWebsocketHandler := otelhttp.NewHandler(NewWebsocketHandler(web3Handler, ac), "web3.websocket")
+
+	graphqlHandler, err := NewGraphQLHandler(coreAPI, ac, monitor)
+	if err != nil {
+		return nil, err
+	}
+	wrappedGraphQLHandler := otelhttp.NewHandler(graphqlHandler, "graphql")
 
 	return &ServerV2{
 		core:         coreAPI,
-		grpcServer:   NewGRPCServer(coreAPI, cfg.GRPCPort),
+		grpcServer:   NewGRPCServer(coreAPI, cfg.GRPCPort, ac, monitor),
 		httpSvr:      NewHTTPServer("", cfg.HTTPPort, wrappedWeb3Handler),
 		websocketSvr: NewHTTPServer("", cfg.WebSocketPort, wrappedWebsocketHandler),
+		graphqlSvr:   NewHTTPServer("", cfg.GraphQLPort, wrappedGraphQLHandler),
+		filterCache:  filterCache,
 		tracer:       tp,
 	}, nil
 }
@@ -81,6 +98,11 @@ func (svr *ServerV2) Start(ctx context.Context) error {
 	if err := svr.core.Start(ctx); err != nil {
 		return err
 	}
+	if svr.filterCache != nil {
+		if err := svr.filterCache.Start(ctx); err != nil {
+			return errors.Wrap(err, "failed to start the cache of filters")
+		}
+	}
 	if svr.grpcServer != nil {
 		if err := svr.grpcServer.Start(ctx); err != nil {
 			return err
@@ -96,6 +118,11 @@ func (svr *ServerV2) Start(ctx context.Context) error {
 			return err
 		}
 	}
+	if svr.graphqlSvr != nil {
+		if err := svr.graphqlSvr.Start(ctx); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -106,6 +133,11 @@ func (svr *ServerV2) Stop(ctx context.Context) error {
 			return errors.Wrap(err, "failed to shutdown api tracer")
 		}
 	}
+	if svr.graphqlSvr != nil {
+		if err := svr.graphqlSvr.Stop(ctx); err != nil {
+			return err
+		}
+	}
 	if svr.websocketSvr != nil {
 		if err := svr.websocketSvr.Stop(ctx); err != nil {
 			return err
@@ -121,6 +153,11 @@ func (svr *ServerV2) Stop(ctx context.Context) error {
 			return err
 		}
 	}
+	if svr.filterCache != nil {
+		if err := svr.filterCache.Stop(ctx); err != nil {
+			return errors.Wrap(err, "failed to stop the cache of filters")
+		}
+	}
 	if err := svr.core.Stop(ctx); err != nil {
 		return err
 	}
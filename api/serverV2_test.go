@@ -22,9 +22,9 @@ func TestServerV2(t *testing.T) {
 	web3Handler := NewWeb3Handler(core, "", _defaultBatchRequestLimit)
 	svr := &ServerV2{
 		core:         core,
-		grpcServer:   NewGRPCServer(core, testutil.RandomPort()),
-		httpSvr:      NewHTTPServer("", testutil.RandomPort(), newHTTPHandler(web3Handler)),
-		websocketSvr: NewHTTPServer("", testutil.RandomPort(), NewWebsocketHandler(web3Handler)),
+		grpcServer:   NewGRPCServer(core, testutil.RandomPort(), nil, nil),
+		httpSvr:      NewHTTPServer("", testutil.RandomPort(), newHTTPHandler(web3Handler, nil)),
+		websocketSvr: NewHTTPServer("", testutil.RandomPort(), NewWebsocketHandler(web3Handler, nil)),
 	}
 	ctx := context.Background()
 
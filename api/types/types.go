@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 
+	"github.com/iotexproject/iotex-address/address"
+
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 )
@@ -37,6 +39,23 @@ type (
 		Block    *block.Block
 		Receipts []*action.Receipt
 	}
+
+	// ActionHistoryQuery queries the actions associated with an address page by page. The actions are ordered by
+	// height and action index, and a page continues from the opaque cursor returned by the previous page, so that
+	// the pages stay consistent while new blocks arrive.
+	ActionHistoryQuery struct {
+		// Cursor is the cursor returned by the previous page, which is empty for the first page
+		Cursor string
+		// Count is the maximum number of actions in the page
+		Count uint64
+		// Descending lists the latest actions first
+		Descending bool
+		// ActionTypes filters the actions by the names of their types in the ActionCore proto, such as "transfer"
+		// and "stakeCreate"
+		ActionTypes []string
+		// Counterpart filters the actions sent to or received from the address
+		Counterpart address.Address
+	}
 )
 
 // responseWriter for server
@@ -27,6 +27,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	rewardingabi "github.com/iotexproject/iotex-core/action/protocol/rewarding/ethabi"
 	stakingabi "github.com/iotexproject/iotex-core/action/protocol/staking/ethabi"
 	apitypes "github.com/iotexproject/iotex-core/api/types"
@@ -39,6 +40,10 @@ const (
 	_metamaskBalanceContractAddr = "io1k8uw2hrlvnfq8s2qpwwc24ws2ru54heenx8chr"
 	// _defaultBatchRequestLimit is the default maximum number of items in a batch.
 	_defaultBatchRequestLimit = 100 // Maximum number of items in a batch.
+
+	_defaultActionHistoryCount = 100
+	// _maxSimulateBundleSize is the maximum number of calls in a simulated bundle
+	_maxSimulateBundleSize = 100
 )
 
 type (
@@ -49,8 +54,9 @@ type (
 
 	web3Handler struct {
 		coreService       CoreService
-		cache             apiCache
+		filters           *filterStore
 		batchRequestLimit int
+		monitor           *requestMonitor
 	}
 )
 
@@ -62,6 +68,8 @@ type (
 		ToBlock    string     `json:"toBlock,omitempty"`
 		Address    []string   `json:"address,omitempty"`
 		Topics     [][]string `json:"topics,omitempty"`
+		// Client is the client installing the filter, so that the same filters of different clients are apart
+		Client string `json:"client,omitempty"`
 	}
 )
 
@@ -92,10 +100,15 @@ func init() {
 
 // NewWeb3Handler creates a handle to process web3 requests
 func NewWeb3Handler(core CoreService, cacheURL string, batchRequestLimit int) Web3Handler {
+	return newWeb3Handler(core, newFilterStore(newAPICache(DefaultFilterConfig.Expiry, cacheURL), 0), batchRequestLimit, nil)
+}
+
+func newWeb3Handler(core CoreService, filters *filterStore, batchRequestLimit int, monitor *requestMonitor) *web3Handler {
 	return &web3Handler{
 		coreService:       core,
-		cache:             newAPICache(15*time.Minute, cacheURL),
+		filters:           filters,
 		batchRequestLimit: batchRequestLimit,
+		monitor:           monitor,
 	}
 }
 
@@ -140,12 +153,34 @@ func (svr *web3Handler) handleWeb3Req(ctx context.Context, web3Req *gjson.Result
 		err, err1 error
 		method    = web3Req.Get("method").Value()
 		size      int
+		// methodLabel is the method in the metrics, which is bounded to the known methods
+		methodLabel = method.(string)
 	)
-	defer func(start time.Time) { svr.coreService.Track(ctx, start, method.(string), int64(size), err == nil) }(time.Now())
+	ctx, span := tracer.NewSpan(ctx, "web3."+methodLabel)
+	defer span.End()
+	defer func(start time.Time) {
+		svr.coreService.Track(ctx, start, method.(string), int64(size), err == nil)
+		code := _codeOK
+		if err != nil {
+			span.RecordError(err)
+			errCode, _ := web3Error(err)
+			code = strconv.Itoa(errCode)
+		}
+		svr.monitor.observe(ctx, protocolFromContext(ctx), methodLabel, code, start, func() string {
+			return web3Req.Get("params").Raw
+		})
+	}(time.Now())
 
 	log.T(ctx).Debug("handleWeb3Req", zap.String("method", method.(string)), zap.String("requestParams", fmt.Sprintf("%+v", web3Req)))
 	_web3ServerMtc.WithLabelValues(method.(string)).Inc()
 	_web3ServerMtc.WithLabelValues("requests_total").Inc()
+	if err = checkAccess(ctx, method.(string)); err != nil {
+		size, err1 = writer.Write(&web3Response{
+			id:  int(web3Req.Get("id").Int()),
+			err: err,
+		})
+		return err1
+	}
 	switch method {
 	case "eth_accounts":
 		res, err = svr.ethAccounts()
@@ -162,7 +197,7 @@ func (svr *web3Handler) handleWeb3Req(ctx context.Context, web3Req *gjson.Result
 	case "eth_getTransactionCount":
 		res, err = svr.getTransactionCount(web3Req)
 	case "eth_call":
-		res, err = svr.call(web3Req)
+		res, err = svr.call(ctx, web3Req)
 	case "eth_getCode":
 		res, err = svr.getCode(web3Req)
 	case "eth_protocolVersion":
@@ -192,7 +227,7 @@ func (svr *web3Handler) handleWeb3Req(ctx context.Context, web3Req *gjson.Result
 	case "eth_getBlockByNumber":
 		res, err = svr.getBlockByNumber(web3Req)
 	case "eth_estimateGas":
-		res, err = svr.estimateGas(web3Req)
+		res, err = svr.estimateGas(ctx, web3Req)
 	case "eth_sendRawTransaction":
 		res, err = svr.sendRawTransaction(web3Req)
 	case "eth_getTransactionByHash":
@@ -206,7 +241,7 @@ func (svr *web3Handler) handleWeb3Req(ctx context.Context, web3Req *gjson.Result
 	case "eth_getTransactionReceipt":
 		res, err = svr.getTransactionReceipt(web3Req)
 	case "eth_getStorageAt":
-		res, err = svr.getStorageAt(web3Req)
+		res, err = svr.getStorageAt(ctx, web3Req)
 	case "eth_getFilterLogs":
 		res, err = svr.getFilterLogs(web3Req)
 	case "eth_getFilterChanges":
@@ -217,10 +252,10 @@ func (svr *web3Handler) handleWeb3Req(ctx context.Context, web3Req *gjson.Result
 		var filter *filterObject
 		filter, err = parseLogRequest(web3Req.Get("params"))
 		if err == nil {
-			res, err = svr.newFilter(filter)
+			res, err = svr.newFilter(ctx, filter)
 		}
 	case "eth_newBlockFilter":
-		res, err = svr.newBlockFilter()
+		res, err = svr.newBlockFilter(ctx)
 	case "eth_subscribe":
 		res, err = svr.subscribe(web3Req, writer)
 	case "eth_unsubscribe":
@@ -229,11 +264,30 @@ func (svr *web3Handler) handleWeb3Req(ctx context.Context, web3Req *gjson.Result
 		res, err = svr.traceTransaction(ctx, web3Req)
 	case "debug_traceCall":
 		res, err = svr.traceCall(ctx, web3Req)
+	case "debug_consensusTimeline":
+		res, err = svr.consensusTimeline(web3Req)
+	case "eth_createAccessList":
+		res, err = svr.createAccessList(ctx, web3Req)
+	case "eth_callMany":
+		res, err = svr.callMany(ctx, web3Req)
+	case "iotex_simulateBundle":
+		res, err = svr.simulateBundle(ctx, web3Req)
+	case "iotex_getActionsByAddress":
+		res, err = svr.getActionsByAddress(web3Req)
+	case "evm_mine":
+		res, err = svr.evmMine(web3Req)
+	case "evm_increaseTime":
+		res, err = svr.evmIncreaseTime(web3Req)
+	case "evm_snapshot":
+		res, err = svr.evmSnapshot()
+	case "evm_revert":
+		res, err = svr.evmRevert(web3Req)
 	case "eth_coinbase", "eth_getUncleCountByBlockHash", "eth_getUncleCountByBlockNumber",
 		"eth_sign", "eth_signTransaction", "eth_sendTransaction", "eth_getUncleByBlockHashAndIndex",
 		"eth_getUncleByBlockNumberAndIndex", "eth_pendingTransactions":
 		res, err = svr.unimplemented()
 	default:
+		methodLabel = _methodUnknown
 		res, err = nil, errors.Wrapf(errors.New("web3 method not found"), "method: %s\n", web3Req.Get("method"))
 	}
 	if err != nil {
@@ -346,11 +400,15 @@ func (svr *web3Handler) getTransactionCount(in *gjson.Result) (interface{}, erro
 	return uint64ToHex(pendingNonce), nil
 }
 
-func (svr *web3Handler) call(in *gjson.Result) (interface{}, error) {
+func (svr *web3Handler) call(ctx context.Context, in *gjson.Result) (interface{}, error) {
 	callerAddr, to, gasLimit, gasPrice, value, data, err := parseCallObject(in)
 	if err != nil {
 		return nil, err
 	}
+	ctx, err = withStateOverride(ctx, in.Get("params.2"))
+	if err != nil {
+		return nil, err
+	}
 	if to == _metamaskBalanceContractAddr {
 		return nil, nil
 	}
@@ -385,7 +443,7 @@ func (svr *web3Handler) call(in *gjson.Result) (interface{}, error) {
 		return "0x" + ret, nil
 	}
 	exec, _ := action.NewExecution(to, 0, value, gasLimit, gasPrice, data)
-	ret, receipt, err := svr.coreService.ReadContract(context.Background(), callerAddr, exec)
+	ret, receipt, err := svr.coreService.ReadContract(ctx, callerAddr, exec)
 	if err != nil {
 		return nil, err
 	}
@@ -395,11 +453,15 @@ func (svr *web3Handler) call(in *gjson.Result) (interface{}, error) {
 	return "0x" + ret, nil
 }
 
-func (svr *web3Handler) estimateGas(in *gjson.Result) (interface{}, error) {
+func (svr *web3Handler) estimateGas(ctx context.Context, in *gjson.Result) (interface{}, error) {
 	from, to, gasLimit, gasPrice, value, data, err := parseCallObject(in)
 	if err != nil {
 		return nil, err
 	}
+	ctx, err = withStateOverride(ctx, in.Get("params.2"))
+	if err != nil {
+		return nil, err
+	}
 
 	var (
 		tx     *types.Transaction
@@ -427,7 +489,7 @@ func (svr *web3Handler) estimateGas(in *gjson.Result) (interface{}, error) {
 
 	var estimatedGas uint64
 	if exec, ok := elp.Action().(*action.Execution); ok {
-		estimatedGas, err = svr.coreService.EstimateExecutionGasConsumption(context.Background(), exec, from)
+		estimatedGas, err = svr.coreService.EstimateExecutionGasConsumption(ctx, exec, from)
 	} else {
 		estimatedGas, err = svr.coreService.EstimateGasForNonExecution(elp.Action())
 	}
@@ -737,7 +799,7 @@ func (svr *web3Handler) getTransactionByBlockNumberAndIndex(in *gjson.Result) (i
 	return svr.assembleConfirmedTransaction(blk.Block.HashBlock(), blk.Block.Actions[idx], blk.Receipts[idx])
 }
 
-func (svr *web3Handler) getStorageAt(in *gjson.Result) (interface{}, error) {
+func (svr *web3Handler) getStorageAt(ctx context.Context, in *gjson.Result) (interface{}, error) {
 	ethAddr, storagePos := in.Get("params.0"), in.Get("params.1")
 	if !ethAddr.Exists() || !storagePos.Exists() {
 		return nil, errInvalidFormat
@@ -750,14 +812,14 @@ func (svr *web3Handler) getStorageAt(in *gjson.Result) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-	val, err := svr.coreService.ReadContractStorage(context.Background(), contractAddr, pos)
+	val, err := svr.coreService.ReadContractStorage(ctx, contractAddr, pos)
 	if err != nil {
 		return nil, err
 	}
 	return "0x" + hex.EncodeToString(val), nil
 }
 
-func (svr *web3Handler) newFilter(filter *filterObject) (interface{}, error) {
+func (svr *web3Handler) newFilter(ctx context.Context, filter *filterObject) (interface{}, error) {
 	//check the validity of filter before caching
 	if filter == nil {
 		return nil, errNullPointer
@@ -781,27 +843,23 @@ func (svr *web3Handler) newFilter(filter *filterObject) (interface{}, error) {
 		}
 	}
 
-	// cache filter and return hash value of the filter as filter id
+	// store filter and return hash value of the filter as filter id
 	filter.FilterType = "log"
-	objInByte, _ := json.Marshal(*filter)
-	keyHash := hash.Hash256b(objInByte)
-	filterID := hex.EncodeToString(keyHash[:])
-	err := svr.cache.Set(filterID, objInByte)
+	filter.Client = clientIDFromContext(ctx)
+	filterID, err := svr.filters.add(filter)
 	if err != nil {
 		return nil, err
 	}
 	return "0x" + filterID, nil
 }
 
-func (svr *web3Handler) newBlockFilter() (interface{}, error) {
+func (svr *web3Handler) newBlockFilter(ctx context.Context) (interface{}, error) {
 	filterObj := filterObject{
 		FilterType: "block",
 		LogHeight:  svr.coreService.TipHeight(),
+		Client:     clientIDFromContext(ctx),
 	}
-	objInByte, _ := json.Marshal(filterObj)
-	keyHash := hash.Hash256b(objInByte)
-	filterID := hex.EncodeToString(keyHash[:])
-	err := svr.cache.Set(filterID, objInByte)
+	filterID, err := svr.filters.add(&filterObj)
 	if err != nil {
 		return nil, err
 	}
@@ -813,7 +871,7 @@ func (svr *web3Handler) uninstallFilter(in *gjson.Result) (interface{}, error) {
 	if !id.Exists() {
 		return nil, errInvalidFormat
 	}
-	return svr.cache.Del(util.Remove0xPrefix(id.String())), nil
+	return svr.filters.remove(util.Remove0xPrefix(id.String())), nil
 }
 
 func (svr *web3Handler) getFilterChanges(in *gjson.Result) (interface{}, error) {
@@ -822,7 +880,7 @@ func (svr *web3Handler) getFilterChanges(in *gjson.Result) (interface{}, error)
 		return nil, errInvalidFormat
 	}
 	filterID := util.Remove0xPrefix(id.String())
-	filterObj, err := loadFilterFromCache(svr.cache, filterID)
+	filterObj, err := svr.filters.get(filterID)
 	if err != nil {
 		return nil, err
 	}
@@ -869,8 +927,7 @@ func (svr *web3Handler) getFilterChanges(in *gjson.Result) (interface{}, error)
 
 	// update the logHeight of filter cache
 	filterObj.LogHeight = newLogHeight
-	objInByte, _ := json.Marshal(filterObj)
-	if err = svr.cache.Set(filterID, objInByte); err != nil {
+	if err = svr.filters.update(filterID, filterObj); err != nil {
 		return nil, err
 	}
 	return ret, nil
@@ -882,7 +939,7 @@ func (svr *web3Handler) getFilterLogs(in *gjson.Result) (interface{}, error) {
 		return nil, errInvalidFormat
 	}
 	filterID := util.Remove0xPrefix(id.String())
-	filterObj, err := loadFilterFromCache(svr.cache, filterID)
+	filterObj, err := svr.filters.get(filterID)
 	if err != nil {
 		return nil, err
 	}
@@ -988,6 +1045,335 @@ func (svr *web3Handler) traceTransaction(ctx context.Context, in *gjson.Result)
 	}
 }
 
+func (svr *web3Handler) consensusTimeline(in *gjson.Result) (interface{}, error) {
+	heightStr := in.Get("params.0")
+	if !heightStr.Exists() {
+		return nil, errInvalidFormat
+	}
+	var (
+		height uint64
+		err    error
+	)
+	if heightStr.String() == _pendingBlockNumber {
+		// the height in consensus
+		height = svr.coreService.TipHeight() + 1
+	} else if height, err = svr.parseBlockNumber(heightStr.String()); err != nil {
+		return nil, err
+	}
+	return svr.coreService.ConsensusTimeline(height)
+}
+
+func (svr *web3Handler) getActionsByAddress(in *gjson.Result) (interface{}, error) {
+	addr, options := in.Get("params.0"), in.Get("params.1")
+	if !addr.Exists() {
+		return nil, errInvalidFormat
+	}
+	ioAddr, err := ethAddrToIoAddr(addr.String())
+	if err != nil {
+		return nil, err
+	}
+	query := &apitypes.ActionHistoryQuery{
+		Cursor: options.Get("cursor").String(),
+		Count:  _defaultActionHistoryCount,
+	}
+	if count := options.Get("count"); count.Exists() {
+		if query.Count, err = parseQuantity(count); err != nil {
+			return nil, err
+		}
+	}
+	switch order := options.Get("order").String(); order {
+	case "", "asc":
+	case "desc":
+		query.Descending = true
+	default:
+		return nil, errors.Wrapf(errUnkownType, "order: %s", order)
+	}
+	for _, t := range options.Get("actionTypes").Array() {
+		query.ActionTypes = append(query.ActionTypes, t.String())
+	}
+	if counterpart := options.Get("counterpart"); counterpart.Exists() {
+		if query.Counterpart, err = ethAddrToIoAddr(counterpart.String()); err != nil {
+			return nil, err
+		}
+	}
+	acts, next, err := svr.coreService.ActionsByAddressWithCursor(ioAddr, query)
+	if err != nil {
+		return nil, err
+	}
+	ret := &getActionsByAddressResult{
+		Actions:    make([]*actionHistoryResult, 0, len(acts)),
+		NextCursor: next,
+	}
+	for _, act := range acts {
+		selp, err := (&action.Deserializer{}).SetEvmNetworkID(svr.coreService.EVMNetworkID()).ActionToSealedEnvelope(act.Action)
+		if err != nil {
+			return nil, err
+		}
+		from, err := ioAddrToEthAddr(act.Sender)
+		if err != nil {
+			return nil, err
+		}
+		var to *string
+		if dst, ok := selp.Destination(); ok && dst != "" {
+			ethDst, err := ioAddrToEthAddr(dst)
+			if err != nil {
+				return nil, err
+			}
+			to = &ethDst
+		}
+		ret.Actions = append(ret.Actions, &actionHistoryResult{
+			Hash:             "0x" + act.ActHash,
+			BlockHash:        "0x" + act.BlkHash,
+			BlockNumber:      uint64ToHex(act.BlkHeight),
+			TransactionIndex: uint64ToHex(uint64(act.Index)),
+			From:             from,
+			To:               to,
+			Type:             actionTypeName(act.Action.GetCore()),
+			Timestamp:        uint64ToHex(uint64(act.Timestamp.GetSeconds())),
+		})
+	}
+	return ret, nil
+}
+
+// createAccessList returns the access list of the call, with the params [call, block] in which the access list of the
+// call seeds the result
+func (svr *web3Handler) createAccessList(ctx context.Context, in *gjson.Result) (interface{}, error) {
+	from, to, gasLimit, _, value, data, err := parseCallObject(in)
+	if err != nil {
+		return nil, err
+	}
+	var seed types.AccessList
+	if list := in.Get("params.0.accessList"); list.Exists() {
+		if err := json.Unmarshal([]byte(list.Raw), &seed); err != nil {
+			return nil, errors.Wrapf(errUnkownType, "accessList: %s", list.Raw)
+		}
+	}
+	exec, err := action.NewExecutionWithAccessList(to, 0, value, gasLimit, big.NewInt(0), data, seed)
+	if err != nil {
+		return nil, err
+	}
+	list, receipt, gasWithoutList, err := svr.coreService.CreateAccessList(ctx, from, exec)
+	if err != nil {
+		return nil, err
+	}
+	if list == nil {
+		list = types.AccessList{}
+	}
+	ret := &createAccessListResult{
+		AccessList:               list,
+		GasUsed:                  uint64ToHex(receipt.GasConsumed),
+		GasUsedWithoutAccessList: uint64ToHex(gasWithoutList),
+	}
+	ret.Error = executionError(receipt)
+	return ret, nil
+}
+
+// executionError returns the error of the execution failed, or empty if it succeeded
+func executionError(receipt *action.Receipt) string {
+	if receipt.Status == uint64(iotextypes.ReceiptStatus_Success) {
+		return ""
+	}
+	if msg := receipt.ExecutionRevertMsg(); msg != "" {
+		return "execution reverted: " + msg
+	}
+	return fmt.Sprintf("execution failed: status = %d", receipt.Status)
+}
+
+// simulateBundle runs the calls one after another on the state left by the previous ones, with the params
+// [calls, block, stateOverride, blockOverride] in which only the calls are required. The calls run on top of the
+// tip, so the block can only be latest or pending.
+func (svr *web3Handler) simulateBundle(ctx context.Context, in *gjson.Result) (interface{}, error) {
+	calls := in.Get("params.0")
+	if !calls.IsArray() || len(calls.Array()) == 0 {
+		return nil, errInvalidFormat
+	}
+	if len(calls.Array()) > _maxSimulateBundleSize {
+		return nil, errors.Wrapf(errInvalidFormat, "more than %d calls in the bundle", _maxSimulateBundleSize)
+	}
+	switch blk := in.Get("params.1").String(); blk {
+	case "", _latestBlockNumber, _pendingBlockNumber:
+	default:
+		return nil, errors.Wrapf(errInvalidBlock, "bundle can only be simulated on the latest block, not %s", blk)
+	}
+	callers, execs, err := parseBundleCalls(calls)
+	if err != nil {
+		return nil, err
+	}
+	stateOverride, err := parseStateOverride(in.Get("params.2"))
+	if err != nil {
+		return nil, err
+	}
+	blockOverride, err := parseBlockOverride(in.Get("params.3"))
+	if err != nil {
+		return nil, err
+	}
+	retvals, receipts, err := svr.coreService.SimulateExecutions(ctx, callers, execs, stateOverride, []evm.SimulationBlock{{
+		Size:     len(execs),
+		Override: blockOverride,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*simulateCallResult, 0, len(receipts))
+	for i, receipt := range receipts {
+		res := &simulateCallResult{
+			ReturnData: "0x" + hex.EncodeToString(retvals[i]),
+			Logs:       make([]*getLogsResult, 0, len(receipt.Logs())),
+			GasUsed:    uint64ToHex(receipt.GasConsumed),
+			Status:     uint64ToHex(receipt.Status),
+			Revert:     receipt.ExecutionRevertMsg(),
+		}
+		for _, l := range receipt.Logs() {
+			res.Logs = append(res.Logs, &getLogsResult{log: l})
+		}
+		if res.BalanceDiffs, err = balanceDiffs(receipt.TransactionLogs()); err != nil {
+			return nil, err
+		}
+		ret = append(ret, res)
+	}
+	return ret, nil
+}
+
+// callMany runs the bundles of calls one after another, each in a block of its own, with the params
+// [bundles, simulationContext, stateOverride] of which a bundle is {transactions, blockOverride}. The bundles run on
+// top of the tip, so the simulation context can only be at the end of the latest block.
+func (svr *web3Handler) callMany(ctx context.Context, in *gjson.Result) (interface{}, error) {
+	bundles := in.Get("params.0")
+	if !bundles.IsArray() || len(bundles.Array()) == 0 {
+		return nil, errInvalidFormat
+	}
+	simCtx := in.Get("params.1")
+	switch blk := simCtx.Get("blockNumber").String(); blk {
+	case "", _latestBlockNumber, _pendingBlockNumber:
+	default:
+		return nil, errors.Wrapf(errInvalidBlock, "bundles can only be simulated on the latest block, not %s", blk)
+	}
+	if idx := simCtx.Get("transactionIndex"); idx.Exists() && idx.Int() != -1 {
+		return nil, errors.Wrapf(errInvalidBlock, "bundles can only be simulated at the end of the block, not at transaction %s", idx.String())
+	}
+	var (
+		callers []address.Address
+		execs   []*action.Execution
+		blocks  = make([]evm.SimulationBlock, 0, len(bundles.Array()))
+	)
+	for _, bundle := range bundles.Array() {
+		calls := bundle.Get("transactions")
+		if !calls.IsArray() || len(calls.Array()) == 0 {
+			return nil, errors.Wrap(errInvalidFormat, "empty bundle")
+		}
+		if len(execs)+len(calls.Array()) > _maxSimulateBundleSize {
+			return nil, errors.Wrapf(errInvalidFormat, "more than %d calls in the bundles", _maxSimulateBundleSize)
+		}
+		bundleCallers, bundleExecs, err := parseBundleCalls(calls)
+		if err != nil {
+			return nil, err
+		}
+		blockOverride, err := parseBlockOverride(bundle.Get("blockOverride"))
+		if err != nil {
+			return nil, err
+		}
+		callers = append(callers, bundleCallers...)
+		execs = append(execs, bundleExecs...)
+		blocks = append(blocks, evm.SimulationBlock{Size: len(bundleExecs), Override: blockOverride})
+	}
+	stateOverride, err := parseStateOverride(in.Get("params.2"))
+	if err != nil {
+		return nil, err
+	}
+	retvals, receipts, err := svr.coreService.SimulateExecutions(ctx, callers, execs, stateOverride, blocks)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		ret = make([][]*callManyResult, 0, len(blocks))
+		i   int
+	)
+	for _, blk := range blocks {
+		results := make([]*callManyResult, 0, blk.Size)
+		for ; len(results) < blk.Size; i++ {
+			res := &callManyResult{Error: executionError(receipts[i])}
+			if res.Error == "" {
+				res.Value = "0x" + hex.EncodeToString(retvals[i])
+			}
+			results = append(results, res)
+		}
+		ret = append(ret, results)
+	}
+	return ret, nil
+}
+
+// parseBundleCalls parses the calls of a bundle into the executions and their callers
+func parseBundleCalls(calls gjson.Result) ([]address.Address, []*action.Execution, error) {
+	var (
+		callers = make([]address.Address, 0, len(calls.Array()))
+		execs   = make([]*action.Execution, 0, len(calls.Array()))
+	)
+	for _, call := range calls.Array() {
+		from, to, gasLimit, gasPrice, value, data, err := parseCall(call)
+		if err != nil {
+			return nil, nil, err
+		}
+		exec, err := action.NewExecution(to, 0, value, gasLimit, gasPrice, data)
+		if err != nil {
+			return nil, nil, err
+		}
+		callers = append(callers, from)
+		execs = append(execs, exec)
+	}
+	return callers, execs, nil
+}
+
+func (svr *web3Handler) evmMine(in *gjson.Result) (interface{}, error) {
+	var timestamp time.Time
+	if tsStr := in.Get("params.0"); tsStr.Exists() {
+		ts, err := parseQuantity(tsStr)
+		if err != nil {
+			return nil, err
+		}
+		timestamp = time.Unix(int64(ts), 0)
+	}
+	if err := svr.coreService.DevMine(timestamp); err != nil {
+		return nil, err
+	}
+	return "0x0", nil
+}
+
+func (svr *web3Handler) evmIncreaseTime(in *gjson.Result) (interface{}, error) {
+	deltaStr := in.Get("params.0")
+	if !deltaStr.Exists() {
+		return nil, errInvalidFormat
+	}
+	delta, err := parseQuantity(deltaStr)
+	if err != nil {
+		return nil, err
+	}
+	offset, err := svr.coreService.DevIncreaseTime(time.Duration(delta) * time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return int64(offset / time.Second), nil
+}
+
+func (svr *web3Handler) evmSnapshot() (interface{}, error) {
+	id, err := svr.coreService.DevSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return uint64ToHex(id), nil
+}
+
+func (svr *web3Handler) evmRevert(in *gjson.Result) (interface{}, error) {
+	idStr := in.Get("params.0")
+	if !idStr.Exists() {
+		return nil, errInvalidFormat
+	}
+	id, err := parseQuantity(idStr)
+	if err != nil {
+		return nil, err
+	}
+	return svr.coreService.DevRevert(id)
+}
+
 func (svr *web3Handler) traceCall(ctx context.Context, in *gjson.Result) (interface{}, error) {
 	var (
 		err          error
@@ -1014,6 +1400,9 @@ func (svr *web3Handler) traceCall(ctx context.Context, in *gjson.Result) (interf
 		enableMemory, disableStack, disableStorage, enableReturnData bool
 		tracerJs, tracerTimeout                                      *string
 	)
+	if ctx, err = withStateOverride(ctx, options.Get("stateOverrides")); err != nil {
+		return nil, err
+	}
 	if options.Exists() {
 		enableMemory = options.Get("enableMemory").Bool()
 		disableStack = options.Get("disableStack").Bool()
@@ -85,12 +85,63 @@ type (
 		Gas         uint64               `json:"gas"`
 		StructLogs  []apitypes.StructLog `json:"structLogs"`
 	}
+
+	getActionsByAddressResult struct {
+		Actions    []*actionHistoryResult `json:"actions"`
+		NextCursor string                 `json:"nextCursor"`
+	}
+
+	actionHistoryResult struct {
+		Hash             string  `json:"hash"`
+		BlockHash        string  `json:"blockHash"`
+		BlockNumber      string  `json:"blockNumber"`
+		TransactionIndex string  `json:"transactionIndex"`
+		From             string  `json:"from"`
+		To               *string `json:"to"`
+		Type             string  `json:"type"`
+		Timestamp        string  `json:"timestamp"`
+	}
+
+	createAccessListResult struct {
+		AccessList               types.AccessList `json:"accessList"`
+		GasUsed                  string           `json:"gasUsed"`
+		GasUsedWithoutAccessList string           `json:"gasUsedWithoutAccessList"`
+		Error                    string           `json:"error,omitempty"`
+	}
+
+	// callManyResult is the result of a call of eth_callMany, with either the value returned or the error
+	callManyResult struct {
+		Value string `json:"value,omitempty"`
+		Error string `json:"error,omitempty"`
+	}
+
+	simulateCallResult struct {
+		ReturnData string           `json:"returnData"`
+		Logs       []*getLogsResult `json:"logs"`
+		GasUsed    string           `json:"gasUsed"`
+		Status     string           `json:"status"`
+		Revert     string           `json:"revert,omitempty"`
+		// BalanceDiffs maps the addresses to the changes of their balance
+		BalanceDiffs map[string]string `json:"balanceDiffs"`
+	}
 )
 
 var (
 	errInvalidObject = errors.New("invalid object")
 )
 
+// web3Error returns the code and the message of the error responded to a web3 request
+func web3Error(err error) (int, string) {
+	// error code: https://eth.wiki/json-rpc/json-rpc-error-codes-improvement-proposal
+	if s, ok := status.FromError(err); ok {
+		return int(s.Code()), s.Message()
+	}
+	if errors.Cause(err) == errRateLimited {
+		return _errCodeLimitExceeded, err.Error()
+	}
+	return -32603, err.Error()
+}
+
 func (obj *web3Response) MarshalJSON() ([]byte, error) {
 	if obj.err == nil {
 		return json.Marshal(&struct {
@@ -104,17 +155,7 @@ func (obj *web3Response) MarshalJSON() ([]byte, error) {
 		})
 	}
 
-	var (
-		errCode int
-		errMsg  string
-	)
-	// error code: https://eth.wiki/json-rpc/json-rpc-error-codes-improvement-proposal
-	if s, ok := status.FromError(obj.err); ok {
-		errCode, errMsg = int(s.Code()), s.Message()
-	} else {
-		errCode, errMsg = -32603, obj.err.Error()
-	}
-
+	errCode, errMsg := web3Error(obj.err)
 	return json.Marshal(&struct {
 		Jsonrpc string     `json:"jsonrpc"`
 		ID      int        `json:"id"`
@@ -14,22 +14,28 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/go-pkgs/util"
+	"github.com/iotexproject/iotex-address/address"
 	"github.com/iotexproject/iotex-proto/golang/iotexapi"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	apitypes "github.com/iotexproject/iotex-core/api/types"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/consensus/scheme"
 	"github.com/iotexproject/iotex-core/test/identityset"
 	"github.com/iotexproject/iotex-core/test/mock/mock_apicoreservice"
 	mock_apitypes "github.com/iotexproject/iotex-core/test/mock/mock_apiresponder"
@@ -103,7 +109,7 @@ func TestHandlePost(t *testing.T) {
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
 	core.EXPECT().Track(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return().AnyTimes()
-	svr := newHTTPHandler(NewWeb3Handler(core, "", _defaultBatchRequestLimit))
+	svr := newHTTPHandler(NewWeb3Handler(core, "", _defaultBatchRequestLimit), nil)
 	getServerResp := func(svr *hTTPHandler, req *http.Request) *httptest.ResponseRecorder {
 		req.Header.Set("Content-Type", "application/json")
 		resp := httptest.NewRecorder()
@@ -171,7 +177,7 @@ func TestGasPrice(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 	core.EXPECT().SuggestGasPrice().Return(uint64(1), nil)
 	ret, err := web3svr.gasPrice()
 	require.NoError(err)
@@ -187,7 +193,7 @@ func TestGetChainID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 	core.EXPECT().EVMNetworkID().Return(uint32(1))
 	ret, err := web3svr.getChainID()
 	require.NoError(err)
@@ -199,7 +205,7 @@ func TestGetBlockNumber(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 	core.EXPECT().TipHeight().Return(uint64(1))
 	ret, err := web3svr.getBlockNumber()
 	require.NoError(err)
@@ -211,7 +217,7 @@ func TestGetBlockByNumber(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 
 	tsf, err := action.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
@@ -262,7 +268,7 @@ func TestGetBalance(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 	balance := "111111111111111111"
 	core.EXPECT().Account(gomock.Any()).Return(&iotextypes.AccountMeta{Balance: balance}, nil, nil)
 
@@ -279,7 +285,7 @@ func TestGetTransactionCount(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 	core.EXPECT().PendingNonce(gomock.Any()).Return(uint64(2), nil)
 
 	inNil := gjson.Parse(`{"params":[]}`)
@@ -297,7 +303,7 @@ func TestCall(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 
 	t.Run("to is StakingProtocol addr", func(t *testing.T) {
 		meta := &iotextypes.AccountMeta{
@@ -317,7 +323,7 @@ func TestCall(t *testing.T) {
 			"data":     "d201114a"
 		   },
 		   1]}`)
-		ret, err := web3svr.call(&in)
+		ret, err := web3svr.call(context.Background(), &in)
 		require.NoError(err)
 		require.Equal("0x0000000000000000000000000000000000000000000000056bc75e2d63100000", ret.(string))
 	})
@@ -336,7 +342,7 @@ func TestCall(t *testing.T) {
 			"data":     "ad7a672f"
 		   },
 		   1]}`)
-		ret, err := web3svr.call(&in)
+		ret, err := web3svr.call(context.Background(), &in)
 		require.NoError(err)
 		require.Equal("0x0000000000000000000000000000000000000000000000000000000000002710", ret.(string))
 	})
@@ -352,11 +358,37 @@ func TestCall(t *testing.T) {
 			"data":     "0x1"
 		   },
 		   1]}`)
-		ret, err := web3svr.call(&in)
+		ret, err := web3svr.call(context.Background(), &in)
 		require.NoError(err)
 		require.Equal("0x111111", ret.(string))
 	})
 
+	t.Run("state override", func(t *testing.T) {
+		core.EXPECT().ReadContract(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, _ address.Address, _ *action.Execution) (string, *iotextypes.Receipt, error) {
+				sctx, ok := evm.GetSimulationCtx(ctx)
+				require.True(ok)
+				require.Equal(evm.StateOverride{
+					common.HexToAddress("0x7c13866F9253DEf79e20034eDD011e1d69E67fe5"): {Code: []byte{0x60, 0x00}},
+				}, sctx.StateOverride)
+				return "222222", nil, nil
+			})
+		in := gjson.Parse(`{"params":[{
+			"from":     "",
+			"to":       "0x7c13866F9253DEf79e20034eDD011e1d69E67fe5",
+			"data":     "0x1"
+		   },
+		   "latest",
+		   {"0x7c13866F9253DEf79e20034eDD011e1d69E67fe5": {"code": "0x6000"}}]}`)
+		ret, err := web3svr.call(context.Background(), &in)
+		require.NoError(err)
+		require.Equal("0x222222", ret.(string))
+
+		in = gjson.Parse(`{"params":[{"to": "0x7c13866F9253DEf79e20034eDD011e1d69E67fe5"}, "latest", {"0x7c13866F9253DEf79e20034eDD011e1d69E67fe5": {"state": {}}}]}`)
+		_, err = web3svr.call(context.Background(), &in)
+		require.Error(err)
+	})
+
 	t.Run("revert call", func(t *testing.T) {
 		receipt := &iotextypes.Receipt{
 			Status:             0,
@@ -378,7 +410,7 @@ func TestCall(t *testing.T) {
 			"data":     "0x1"
 		   },
 		   1]}`)
-		_, err := web3svr.call(&in)
+		_, err := web3svr.call(context.Background(), &in)
 		require.EqualError(err, "rpc error: code = InvalidArgument desc = execution reverted: "+receipt.GetExecutionRevertMsg())
 	})
 }
@@ -388,7 +420,7 @@ func TestEstimateGas(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 	core.EXPECT().ChainID().Return(uint32(1)).Times(2)
 
 	t.Run("estimate execution", func(t *testing.T) {
@@ -404,7 +436,7 @@ func TestEstimateGas(t *testing.T) {
 			"data":     "0x6d4ce63c"
 		   },
 		   1]}`)
-		ret, err := web3svr.estimateGas(&in)
+		ret, err := web3svr.estimateGas(context.Background(), &in)
 		require.NoError(err)
 		require.Equal(uint64ToHex(uint64(21000)), ret.(string))
 	})
@@ -422,7 +454,7 @@ func TestEstimateGas(t *testing.T) {
 			"data":     "0x1123123c"
 		   },
 		   1]}`)
-		ret, err := web3svr.estimateGas(&in)
+		ret, err := web3svr.estimateGas(context.Background(), &in)
 		require.NoError(err)
 		require.Equal(uint64ToHex(uint64(36000)), ret.(string))
 	})
@@ -433,7 +465,7 @@ func TestSendRawTransaction(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 	core.EXPECT().Genesis().Return(genesis.Default)
 	core.EXPECT().TipHeight().Return(uint64(0))
 	core.EXPECT().EVMNetworkID().Return(uint32(1))
@@ -460,7 +492,7 @@ func TestGetCode(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 	code := "608060405234801561001057600080fd5b50610150806100206contractbytecode"
 	data, _ := hex.DecodeString(code)
 	core.EXPECT().Account(gomock.Any()).Return(&iotextypes.AccountMeta{ContractByteCode: data}, nil, nil)
@@ -484,7 +516,7 @@ func TestGetNodeInfo(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 	core.EXPECT().ServerMeta().Return("111", "", "", "222", "")
 	ret, err := web3svr.getNodeInfo()
 	require.NoError(err)
@@ -496,7 +528,7 @@ func TestGetNetworkID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 	core.EXPECT().EVMNetworkID().Return(uint32(123))
 	ret, err := web3svr.getNetworkID()
 	require.NoError(err)
@@ -508,7 +540,7 @@ func TestIsSyncing(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 	core.EXPECT().SyncingProgress().Return(uint64(1), uint64(2), uint64(3))
 	ret, err := web3svr.isSyncing()
 	require.NoError(err)
@@ -524,7 +556,7 @@ func TestGetBlockTransactionCountByHash(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 
 	tsf, err := action.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
@@ -560,7 +592,7 @@ func TestGetBlockByHash(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 
 	tsf, err := action.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
@@ -612,7 +644,7 @@ func TestGetTransactionByHash(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 
 	selp, err := action.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
@@ -674,7 +706,7 @@ func TestGetLogs(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 
 	logs := []*action.Log{
 		{
@@ -723,7 +755,7 @@ func TestGetTransactionReceipt(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 
 	selp, err := action.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
@@ -774,7 +806,7 @@ func TestGetBlockTransactionCountByNumber(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 
 	tsf, err := action.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
@@ -809,7 +841,7 @@ func TestGetTransactionByBlockHashAndIndex(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 
 	tsf, err := action.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
@@ -856,7 +888,7 @@ func TestGetTransactionByBlockNumberAndIndex(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 
 	tsf, err := action.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
@@ -902,12 +934,12 @@ func TestGetStorageAt(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 	val := []byte("test")
 	core.EXPECT().ReadContractStorage(gomock.Any(), gomock.Any(), gomock.Any()).Return(val, nil)
 
 	in := gjson.Parse(`{"params":["0x123456789abc", "0"]}`)
-	ret, err := web3svr.getStorageAt(&in)
+	ret, err := web3svr.getStorageAt(context.Background(), &in)
 	require.NoError(err)
 	require.Equal("0x"+hex.EncodeToString(val), ret.(string))
 }
@@ -917,9 +949,9 @@ func TestNewfilter(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, newAPICache(1*time.Second, ""), _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, newFilterStore(newAPICache(1*time.Second, ""), 0), _defaultBatchRequestLimit, nil}
 
-	ret, err := web3svr.newFilter(&filterObject{
+	ret, err := web3svr.newFilter(context.Background(), &filterObject{
 		FromBlock: "1",
 		ToBlock:   "2",
 		Address:   []string{"0x0000000000000000000000000000000000000001", "0x0000000000000000000000000000000000000002"},
@@ -934,10 +966,10 @@ func TestNewBlockFilter(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, newAPICache(1*time.Second, ""), _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, newFilterStore(newAPICache(1*time.Second, ""), 0), _defaultBatchRequestLimit, nil}
 	core.EXPECT().TipHeight().Return(uint64(123))
 
-	ret, err := web3svr.newBlockFilter()
+	ret, err := web3svr.newBlockFilter(context.Background())
 	require.NoError(err)
 	require.Equal("0x4c6ace15a9c5b9d3c89e786b7b6dfaf1bdc5807b8d7da0292db94d473f349101", ret.(string))
 }
@@ -947,9 +979,9 @@ func TestUninstallFilter(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, newAPICache(1*time.Second, ""), _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, newFilterStore(newAPICache(1*time.Second, ""), 0), _defaultBatchRequestLimit, nil}
 
-	require.NoError(web3svr.cache.Set("123456789abc", []byte("test")))
+	require.NoError(web3svr.filters.cache.Set("123456789abc", []byte("test")))
 
 	t.Run("nil params", func(t *testing.T) {
 		inNil := gjson.Parse(`{"params":[]}`)
@@ -970,7 +1002,7 @@ func TestGetFilterChanges(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, newAPICache(1*time.Second, ""), _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, newFilterStore(newAPICache(1*time.Second, ""), 0), _defaultBatchRequestLimit, nil}
 	core.EXPECT().TipHeight().Return(uint64(0)).Times(3)
 
 	t.Run("log filterType", func(t *testing.T) {
@@ -1000,7 +1032,7 @@ func TestGetFilterChanges(t *testing.T) {
 		}
 		core.EXPECT().LogsInRange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(logs, hashes, nil)
 
-		require.NoError(web3svr.cache.Set("123456789abc", []byte(`{"logHeight":0,"filterType":"log","fromBlock":"0x1"}`)))
+		require.NoError(web3svr.filters.cache.Set("123456789abc", []byte(`{"logHeight":0,"filterType":"log","fromBlock":"0x1"}`)))
 		in := gjson.Parse(`{"params":["0x123456789abc"]}`)
 		ret, err := web3svr.getFilterChanges(&in)
 		require.NoError(err)
@@ -1038,7 +1070,7 @@ func TestGetFilterChanges(t *testing.T) {
 				},
 			}, nil)
 
-		require.NoError(web3svr.cache.Set("123456789abc", []byte(`{"logHeight":0,"filterType":"block","fromBlock":"0x1"}`)))
+		require.NoError(web3svr.filters.cache.Set("123456789abc", []byte(`{"logHeight":0,"filterType":"block","fromBlock":"0x1"}`)))
 		in := gjson.Parse(`{"params":["0x123456789abc"]}`)
 		ret, err := web3svr.getFilterChanges(&in)
 		require.NoError(err)
@@ -1055,7 +1087,7 @@ func TestGetFilterLogs(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, newAPICache(1*time.Second, ""), _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, newFilterStore(newAPICache(1*time.Second, ""), 0), _defaultBatchRequestLimit, nil}
 
 	logs := []*action.Log{
 		{
@@ -1084,7 +1116,7 @@ func TestGetFilterLogs(t *testing.T) {
 	core.EXPECT().TipHeight().Return(uint64(0))
 	core.EXPECT().LogsInRange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(logs, hashes, nil)
 
-	require.NoError(web3svr.cache.Set("123456789abc", []byte(`{"logHeight":0,"filterType":"log","fromBlock":"0x1"}`)))
+	require.NoError(web3svr.filters.cache.Set("123456789abc", []byte(`{"logHeight":0,"filterType":"log","fromBlock":"0x1"}`)))
 
 	t.Run("nil params", func(t *testing.T) {
 		inNil := gjson.Parse(`{"params":[]}`)
@@ -1111,7 +1143,7 @@ func TestSubscribe(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 
 	listener := mock_apitypes.NewMockListener(ctrl)
 	listener.EXPECT().AddResponder(gomock.Any()).Return("streamid_1", nil).Times(3)
@@ -1157,7 +1189,7 @@ func TestUnsubscribe(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 
 	listener := mock_apitypes.NewMockListener(ctrl)
 	listener.EXPECT().RemoveResponder(gomock.Any()).Return(true, nil)
@@ -1197,7 +1229,7 @@ func TestDebugTraceTransaction(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 
 	ctx := context.Background()
 	tsf, err := action.SignedExecution(identityset.Address(29).String(),
@@ -1236,7 +1268,7 @@ func TestDebugTraceCall(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 
 	ctx := context.Background()
 	tsf, err := action.SignedExecution(identityset.Address(29).String(),
@@ -1261,3 +1293,333 @@ func TestDebugTraceCall(t *testing.T) {
 	require.Empty(rlt.Revert)
 	require.Equal(0, len(rlt.StructLogs))
 }
+
+func TestDebugConsensusTimeline(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
+
+	timeline := &scheme.ConsensusTimeline{Height: 11, FinalState: "S_PREPARE"}
+	core.EXPECT().TipHeight().Return(uint64(10)).Times(2)
+	core.EXPECT().ConsensusTimeline(uint64(11)).Return(timeline, nil).Times(2)
+	core.EXPECT().ConsensusTimeline(uint64(10)).Return(nil, scheme.ErrTimelineNotFound)
+
+	in := gjson.Parse(`{"params":[]}`)
+	_, err := web3svr.consensusTimeline(&in)
+	require.ErrorIs(err, errInvalidFormat)
+	for _, height := range []string{"0xb", "pending"} {
+		in = gjson.Parse(fmt.Sprintf(`{"params":["%s"]}`, height))
+		ret, err := web3svr.consensusTimeline(&in)
+		require.NoError(err)
+		require.Equal(timeline, ret)
+	}
+	in = gjson.Parse(`{"params":["latest"]}`)
+	_, err = web3svr.consensusTimeline(&in)
+	require.ErrorIs(err, scheme.ErrTimelineNotFound)
+}
+
+func TestEvmDevChain(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
+
+	t.Run("evm_mine", func(t *testing.T) {
+		core.EXPECT().DevMine(time.Time{}).Return(nil)
+		core.EXPECT().DevMine(time.Unix(1700000000, 0)).Return(nil).Times(2)
+		for _, params := range []string{`[]`, `[1700000000]`, `["0x6553f100"]`} {
+			in := gjson.Parse(fmt.Sprintf(`{"params":%s}`, params))
+			ret, err := web3svr.evmMine(&in)
+			require.NoError(err)
+			require.Equal("0x0", ret)
+		}
+	})
+	t.Run("evm_increaseTime", func(t *testing.T) {
+		in := gjson.Parse(`{"params":[]}`)
+		_, err := web3svr.evmIncreaseTime(&in)
+		require.ErrorIs(err, errInvalidFormat)
+		core.EXPECT().DevIncreaseTime(time.Minute).Return(2*time.Minute, nil)
+		in = gjson.Parse(`{"params":[60]}`)
+		ret, err := web3svr.evmIncreaseTime(&in)
+		require.NoError(err)
+		require.Equal(int64(120), ret)
+	})
+	t.Run("evm_snapshot_revert", func(t *testing.T) {
+		core.EXPECT().DevSnapshot().Return(uint64(2), nil)
+		ret, err := web3svr.evmSnapshot()
+		require.NoError(err)
+		require.Equal("0x2", ret)
+		core.EXPECT().DevRevert(uint64(2)).Return(true, nil)
+		core.EXPECT().DevRevert(uint64(3)).Return(false, nil)
+		in := gjson.Parse(`{"params":["0x2"]}`)
+		ret, err = web3svr.evmRevert(&in)
+		require.NoError(err)
+		require.Equal(true, ret)
+		in = gjson.Parse(`{"params":["0x3"]}`)
+		ret, err = web3svr.evmRevert(&in)
+		require.NoError(err)
+		require.Equal(false, ret)
+	})
+}
+
+func TestGetActionsByAddress(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
+	core.EXPECT().EVMNetworkID().Return(uint32(4689)).AnyTimes()
+
+	tsf, err := action.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), 1, big.NewInt(10), []byte{}, 100000, big.NewInt(0))
+	require.NoError(err)
+	actInfo := &iotexapi.ActionInfo{
+		Action:    tsf.Proto(),
+		ActHash:   "01",
+		BlkHash:   "02",
+		BlkHeight: 3,
+		Sender:    identityset.Address(27).String(),
+		Index:     4,
+		Timestamp: timestamppb.New(time.Unix(5, 0)),
+	}
+
+	t.Run("nil params", func(t *testing.T) {
+		in := gjson.Parse(`{"params":[]}`)
+		_, err := web3svr.getActionsByAddress(&in)
+		require.ErrorIs(err, errInvalidFormat)
+	})
+
+	t.Run("invalid order", func(t *testing.T) {
+		in := gjson.Parse(fmt.Sprintf(`{"params":["%s", {"order":"random"}]}`, identityset.Address(27).Hex()))
+		_, err := web3svr.getActionsByAddress(&in)
+		require.ErrorIs(err, errUnkownType)
+	})
+
+	t.Run("get actions", func(t *testing.T) {
+		core.EXPECT().ActionsByAddressWithCursor(identityset.Address(27), &apitypes.ActionHistoryQuery{
+			Cursor:      "cursor",
+			Count:       2,
+			Descending:  true,
+			ActionTypes: []string{"transfer"},
+			Counterpart: identityset.Address(28),
+		}).Return([]*iotexapi.ActionInfo{actInfo}, "next", nil)
+		in := gjson.Parse(fmt.Sprintf(`{"params":["%s", {"cursor":"cursor","count":"0x2","order":"desc","actionTypes":["transfer"],"counterpart":"%s"}]}`,
+			identityset.Address(27).Hex(), identityset.Address(28).Hex()))
+		ret, err := web3svr.getActionsByAddress(&in)
+		require.NoError(err)
+		from, err := ioAddrToEthAddr(identityset.Address(27).String())
+		require.NoError(err)
+		to, err := ioAddrToEthAddr(identityset.Address(28).String())
+		require.NoError(err)
+		require.Equal(&getActionsByAddressResult{
+			Actions: []*actionHistoryResult{{
+				Hash:             "0x01",
+				BlockHash:        "0x02",
+				BlockNumber:      "0x3",
+				TransactionIndex: "0x4",
+				From:             from,
+				To:               &to,
+				Type:             "transfer",
+				Timestamp:        "0x5",
+			}},
+			NextCursor: "next",
+		}, ret)
+	})
+
+	t.Run("default query", func(t *testing.T) {
+		core.EXPECT().ActionsByAddressWithCursor(identityset.Address(27), &apitypes.ActionHistoryQuery{
+			Count: _defaultActionHistoryCount,
+		}).Return(nil, "", nil)
+		in := gjson.Parse(fmt.Sprintf(`{"params":["%s"]}`, identityset.Address(27).Hex()))
+		ret, err := web3svr.getActionsByAddress(&in)
+		require.NoError(err)
+		require.Equal(&getActionsByAddressResult{Actions: []*actionHistoryResult{}}, ret)
+	})
+}
+
+func TestSimulateBundle(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
+
+	t.Run("no calls", func(t *testing.T) {
+		in := gjson.Parse(`{"params":[[]]}`)
+		_, err := web3svr.simulateBundle(context.Background(), &in)
+		require.ErrorIs(err, errInvalidFormat)
+	})
+
+	t.Run("past block", func(t *testing.T) {
+		in := gjson.Parse(`{"params":[[{"to":"0x7c13866F9253DEf79e20034eDD011e1d69E67fe5"}],"0xa"]}`)
+		_, err := web3svr.simulateBundle(context.Background(), &in)
+		require.ErrorIs(err, errInvalidBlock)
+	})
+
+	t.Run("simulate bundle", func(t *testing.T) {
+		var (
+			from     = identityset.Address(27)
+			contract = identityset.Address(28)
+			height   = uint64(10)
+		)
+		fromEth, err := ioAddrToEthAddr(from.String())
+		require.NoError(err)
+		contractEth, err := ioAddrToEthAddr(contract.String())
+		require.NoError(err)
+		receipts := []*action.Receipt{
+			(&action.Receipt{Status: uint64(iotextypes.ReceiptStatus_Success), GasConsumed: 21000}).AddTransactionLogs(&action.TransactionLog{
+				Type:      iotextypes.TransactionLogType_NATIVE_TRANSFER,
+				Sender:    from.String(),
+				Recipient: contract.String(),
+				Amount:    big.NewInt(3),
+			}, &action.TransactionLog{
+				Type:   iotextypes.TransactionLogType_GAS_FEE,
+				Sender: from.String(),
+				Amount: big.NewInt(2),
+			}),
+			(&action.Receipt{Status: uint64(iotextypes.ReceiptStatus_ErrExecutionReverted), GasConsumed: 30000}).SetExecutionRevertMsg("reverted"),
+		}
+		receipts[0].AddLogs(&action.Log{Address: contract.String(), Data: []byte{1}})
+		core.EXPECT().SimulateExecutions(gomock.Any(), []address.Address{from, from}, gomock.Any(), evm.StateOverride{
+			common.HexToAddress(fromEth): {Balance: big.NewInt(100)},
+		}, []evm.SimulationBlock{{Size: 2, Override: &evm.BlockOverride{Number: &height}}}).DoAndReturn(
+			func(_ context.Context, _ []address.Address, execs []*action.Execution, _ evm.StateOverride, _ []evm.SimulationBlock) ([][]byte, []*action.Receipt, error) {
+				require.Len(execs, 2)
+				require.Equal(contract.String(), execs[0].Contract())
+				require.Equal(big.NewInt(3), execs[0].Amount())
+				require.Equal([]byte{0x6d, 0x4c, 0xe6, 0x3c}, execs[1].Data())
+				return [][]byte{{}, {0x01}}, receipts, nil
+			})
+		in := gjson.Parse(fmt.Sprintf(`{"params":[[{"from":"%s","to":"%s","value":"0x3"},{"from":"%s","to":"%s","data":"0x6d4ce63c"}],"latest",{"%s":{"balance":"0x64"}},{"number":"0xa"}]}`,
+			fromEth, contractEth, fromEth, contractEth, fromEth))
+		ret, err := web3svr.simulateBundle(context.Background(), &in)
+		require.NoError(err)
+		results := ret.([]*simulateCallResult)
+		require.Len(results, 2)
+		require.Equal("0x", results[0].ReturnData)
+		require.Equal("0x5208", results[0].GasUsed)
+		require.Equal("0x1", results[0].Status)
+		require.Len(results[0].Logs, 1)
+		require.Equal(map[string]string{fromEth: "-0x5", contractEth: "0x3"}, results[0].BalanceDiffs)
+		require.Equal("0x01", results[1].ReturnData)
+		require.Equal("reverted", results[1].Revert)
+		require.Empty(results[1].BalanceDiffs)
+	})
+}
+
+func TestCallMany(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
+
+	t.Run("invalid bundles", func(t *testing.T) {
+		for _, params := range []string{
+			`[]`,
+			`[[]]`,
+			`[[{"transactions":[]}]]`,
+		} {
+			in := gjson.Parse(`{"params":` + params + `}`)
+			_, err := web3svr.callMany(context.Background(), &in)
+			require.ErrorIs(err, errInvalidFormat)
+		}
+	})
+
+	t.Run("past block", func(t *testing.T) {
+		for _, simCtx := range []string{
+			`{"blockNumber":"0xa"}`,
+			`{"blockNumber":"latest","transactionIndex":1}`,
+		} {
+			in := gjson.Parse(`{"params":[[{"transactions":[{"to":"0x7c13866F9253DEf79e20034eDD011e1d69E67fe5"}]}],` + simCtx + `]}`)
+			_, err := web3svr.callMany(context.Background(), &in)
+			require.ErrorIs(err, errInvalidBlock)
+		}
+	})
+
+	t.Run("call many", func(t *testing.T) {
+		var (
+			from     = identityset.Address(27)
+			contract = identityset.Address(28)
+			height   = uint64(10)
+		)
+		fromEth, err := ioAddrToEthAddr(from.String())
+		require.NoError(err)
+		contractEth, err := ioAddrToEthAddr(contract.String())
+		require.NoError(err)
+		core.EXPECT().SimulateExecutions(gomock.Any(), []address.Address{from, from, from}, gomock.Any(), evm.StateOverride{
+			common.HexToAddress(fromEth): {Balance: big.NewInt(100)},
+		}, []evm.SimulationBlock{
+			{Size: 2, Override: &evm.BlockOverride{Number: &height}},
+			{Size: 1},
+		}).DoAndReturn(
+			func(_ context.Context, _ []address.Address, execs []*action.Execution, _ evm.StateOverride, _ []evm.SimulationBlock) ([][]byte, []*action.Receipt, error) {
+				require.Len(execs, 3)
+				require.Equal(big.NewInt(3), execs[0].Amount())
+				require.Equal([]byte{0x6d, 0x4c, 0xe6, 0x3c}, execs[2].Data())
+				return [][]byte{{}, {0x01}, {0x02}}, []*action.Receipt{
+					{Status: uint64(iotextypes.ReceiptStatus_Success)},
+					(&action.Receipt{Status: uint64(iotextypes.ReceiptStatus_ErrExecutionReverted)}).SetExecutionRevertMsg("reverted"),
+					{Status: uint64(iotextypes.ReceiptStatus_Success)},
+				}, nil
+			})
+		in := gjson.Parse(fmt.Sprintf(`{"params":[[
+			{"transactions":[{"from":"%s","to":"%s","value":"0x3"},{"from":"%s","to":"%s"}],"blockOverride":{"blockNumber":"0xa"}},
+			{"transactions":[{"from":"%s","to":"%s","data":"0x6d4ce63c"}]}
+		],{"blockNumber":"latest","transactionIndex":-1},{"%s":{"balance":"0x64"}}]}`,
+			fromEth, contractEth, fromEth, contractEth, fromEth, contractEth, fromEth))
+		ret, err := web3svr.callMany(context.Background(), &in)
+		require.NoError(err)
+		require.Equal([][]*callManyResult{
+			{{Value: "0x"}, {Error: "execution reverted: reverted"}},
+			{{Value: "0x02"}},
+		}, ret)
+	})
+}
+
+func TestEthCreateAccessList(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
+
+	var (
+		contract = common.HexToAddress("0x7c13866F9253DEf79e20034eDD011e1d69E67fe5")
+		seed     = types.AccessList{{Address: common.HexToAddress("0x01"), StorageKeys: []common.Hash{}}}
+		list     = types.AccessList{{Address: contract, StorageKeys: []common.Hash{{}}}}
+	)
+	t.Run("access list", func(t *testing.T) {
+		core.EXPECT().CreateAccessList(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ address.Address, exec *action.Execution) (types.AccessList, *action.Receipt, uint64, error) {
+				require.Equal(seed, exec.AccessList())
+				return list, &action.Receipt{Status: uint64(iotextypes.ReceiptStatus_Success), GasConsumed: 30000}, 26000, nil
+			})
+		in := gjson.Parse(`{"params":[{"to":"0x7c13866F9253DEf79e20034eDD011e1d69E67fe5","data":"0x6d4ce63c","accessList":[{"address":"0x0000000000000000000000000000000000000001","storageKeys":[]}]},"latest"]}`)
+		ret, err := web3svr.createAccessList(context.Background(), &in)
+		require.NoError(err)
+		require.Equal(&createAccessListResult{
+			AccessList:               list,
+			GasUsed:                  "0x7530",
+			GasUsedWithoutAccessList: "0x6590",
+		}, ret)
+	})
+
+	t.Run("reverted", func(t *testing.T) {
+		core.EXPECT().CreateAccessList(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil,
+			(&action.Receipt{Status: uint64(iotextypes.ReceiptStatus_ErrExecutionReverted), GasConsumed: 30000}).SetExecutionRevertMsg("reverted"), uint64(30000), nil)
+		in := gjson.Parse(`{"params":[{"to":"0x7c13866F9253DEf79e20034eDD011e1d69E67fe5","data":"0x6d4ce63c"}]}`)
+		ret, err := web3svr.createAccessList(context.Background(), &in)
+		require.NoError(err)
+		require.Equal(types.AccessList{}, ret.(*createAccessListResult).AccessList)
+		require.Equal("execution reverted: reverted", ret.(*createAccessListResult).Error)
+	})
+
+	t.Run("invalid access list", func(t *testing.T) {
+		in := gjson.Parse(`{"params":[{"to":"0x7c13866F9253DEf79e20034eDD011e1d69E67fe5","accessList":"invalid"}]}`)
+		_, err := web3svr.createAccessList(context.Background(), &in)
+		require.ErrorIs(err, errUnkownType)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
@@ -25,9 +26,11 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	logfilter "github.com/iotexproject/iotex-core/api/logfilter"
 	apitypes "github.com/iotexproject/iotex-core/api/types"
 	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/util/addrutil"
 )
@@ -36,6 +39,14 @@ func hexStringToNumber(hexStr string) (uint64, error) {
 	return strconv.ParseUint(util.Remove0xPrefix(hexStr), 16, 64)
 }
 
+// parseQuantity parses a quantity given either as a hex string or as a number
+func parseQuantity(in gjson.Result) (uint64, error) {
+	if in.Type == gjson.Number {
+		return in.Uint(), nil
+	}
+	return hexStringToNumber(in.String())
+}
+
 func ethAddrToIoAddr(ethAddr string) (address.Address, error) {
 	if ok := common.IsHexAddress(ethAddr); !ok {
 		return nil, errors.Wrapf(errUnkownType, "ethAddr: %s", ethAddr)
@@ -269,6 +280,11 @@ func parseLogRequest(in gjson.Result) (*filterObject, error) {
 }
 
 func parseCallObject(in *gjson.Result) (address.Address, string, uint64, *big.Int, *big.Int, []byte, error) {
+	return parseCall(in.Get("params.0"))
+}
+
+// parseCall parses the fields of a call object
+func parseCall(call gjson.Result) (address.Address, string, uint64, *big.Int, *big.Int, []byte, error) {
 	var (
 		from     address.Address
 		to       string
@@ -278,7 +294,7 @@ func parseCallObject(in *gjson.Result) (address.Address, string, uint64, *big.In
 		data     []byte
 		err      error
 	)
-	fromStr := in.Get("params.0.from").String()
+	fromStr := call.Get("from").String()
 	if fromStr == "" {
 		fromStr = "0x0000000000000000000000000000000000000000"
 	}
@@ -286,7 +302,7 @@ func parseCallObject(in *gjson.Result) (address.Address, string, uint64, *big.In
 		return nil, "", 0, nil, nil, nil, err
 	}
 
-	toStr := in.Get("params.0.to").String()
+	toStr := call.Get("to").String()
 	if toStr != "" {
 		ioAddr, err := ethAddrToIoAddr(toStr)
 		if err != nil {
@@ -295,14 +311,14 @@ func parseCallObject(in *gjson.Result) (address.Address, string, uint64, *big.In
 		to = ioAddr.String()
 	}
 
-	gasStr := in.Get("params.0.gas").String()
+	gasStr := call.Get("gas").String()
 	if gasStr != "" {
 		if gasLimit, err = hexStringToNumber(gasStr); err != nil {
 			return nil, "", 0, nil, nil, nil, err
 		}
 	}
 
-	gasPriceStr := in.Get("params.0.gasPrice").String()
+	gasPriceStr := call.Get("gasPrice").String()
 	if gasPriceStr != "" {
 		var ok bool
 		if gasPrice, ok = new(big.Int).SetString(util.Remove0xPrefix(gasPriceStr), 16); !ok {
@@ -310,7 +326,7 @@ func parseCallObject(in *gjson.Result) (address.Address, string, uint64, *big.In
 		}
 	}
 
-	valStr := in.Get("params.0.value").String()
+	valStr := call.Get("value").String()
 	if valStr != "" {
 		var ok bool
 		if value, ok = new(big.Int).SetString(util.Remove0xPrefix(valStr), 16); !ok {
@@ -318,15 +334,174 @@ func parseCallObject(in *gjson.Result) (address.Address, string, uint64, *big.In
 		}
 	}
 
-	input := in.Get("params.0.input")
+	input := call.Get("input")
 	if input.Exists() {
 		data = common.FromHex(input.String())
 	} else {
-		data = common.FromHex(in.Get("params.0.data").String())
+		data = common.FromHex(call.Get("data").String())
 	}
 	return from, to, gasLimit, gasPrice, value, data, nil
 }
 
+// balanceDiffs sums up the changes of the balances in the transaction logs, the burnt amount having no recipient
+func balanceDiffs(txLogs []*action.TransactionLog) (map[string]string, error) {
+	var (
+		diffs = make(map[string]*big.Int)
+		addrs []string
+	)
+	change := func(ioAddr string, amount *big.Int) error {
+		if ioAddr == "" {
+			return nil
+		}
+		ethAddr, err := ioAddrToEthAddr(ioAddr)
+		if err != nil {
+			return err
+		}
+		if _, ok := diffs[ethAddr]; !ok {
+			diffs[ethAddr] = new(big.Int)
+			addrs = append(addrs, ethAddr)
+		}
+		diffs[ethAddr].Add(diffs[ethAddr], amount)
+		return nil
+	}
+	for _, l := range txLogs {
+		if l == nil || l.Amount == nil {
+			continue
+		}
+		if err := change(l.Sender, new(big.Int).Neg(l.Amount)); err != nil {
+			return nil, err
+		}
+		if err := change(l.Recipient, l.Amount); err != nil {
+			return nil, err
+		}
+	}
+	ret := make(map[string]string, len(addrs))
+	for _, addr := range addrs {
+		if diffs[addr].Sign() != 0 {
+			ret[addr] = hexutil.EncodeBig(diffs[addr])
+		}
+	}
+	return ret, nil
+}
+
+// parseStateOverride parses the state override of a call, which maps the addresses to the overrides of their
+// balance, nonce, code and storage slots
+func parseStateOverride(in gjson.Result) (evm.StateOverride, error) {
+	if !in.Exists() || in.Type == gjson.Null {
+		return nil, nil
+	}
+	if !in.IsObject() {
+		return nil, errors.Wrap(errUnkownType, "state override")
+	}
+	var (
+		so  = make(evm.StateOverride)
+		err error
+	)
+	in.ForEach(func(k, v gjson.Result) bool {
+		if !common.IsHexAddress(k.String()) {
+			err = errors.Wrapf(errUnkownType, "address: %s", k.String())
+			return false
+		}
+		if v.Get("state").Exists() {
+			err = errors.New("overriding the whole storage is not supported, use stateDiff instead")
+			return false
+		}
+		override := &evm.AccountOverride{}
+		if balance := v.Get("balance"); balance.Exists() {
+			var ok bool
+			if override.Balance, ok = new(big.Int).SetString(util.Remove0xPrefix(balance.String()), 16); !ok {
+				err = errors.Wrapf(errUnkownType, "balance: %s", balance.String())
+				return false
+			}
+		}
+		if nonce := v.Get("nonce"); nonce.Exists() {
+			n, e := parseQuantity(nonce)
+			if e != nil {
+				err = errors.Wrapf(errUnkownType, "nonce: %s", nonce.String())
+				return false
+			}
+			override.Nonce = &n
+		}
+		if code := v.Get("code"); code.Exists() {
+			override.Code = common.FromHex(code.String())
+			if override.Code == nil {
+				override.Code = []byte{}
+			}
+		}
+		if diff := v.Get("stateDiff"); diff.Exists() {
+			override.StateDiff = make(map[common.Hash]common.Hash)
+			diff.ForEach(func(slot, value gjson.Result) bool {
+				override.StateDiff[common.HexToHash(slot.String())] = common.HexToHash(value.String())
+				return true
+			})
+		}
+		so[common.HexToAddress(k.String())] = override
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return so, nil
+}
+
+// withStateOverride returns the context carrying the state override of a call, if there is one
+func withStateOverride(ctx context.Context, in gjson.Result) (context.Context, error) {
+	so, err := parseStateOverride(in)
+	if err != nil || so == nil {
+		return ctx, err
+	}
+	return evm.WithSimulationCtx(ctx, evm.SimulationContext{StateOverride: so}), nil
+}
+
+// parseBlockOverride parses the override of the number, time, gas limit and coinbase of the block a call runs in,
+// of which the number and the time are named blockNumber and timestamp in eth_callMany
+func parseBlockOverride(in gjson.Result) (*evm.BlockOverride, error) {
+	if !in.Exists() || in.Type == gjson.Null {
+		return nil, nil
+	}
+	if !in.IsObject() {
+		return nil, errors.Wrap(errUnkownType, "block override")
+	}
+	bo := &evm.BlockOverride{}
+	number, ts := in.Get("number"), in.Get("time")
+	if !number.Exists() {
+		number = in.Get("blockNumber")
+	}
+	if !ts.Exists() {
+		ts = in.Get("timestamp")
+	}
+	if number.Exists() {
+		n, err := parseQuantity(number)
+		if err != nil {
+			return nil, errors.Wrapf(errUnkownType, "number: %s", number.String())
+		}
+		bo.Number = &n
+	}
+	if ts.Exists() {
+		t, err := parseQuantity(ts)
+		if err != nil {
+			return nil, errors.Wrapf(errUnkownType, "time: %s", ts.String())
+		}
+		blkTime := time.Unix(int64(t), 0)
+		bo.Time = &blkTime
+	}
+	if gasLimit := in.Get("gasLimit"); gasLimit.Exists() {
+		g, err := parseQuantity(gasLimit)
+		if err != nil {
+			return nil, errors.Wrapf(errUnkownType, "gasLimit: %s", gasLimit.String())
+		}
+		bo.GasLimit = &g
+	}
+	if coinbase := in.Get("coinbase"); coinbase.Exists() {
+		addr, err := ethAddrToIoAddr(coinbase.String())
+		if err != nil {
+			return nil, err
+		}
+		bo.Coinbase = addr
+	}
+	return bo, nil
+}
+
 func (svr *web3Handler) getLogQueryRange(fromStr, toStr string, logHeight uint64) (from uint64, to uint64, hasNewLogs bool, err error) {
 	if from, to, err = svr.parseBlockRange(fromStr, toStr); err != nil {
 		return
@@ -364,11 +539,7 @@ func newAPICache(expireTime time.Duration, remoteURL string) apiCache {
 		DB:       0,  // use default DB
 	})
 	if redisClient.Ping(context.Background()).Err() != nil {
-		log.L().Info("local cache is used as API cache")
-		filterCache, _ := ttl.NewCache(ttl.AutoExpireOption(expireTime))
-		return &localCache{
-			ttlCache: filterCache,
-		}
+		return newLocalCache(expireTime)
 	}
 	log.L().Info("remote cache is used as API cache")
 	return &remoteCache{
@@ -377,16 +548,31 @@ func newAPICache(expireTime time.Duration, remoteURL string) apiCache {
 	}
 }
 
+func newLocalCache(expireTime time.Duration) apiCache {
+	log.L().Info("local cache is used as API cache")
+	filterCache, _ := ttl.NewCache(ttl.AutoExpireOption(expireTime))
+	return &localCache{
+		ttlCache: filterCache,
+	}
+}
+
 type apiCache interface {
+	lifecycle.StartStopper
 	Set(key string, data []byte) error
 	Del(key string) bool
 	Get(key string) ([]byte, bool)
+	// Exists returns whether the key exists, without renewing its expiry
+	Exists(key string) bool
 }
 
 type localCache struct {
 	ttlCache *ttl.Cache
 }
 
+func (c *localCache) Start(_ context.Context) error { return nil }
+
+func (c *localCache) Stop(_ context.Context) error { return nil }
+
 func (c *localCache) Set(key string, data []byte) error {
 	if c.ttlCache == nil {
 		return errNullPointer
@@ -414,11 +600,25 @@ func (c *localCache) Get(key string) ([]byte, bool) {
 	return ret, ok
 }
 
+func (c *localCache) Exists(key string) bool {
+	_, ok := c.Get(key)
+	return ok
+}
+
 type remoteCache struct {
 	redisCache *redis.Client
 	expireTime time.Duration
 }
 
+func (c *remoteCache) Start(_ context.Context) error { return nil }
+
+func (c *remoteCache) Stop(_ context.Context) error {
+	if c.redisCache == nil {
+		return nil
+	}
+	return c.redisCache.Close()
+}
+
 func (c *remoteCache) Set(key string, data []byte) error {
 	if c.redisCache == nil {
 		return errNullPointer
@@ -448,6 +648,14 @@ func (c *remoteCache) Get(key string) ([]byte, bool) {
 	return ret, true
 }
 
+func (c *remoteCache) Exists(key string) bool {
+	if c.redisCache == nil {
+		return false
+	}
+	n, err := c.redisCache.Exists(context.Background(), key).Result()
+	return err == nil && n > 0
+}
+
 // fromLoggerStructLogs converts logger.StructLog to apitypes.StructLog
 func fromLoggerStructLogs(logs []logger.StructLog) []apitypes.StructLog {
 	ret := make([]apitypes.StructLog, len(logs))
@@ -4,8 +4,10 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
 	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	"github.com/iotexproject/iotex-core/test/mock/mock_apicoreservice"
 	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
@@ -110,12 +112,52 @@ func TestParseCallObject(t *testing.T) {
 
 }
 
+func TestParseStateOverride(t *testing.T) {
+	require := require.New(t)
+
+	so, err := parseStateOverride(gjson.Parse(`{
+		"0x7c13866F9253DEf79e20034eDD011e1d69E67fe5": {
+			"balance":   "0xde0b6b3a7640000",
+			"nonce":     "0x5",
+			"code":      "0x6d4ce63c",
+			"stateDiff": {"0x01": "0x02"}
+		}}`))
+	require.NoError(err)
+	nonce := uint64(5)
+	require.Equal(evm.StateOverride{
+		common.HexToAddress("0x7c13866F9253DEf79e20034eDD011e1d69E67fe5"): {
+			Balance:   big.NewInt(1000000000000000000),
+			Nonce:     &nonce,
+			Code:      []byte{0x6d, 0x4c, 0xe6, 0x3c},
+			StateDiff: map[common.Hash]common.Hash{common.HexToHash("0x01"): common.HexToHash("0x02")},
+		},
+	}, so)
+
+	so, err = parseStateOverride(gjson.Parse(`null`))
+	require.NoError(err)
+	require.Nil(so)
+
+	_, err = parseStateOverride(gjson.Parse(`{"io10sfcvmuj2000083qqd8d6qg7r457vll9gly090": {}}`))
+	require.ErrorIs(err, errUnkownType)
+	_, err = parseStateOverride(gjson.Parse(`{"0x7c13866F9253DEf79e20034eDD011e1d69E67fe5": {"balance": "unknown"}}`))
+	require.ErrorIs(err, errUnkownType)
+	_, err = parseStateOverride(gjson.Parse(`{"0x7c13866F9253DEf79e20034eDD011e1d69E67fe5": {"state": {}}}`))
+	require.Error(err)
+
+	bo, err := parseBlockOverride(gjson.Parse(`{"number": "0x10", "time": "0x20", "gasLimit": "0x30"}`))
+	require.NoError(err)
+	require.EqualValues(0x10, *bo.Number)
+	require.EqualValues(0x20, bo.Time.Unix())
+	require.EqualValues(0x30, *bo.GasLimit)
+	require.Nil(bo.Coinbase)
+}
+
 func TestParseBlockNumber(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, nil}
 
 	t.Run("earliest block number", func(t *testing.T) {
 		num, _ := web3svr.parseBlockNumber("earliest")
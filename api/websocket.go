@@ -30,11 +30,7 @@ const (
 // WebsocketHandler handles requests from websocket protocol
 type WebsocketHandler struct {
 	msgHandler Web3Handler
-}
-
-var upgrader = websocket.Upgrader{
-	ReadBufferSize:  1024,
-	WriteBufferSize: 1024,
+	access     *AccessControl
 }
 
 // type safeWebsocketConn wraps websocket.Conn with a mutex
@@ -72,14 +68,19 @@ func (c *safeWebsocketConn) SetWriteDeadline(t time.Time) error {
 }
 
 // NewWebsocketHandler creates a new websocket handler
-func NewWebsocketHandler(web3Handler Web3Handler) *WebsocketHandler {
+func NewWebsocketHandler(web3Handler Web3Handler, ac *AccessControl) *WebsocketHandler {
 	return &WebsocketHandler{
 		msgHandler: web3Handler,
+		access:     ac,
 	}
 }
 
 func (wsSvr *WebsocketHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
-	upgrader.CheckOrigin = func(_ *http.Request) bool { return true }
+	upgrader := websocket.Upgrader{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 1024,
+		CheckOrigin:     wsSvr.access.checkOrigin,
+	}
 
 	// upgrade this connection to a WebSocket connection
 	ws, err := upgrader.Upgrade(w, req, nil)
@@ -88,7 +89,7 @@ func (wsSvr *WebsocketHandler) ServeHTTP(w http.ResponseWriter, req *http.Reques
 		return
 	}
 
-	wsSvr.handleConnection(req.Context(), ws)
+	wsSvr.handleConnection(wsSvr.access.withClient(withProtocol(req.Context(), _protocolWebsocket), _protocolWebsocket, req), ws)
 }
 
 func (wsSvr *WebsocketHandler) handleConnection(ctx context.Context, ws *websocket.Conn) {
@@ -67,7 +67,7 @@ func (b *Block) RunnableActions() RunnableActions {
 
 // Finalize creates a footer for the block
 func (b *Block) Finalize(endorsements []*endorsement.Endorsement, ts time.Time) error {
-	if len(b.endorsements) != 0 {
+	if len(b.endorsements) != 0 || b.aggregatedEndorsement != nil {
 		return errors.New("the block has been finalized")
 	}
 	b.endorsements = endorsements
@@ -76,6 +76,20 @@ func (b *Block) Finalize(endorsements []*endorsement.Endorsement, ts time.Time)
 	return nil
 }
 
+// FinalizeWithAggregation creates a footer for the block, with the BLS aggregated endorsement
+// and the endorsements not aggregated
+func (b *Block) FinalizeWithAggregation(
+	aggregated *endorsement.AggregatedEndorsement,
+	endorsements []*endorsement.Endorsement,
+	ts time.Time,
+) error {
+	if err := b.Finalize(endorsements, ts); err != nil {
+		return err
+	}
+	b.aggregatedEndorsement = aggregated
+	return nil
+}
+
 // TransactionLog returns transaction logs in the block
 func (b *Block) TransactionLog() *BlkTransactionLog {
 	if len(b.Receipts) == 0 {
@@ -11,6 +11,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/iotexproject/iotex-core/blockchain/block/footerpb"
 	"github.com/iotexproject/iotex-core/endorsement"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 )
@@ -19,6 +20,9 @@ import (
 type Footer struct {
 	endorsements []*endorsement.Endorsement
 	commitTime   time.Time
+	// aggregatedEndorsement carries the BLS aggregated commit signatures, stored as a field of
+	// footerpb.BlockFooter in protobuf
+	aggregatedEndorsement *endorsement.AggregatedEndorsement
 }
 
 // ConvertToBlockFooterPb converts BlockFooter
@@ -34,6 +38,17 @@ func (f *Footer) ConvertToBlockFooterPb() (*iotextypes.BlockFooter, error) {
 		}
 		pb.Endorsements = append(pb.Endorsements, ePb)
 	}
+	if f.aggregatedEndorsement != nil {
+		// iotextypes.BlockFooter carries the fields of footerpb.BlockFooter it does not know as unknown fields
+		// TODO: set iotextypes.BlockFooter.AggregatedEndorsement (field 3) directly once iotex-proto defines it
+		ext, err := proto.Marshal(&footerpb.BlockFooter{
+			AggregatedEndorsement: f.aggregatedEndorsement.Proto(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		pb.ProtoReflect().SetUnknown(ext)
+	}
 	return &pb, nil
 }
 
@@ -47,6 +62,20 @@ func (f *Footer) ConvertFromBlockFooterPb(pb *iotextypes.BlockFooter) error {
 	}
 	commitTime := pb.GetTimestamp().AsTime()
 	f.commitTime = commitTime
+	f.aggregatedEndorsement = nil
+	// TODO: read iotextypes.BlockFooter.AggregatedEndorsement once iotex-proto defines it
+	if ext := pb.ProtoReflect().GetUnknown(); len(ext) != 0 {
+		extPb := &footerpb.BlockFooter{}
+		if err := proto.Unmarshal(ext, extPb); err != nil {
+			return err
+		}
+		if aggPb := extPb.GetAggregatedEndorsement(); aggPb != nil {
+			f.aggregatedEndorsement = &endorsement.AggregatedEndorsement{}
+			if err := f.aggregatedEndorsement.LoadProto(aggPb); err != nil {
+				return err
+			}
+		}
+	}
 	pbEndorsements := pb.GetEndorsements()
 	if pbEndorsements == nil {
 		return nil
@@ -73,6 +102,11 @@ func (f *Footer) Endorsements() []*endorsement.Endorsement {
 	return f.endorsements
 }
 
+// AggregatedEndorsement returns the BLS aggregated commit endorsement, nil if not aggregated
+func (f *Footer) AggregatedEndorsement() *endorsement.AggregatedEndorsement {
+	return f.aggregatedEndorsement
+}
+
 // Serialize returns the serialized byte stream of the block footer
 func (f *Footer) Serialize() ([]byte, error) {
 	pb, err := f.ConvertToBlockFooterPb()
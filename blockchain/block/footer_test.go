@@ -10,16 +10,20 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/iotexproject/iotex-core/blockchain/block/footerpb"
+	"github.com/iotexproject/iotex-core/crypto/bls"
 	"github.com/iotexproject/iotex-core/endorsement"
 	"github.com/iotexproject/iotex-core/test/identityset"
 )
 
 func TestConvertToBlockFooterPb(t *testing.T) {
 	require := require.New(t)
-	footer := &Footer{nil, time.Now()}
+	footer := &Footer{nil, time.Now(), nil}
 	blockFooter, err := footer.ConvertToBlockFooterPb()
 	require.NoError(err)
 	require.NotNil(blockFooter)
@@ -45,7 +49,7 @@ func TestConvertFromBlockFooterPb(t *testing.T) {
 
 func TestSerDesFooter(t *testing.T) {
 	require := require.New(t)
-	footer := &Footer{nil, time.Now()}
+	footer := &Footer{nil, time.Now(), nil}
 	ser, err := footer.Serialize()
 	require.NoError(err)
 	require.NoError(footer.Deserialize(ser))
@@ -63,6 +67,61 @@ func makeFooter() (f *Footer) {
 	endors := make([]*endorsement.Endorsement, 0)
 	endor := endorsement.NewEndorsement(time.Now(), identityset.PrivateKey(27).PublicKey(), nil)
 	endors = append(endors, endor)
-	f = &Footer{endors, time.Now()}
+	f = &Footer{endors, time.Now(), nil}
 	return
 }
+
+func TestSerDesFooterWithAggregation(t *testing.T) {
+	require := require.New(t)
+	doc := hash.Hash256b([]byte("block"))
+	sk, err := bls.GenerateKey()
+	require.NoError(err)
+	ts := time.Unix(1700000000, 0)
+	en, err := endorsement.EndorseWithBLS(identityset.PrivateKey(1), sk, testDocument(doc), ts)
+	require.NoError(err)
+	aggregated, rest, err := endorsement.AggregateEndorsements(
+		testDocument(doc),
+		[]*endorsement.Endorsement{en},
+		[]string{identityset.Address(1).String()},
+		[]*bls.PublicKey{sk.PublicKey()},
+		ts,
+	)
+	require.NoError(err)
+	require.Empty(rest)
+
+	footer := makeFooter()
+	footer.aggregatedEndorsement = aggregated
+	ser, err := footer.Serialize()
+	require.NoError(err)
+	footer = &Footer{}
+	require.NoError(footer.Deserialize(ser))
+	require.Equal(1, len(footer.endorsements))
+	require.NotNil(footer.AggregatedEndorsement())
+	require.Equal(aggregated.Signature(), footer.AggregatedEndorsement().Signature())
+
+	// the aggregated endorsement is a field of footerpb.BlockFooter
+	pb, err := footer.ConvertToBlockFooterPb()
+	require.NoError(err)
+	require.Len(pb.Endorsements, 1)
+	b, err := proto.Marshal(pb)
+	require.NoError(err)
+	extPb := &footerpb.BlockFooter{}
+	require.NoError(proto.Unmarshal(b, extPb))
+	require.Len(extPb.Endorsements, 1)
+	require.Equal(aggregated.Signers(), extPb.AggregatedEndorsement.Signers)
+
+	// a footer with the aggregated endorsement only
+	footer = &Footer{nil, ts, aggregated}
+	ser, err = footer.Serialize()
+	require.NoError(err)
+	footer = &Footer{}
+	require.NoError(footer.Deserialize(ser))
+	require.Empty(footer.endorsements)
+	require.Equal(aggregated.Signers(), footer.AggregatedEndorsement().Signers())
+}
+
+type testDocument hash.Hash256
+
+func (d testDocument) Hash() ([]byte, error) {
+	return d[:], nil
+}
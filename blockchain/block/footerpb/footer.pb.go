@@ -0,0 +1,197 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+// To compile the proto, run:
+//      protoc --go_out=plugins=grpc:. *.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v4.23.3
+// source: blockchain/block/footerpb/footer.proto
+
+package footerpb
+
+import (
+	endorsementpb "github.com/iotexproject/iotex-core/endorsement/endorsementpb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BlockFooter extends iotextypes.BlockFooter with the aggregated commit endorsement, and is wire compatible with it.
+// The field numbers are reserved for the same fields of iotextypes, which replace this message once defined.
+type BlockFooter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Endorsements          []*endorsementpb.Endorsement         `protobuf:"bytes,1,rep,name=endorsements,proto3" json:"endorsements,omitempty"`
+	Timestamp             *timestamppb.Timestamp               `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	AggregatedEndorsement *endorsementpb.AggregatedEndorsement `protobuf:"bytes,3,opt,name=aggregatedEndorsement,proto3" json:"aggregatedEndorsement,omitempty"`
+}
+
+func (x *BlockFooter) Reset() {
+	*x = BlockFooter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_block_footerpb_footer_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockFooter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockFooter) ProtoMessage() {}
+
+func (x *BlockFooter) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_block_footerpb_footer_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockFooter.ProtoReflect.Descriptor instead.
+func (*BlockFooter) Descriptor() ([]byte, []int) {
+	return file_blockchain_block_footerpb_footer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BlockFooter) GetEndorsements() []*endorsementpb.Endorsement {
+	if x != nil {
+		return x.Endorsements
+	}
+	return nil
+}
+
+func (x *BlockFooter) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *BlockFooter) GetAggregatedEndorsement() *endorsementpb.AggregatedEndorsement {
+	if x != nil {
+		return x.AggregatedEndorsement
+	}
+	return nil
+}
+
+var File_blockchain_block_footerpb_footer_proto protoreflect.FileDescriptor
+
+var file_blockchain_block_footerpb_footer_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2f, 0x66, 0x6f, 0x6f, 0x74, 0x65, 0x72, 0x70, 0x62, 0x2f, 0x66, 0x6f, 0x6f, 0x74,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x66, 0x6f, 0x6f, 0x74, 0x65, 0x72,
+	0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x2b, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2f, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2f, 0x65,
+	0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xe3, 0x01, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x6f, 0x6f, 0x74, 0x65, 0x72,
+	0x12, 0x3e, 0x0a, 0x0c, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x5a, 0x0a, 0x15, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x6e, 0x64, 0x6f,
+	0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x15, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x64, 0x6f, 0x72,
+	0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x2f, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x66, 0x6f,
+	0x6f, 0x74, 0x65, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_blockchain_block_footerpb_footer_proto_rawDescOnce sync.Once
+	file_blockchain_block_footerpb_footer_proto_rawDescData = file_blockchain_block_footerpb_footer_proto_rawDesc
+)
+
+func file_blockchain_block_footerpb_footer_proto_rawDescGZIP() []byte {
+	file_blockchain_block_footerpb_footer_proto_rawDescOnce.Do(func() {
+		file_blockchain_block_footerpb_footer_proto_rawDescData = protoimpl.X.CompressGZIP(file_blockchain_block_footerpb_footer_proto_rawDescData)
+	})
+	return file_blockchain_block_footerpb_footer_proto_rawDescData
+}
+
+var file_blockchain_block_footerpb_footer_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_blockchain_block_footerpb_footer_proto_goTypes = []interface{}{
+	(*BlockFooter)(nil),                         // 0: footerpb.BlockFooter
+	(*endorsementpb.Endorsement)(nil),           // 1: endorsementpb.Endorsement
+	(*timestamppb.Timestamp)(nil),               // 2: google.protobuf.Timestamp
+	(*endorsementpb.AggregatedEndorsement)(nil), // 3: endorsementpb.AggregatedEndorsement
+}
+var file_blockchain_block_footerpb_footer_proto_depIdxs = []int32{
+	1, // 0: footerpb.BlockFooter.endorsements:type_name -> endorsementpb.Endorsement
+	2, // 1: footerpb.BlockFooter.timestamp:type_name -> google.protobuf.Timestamp
+	3, // 2: footerpb.BlockFooter.aggregatedEndorsement:type_name -> endorsementpb.AggregatedEndorsement
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_blockchain_block_footerpb_footer_proto_init() }
+func file_blockchain_block_footerpb_footer_proto_init() {
+	if File_blockchain_block_footerpb_footer_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_blockchain_block_footerpb_footer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockFooter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_blockchain_block_footerpb_footer_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_blockchain_block_footerpb_footer_proto_goTypes,
+		DependencyIndexes: file_blockchain_block_footerpb_footer_proto_depIdxs,
+		MessageInfos:      file_blockchain_block_footerpb_footer_proto_msgTypes,
+	}.Build()
+	File_blockchain_block_footerpb_footer_proto = out.File
+	file_blockchain_block_footerpb_footer_proto_rawDesc = nil
+	file_blockchain_block_footerpb_footer_proto_goTypes = nil
+	file_blockchain_block_footerpb_footer_proto_depIdxs = nil
+}
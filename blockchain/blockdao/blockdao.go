@@ -53,6 +53,12 @@ type (
 		FooterByHeight(uint64) (*block.Footer, error)
 	}
 
+	// BlockRemover is a BlockDAO able to remove the blocks on top of a target height, together with the data
+	// of these blocks in the indexers
+	BlockRemover interface {
+		DeleteBlockToTarget(context.Context, uint64) error
+	}
+
 	blockDAO struct {
 		blockStore   BlockDAO
 		indexers     []BlockIndexer
@@ -255,6 +261,38 @@ func (dao *blockDAO) PutBlock(ctx context.Context, blk *block.Block) error {
 	return nil
 }
 
+// DeleteBlockToTarget deletes the blocks above the target height one by one from the tip. The indexers delete
+// a block in the reverse order of indexing it.
+func (dao *blockDAO) DeleteBlockToTarget(ctx context.Context, targetHeight uint64) error {
+	store, ok := dao.blockStore.(interface{ DeleteTipBlock() error })
+	if !ok {
+		return errors.New("block store does not support deleting blocks")
+	}
+	for tipHeight := atomic.LoadUint64(&dao.tipHeight); tipHeight > targetHeight; tipHeight-- {
+		blk, err := dao.blockStore.GetBlockByHeight(tipHeight)
+		if err != nil {
+			return err
+		}
+		for i := len(dao.indexers) - 1; i >= 0; i-- {
+			if err := dao.indexers[i].DeleteTipBlock(ctx, blk); err != nil {
+				return errors.Wrapf(err, "failed to delete block %d from indexer", tipHeight)
+			}
+		}
+		if err := store.DeleteTipBlock(); err != nil {
+			return errors.Wrapf(err, "failed to delete block %d", tipHeight)
+		}
+		atomic.StoreUint64(&dao.tipHeight, tipHeight-1)
+		h := blk.HashBlock()
+		for _, c := range []cache.LRUCache{dao.headerCache, dao.bodyCache, dao.footerCache} {
+			if c != nil {
+				c.Remove(tipHeight)
+				c.Remove(h)
+			}
+		}
+	}
+	return nil
+}
+
 func lruCacheGet(c cache.LRUCache, key interface{}) (interface{}, bool) {
 	if c != nil {
 		return c.Get(key)
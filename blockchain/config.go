@@ -17,6 +17,7 @@ import (
 	"go.uber.org/config"
 	"go.uber.org/zap"
 
+	"github.com/iotexproject/iotex-core/crypto/bls"
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/pkg/log"
 )
@@ -39,6 +40,7 @@ type (
 		Address                    string           `yaml:"address"`
 		ProducerPrivKey            string           `yaml:"producerPrivKey"`
 		ProducerPrivKeySchema      string           `yaml:"producerPrivKeySchema"`
+		ProducerBLSPrivKey         string           `yaml:"producerBLSPrivKey"`
 		SignatureScheme            []string         `yaml:"signatureScheme"`
 		EmptyGenesis               bool             `yaml:"emptyGenesis"`
 		GravityChainDB             db.Config        `yaml:"gravityChainDB"`
@@ -71,6 +73,21 @@ type (
 		StreamingBlockBufferSize uint64 `yaml:"streamingBlockBufferSize"`
 		// PersistStakingPatchBlock is the block to persist staking patch
 		PersistStakingPatchBlock uint64 `yaml:"persistStakingPatchBlock"`
+		// EnableParallelExecution enables optimistic parallel execution of the actions in a block
+		EnableParallelExecution bool `yaml:"enableParallelExecution"`
+		// ParallelExecutionWorkers is the number of workers for parallel execution, 0 means the number of CPUs
+		ParallelExecutionWorkers int `yaml:"parallelExecutionWorkers"`
+		// ForkEndpoint is the gRPC endpoint of a remote node running in archive mode, from which the accounts,
+		// contract codes, contract storage and the states of the staking, rewarding and poll protocols missing
+		// locally are read when running actions, so that the local chain runs on top of the remote states. The
+		// staking candidates and the delegates of the first epoch are forked in the genesis, while the other states
+		// queried through the API are the local ones until an action touches them
+		ForkEndpoint string `yaml:"forkEndpoint"`
+		// ForkHeight is the height of the remote node to read the states at, which must be the start height of an
+		// epoch before its tip epoch, 0 means the start height of the last epoch completed at startup
+		ForkHeight uint64 `yaml:"forkHeight"`
+		// ForkInsecure connects to the remote node without TLS
+		ForkInsecure bool `yaml:"forkInsecure"`
 	}
 )
 
@@ -111,6 +128,8 @@ var (
 		WorkingSetCacheSize:           20,
 		StreamingBlockBufferSize:      200,
 		PersistStakingPatchBlock:      19778037,
+		EnableParallelExecution:       false,
+		ParallelExecutionWorkers:      0,
 	}
 
 	// ErrConfig config error
@@ -143,6 +162,21 @@ func (cfg *Config) ProducerPrivateKey() crypto.PrivateKey {
 	return sk
 }
 
+// ProducerBLSPrivateKey returns the configured BLS private key, nil if not configured
+func (cfg *Config) ProducerBLSPrivateKey() *bls.PrivateKey {
+	if cfg.ProducerBLSPrivKey == "" {
+		return nil
+	}
+	sk, err := bls.HexStringToPrivateKey(cfg.ProducerBLSPrivKey)
+	if err != nil {
+		log.L().Panic(
+			"Error when decoding BLS private key",
+			zap.Error(err),
+		)
+	}
+	return sk
+}
+
 // SetProducerPrivKey set producer privKey by PrivKeyConfigFile info
 func (cfg *Config) SetProducerPrivKey() error {
 	switch cfg.ProducerPrivKeySchema {
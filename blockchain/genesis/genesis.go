@@ -77,6 +77,7 @@ func defaultConfig() Genesis {
 			SumatraBlockHeight:      28516681,
 			TsunamiBlockHeight:      29275561,
 			UpernavikBlockHeight:    39275561,
+			VanuatuBlockHeight:      math.MaxUint64,
 			ToBeEnabledBlockHeight:  math.MaxUint64,
 		},
 		Account: Account{
@@ -121,6 +122,8 @@ func defaultConfig() Genesis {
 			MinStakeAmount:                   unit.ConvertIotxToRau(100).String(),
 			BootstrapCandidates:              []BootstrapCandidate{},
 			EndorsementWithdrawWaitingBlocks: 24 * 60 * 60 / 5,
+			DoubleSignEvidenceMaxAge:         1,
+			DoubleSignJailEpochs:             24,
 		},
 	}
 }
@@ -259,9 +262,11 @@ type (
 		// 2. generate transaction log for SelfDestruct() call in EVM
 		// 3. raise block gas limit to 50M
 		TsunamiBlockHeight uint64 `yaml:"tsunamiHeight"`
-		// UpernavikBlockHeight is the start height to
-		// 1. enable Cancun EVM
+		// UpernavikBlockHeight is the start height of the Upernavik hard fork
 		UpernavikBlockHeight uint64 `yaml:"upernavikHeight"`
+		// VanuatuBlockHeight is the start height to
+		// 1. enable Cancun EVM
+		VanuatuBlockHeight uint64 `yaml:"vanuatuHeight"`
 		// ToBeEnabledBlockHeight is a fake height that acts as a gating factor for WIP features
 		// upon next release, change IsToBeEnabled() to IsNextHeight() for features to be released
 		ToBeEnabledBlockHeight uint64 `yaml:"toBeEnabledHeight"`
@@ -364,6 +369,12 @@ type (
 		MinStakeAmount                   string               `yaml:"minStakeAmount"`
 		BootstrapCandidates              []BootstrapCandidate `yaml:"bootstrapCandidates"`
 		EndorsementWithdrawWaitingBlocks uint64               `yaml:"endorsementWithdrawWaitingBlocks"`
+		// DoubleSignSlashRate is the percentage of the self-stake to burn when the delegate is jailed
+		DoubleSignSlashRate uint32 `yaml:"doubleSignSlashRate"`
+		// DoubleSignEvidenceMaxAge is the number of epochs after which a double-sign can no longer be reported
+		DoubleSignEvidenceMaxAge uint64 `yaml:"doubleSignEvidenceMaxAge"`
+		// DoubleSignJailEpochs is the number of epochs the delegate is jailed for double-signing
+		DoubleSignJailEpochs uint64 `yaml:"doubleSignJailEpochs"`
 	}
 
 	// VoteWeightCalConsts contains the configs for calculating vote weight
@@ -618,6 +629,11 @@ func (g *Blockchain) IsUpernavik(height uint64) bool {
 	return g.isPost(g.UpernavikBlockHeight, height)
 }
 
+// IsVanuatu checks whether height is equal to or larger than vanuatu height
+func (g *Blockchain) IsVanuatu(height uint64) bool {
+	return g.isPost(g.VanuatuBlockHeight, height)
+}
+
 // IsToBeEnabled checks whether height is equal to or larger than toBeEnabled height
 func (g *Blockchain) IsToBeEnabled(height uint64) bool {
 	return g.isPost(g.ToBeEnabledBlockHeight, height)
@@ -6,6 +6,7 @@
 package genesis
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -65,6 +66,7 @@ func TestNewHeightChange(t *testing.T) {
 	require.True(cfg.IsTsunami(uint64(29275561)))
 	require.False(cfg.IsUpernavik(uint64(39275560)))
 	require.True(cfg.IsUpernavik(uint64(39275561)))
+	require.False(cfg.IsVanuatu(uint64(39275561)))
 
 	require.Equal(cfg.PacificBlockHeight, uint64(432001))
 	require.Equal(cfg.AleutianBlockHeight, uint64(864001))
@@ -90,4 +92,5 @@ func TestNewHeightChange(t *testing.T) {
 	require.Equal(cfg.SumatraBlockHeight, uint64(28516681))
 	require.Equal(cfg.TsunamiBlockHeight, uint64(29275561))
 	require.Equal(cfg.UpernavikBlockHeight, uint64(39275561))
+	require.Equal(cfg.VanuatuBlockHeight, uint64(math.MaxUint64))
 }
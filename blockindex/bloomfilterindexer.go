@@ -183,11 +183,24 @@ func (bfx *bloomfilterIndexer) DeleteTipBlock(_ context.Context, blk *block.Bloc
 	bfx.mutex.Lock()
 	defer bfx.mutex.Unlock()
 	height := blk.Height()
-	if err := bfx.kvStore.Delete(BlockBloomFilterNamespace, byteutil.Uint64ToBytesBigEndian(height)); err != nil {
+	if height == 0 {
+		return errors.New("cannot delete genesis block")
+	}
+	b := batch.NewBatch()
+	b.Delete(BlockBloomFilterNamespace, byteutil.Uint64ToBytesBigEndian(height), "failed to delete block bloom filter")
+	b.Put(RangeBloomFilterNamespace, []byte(CurrentHeightKey), byteutil.Uint64ToBytesBigEndian(height-1), "failed to put current height")
+	if err := bfx.kvStore.WriteBatch(b); err != nil {
 		return err
 	}
-	bfx.curRangeBloomfilter = nil
-	return nil
+	// the current range is removed if it starts after the remaining blocks
+	if start := bfx.curRangeBloomfilter.Start(); start > 1 && start >= height {
+		if err := bfx.totalRange.Delete(start); err != nil {
+			return err
+		}
+	}
+	// the logs of the deleted block are left in the range bloom filter, which only lead to false positives
+	bfx.totalRange.Close()
+	return bfx.initRangeBloomFilter(height - 1)
 }
 
 // RangeBloomFilterNumElements returns the number of elements that each rangeBloomfilter indexes
@@ -258,6 +258,24 @@ func TestBloomfilterIndexer(t *testing.T) {
 			require.NoError(err)
 			require.Equal(expectedRes4[i], res)
 		}
+
+		// delete the tip blocks and index them again
+		for i := len(blks) - 1; i >= 3; i-- {
+			require.NoError(indexer.DeleteTipBlock(ctx, blks[i]))
+			height, err := indexer.Height()
+			require.NoError(err)
+			require.Equal(blks[i].Height()-1, height)
+			_, err = indexer.BlockFilterByHeight(blks[i].Height())
+			require.Error(err)
+		}
+		for i := 3; i < len(blks); i++ {
+			require.NoError(indexer.PutBlock(ctx, blks[i]))
+		}
+		for i, l := range testFilter {
+			res, err := indexer.FilterBlocksInRange(logfilter.NewLogFilter(l), 1, 5, 0)
+			require.NoError(err)
+			require.Equal(expectedRes2[i], res)
+		}
 	}
 
 	t.Run("Bolt DB indexer", func(t *testing.T) {
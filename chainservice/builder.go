@@ -47,6 +47,7 @@ import (
 	"github.com/iotexproject/iotex-core/pkg/util/blockutil"
 	"github.com/iotexproject/iotex-core/server/itx/nodestats"
 	"github.com/iotexproject/iotex-core/state/factory"
+	"github.com/iotexproject/iotex-core/state/fork"
 )
 
 // Builder is a builder to build chainservice
@@ -184,12 +185,22 @@ func (builder *Builder) createFactory(forTest bool) (factory.Factory, error) {
 	if err != nil {
 		return nil, err
 	}
-	return factory.NewFactory(
-		factoryCfg,
-		dao,
+	opts := []factory.Option{
 		factory.RegistryOption(builder.cs.registry),
 		factory.DefaultTriePatchOption(),
-	)
+	}
+	if builder.cfg.Consensus.Dev.Enabled {
+		opts = append(opts, factory.RevertibleOption())
+	}
+	if endpoint := builder.cfg.Chain.ForkEndpoint; endpoint != "" {
+		reader, err := fork.NewRemoteReader(endpoint, builder.cfg.Chain.ForkHeight, builder.cfg.Chain.ForkInsecure)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create fork reader")
+		}
+		log.L().Info("Forking from remote node.", zap.String("endpoint", endpoint), zap.Uint64("height", reader.Height()))
+		opts = append(opts, factory.ForkOption(reader))
+	}
+	return factory.NewFactory(factoryCfg, dao, opts...)
 }
 
 func (builder *Builder) buildElectionCommittee() error {
@@ -304,7 +315,8 @@ func (builder *Builder) buildSGDRegistry(forTest bool) error {
 	if builder.cs.sgdIndexer != nil {
 		return nil
 	}
-	if forTest || builder.cfg.Genesis.SystemSGDContractAddress == "" {
+	// the indexer does not support deleting blocks, which is needed by dev chain
+	if forTest || builder.cfg.Genesis.SystemSGDContractAddress == "" || builder.cfg.Consensus.Dev.Enabled {
 		builder.cs.sgdIndexer = nil
 		return nil
 	}
@@ -323,7 +335,7 @@ func (builder *Builder) buildContractStakingIndexer(forTest bool) error {
 	if builder.cs.contractStakingIndexer != nil {
 		return nil
 	}
-	if forTest || builder.cfg.Genesis.SystemStakingContractAddress == "" {
+	if forTest || builder.cfg.Genesis.SystemStakingContractAddress == "" || builder.cfg.Consensus.Dev.Enabled {
 		builder.cs.contractStakingIndexer = nil
 		return nil
 	}
@@ -662,7 +674,11 @@ func (builder *Builder) registerRollDPoSProtocol() error {
 	if err != nil {
 		return errors.Wrap(err, "failed to generate poll protocol")
 	}
-	return pollProtocol.Register(builder.cs.registry)
+	if err := pollProtocol.Register(builder.cs.registry); err != nil {
+		return err
+	}
+	// registered ahead of execution protocol, which would otherwise take the calls as transfers
+	return poll.NewDelegateConfigProtocol(rewarding.DepositGas).Register(builder.cs.registry)
 }
 
 func (builder *Builder) buildBlockTimeCalculator() (err error) {
@@ -691,6 +707,14 @@ func (builder *Builder) buildConsensusComponent() error {
 	if pollProtocol := poll.FindProtocol(builder.cs.registry); pollProtocol != nil {
 		copts = append(copts, consensus.WithPollProtocol(pollProtocol))
 	}
+	copts = append(copts, consensus.WithEvidenceHandler(builder.evidenceHandler()))
+	if builder.cfg.Consensus.Dev.Enabled {
+		rewind, err := rewindCB(builder.cs.blockdao, builder.cs.chain, builder.cs.actpool)
+		if err != nil {
+			return err
+		}
+		copts = append(copts, consensus.WithDevChain(rewind))
+	}
 
 	// TODO: explorer dependency deleted at #1085, need to revive by migrating to api
 	builderCfg := rp.BuilderConfig{
@@ -708,10 +732,63 @@ func (builder *Builder) buildConsensusComponent() error {
 	}
 	builder.cs.consensus = component
 	builder.cs.lifecycle.Add(component)
+	if dev, err := component.DevChain(); err == nil {
+		builder.cs.actpool = &devActPool{ActPool: builder.cs.actpool, trigger: dev.Trigger}
+	}
 
 	return nil
 }
 
+// evidenceHandler reports the double-sign evidence to the staking protocol with an action signed by the producer,
+// once the double-sign slashing is enabled
+func (builder *Builder) evidenceHandler() rp.EvidenceHandler {
+	var (
+		g        = builder.cfg.Genesis
+		chainCfg = builder.cfg.Chain
+		apCfg    = builder.cfg.ActPool
+		chain    = builder.cs.chain
+		ap       = builder.cs.actpool
+		registry = builder.cs.registry
+		p2pAgent = builder.cs.p2pAgent
+	)
+	return func(evidence *staking.DoubleSignEvidence) error {
+		if !g.IsToBeEnabled(chain.TipHeight() + 1) {
+			return nil
+		}
+		data, err := evidence.EncodeABIBinary()
+		if err != nil {
+			return err
+		}
+		nonce, err := ap.GetPendingNonce(chainCfg.ProducerAddress().String())
+		if err != nil {
+			return errors.Wrap(err, "failed to get pending nonce")
+		}
+		exec, err := action.NewExecution(staking.ProtocolAddr().String(), nonce, big.NewInt(0), 0, apCfg.MinGasPrice(), data)
+		if err != nil {
+			return err
+		}
+		gasLimit, err := exec.IntrinsicGas()
+		if err != nil {
+			return err
+		}
+		elp := (&action.EnvelopeBuilder{}).SetNonce(nonce).
+			SetGasLimit(gasLimit).
+			SetGasPrice(apCfg.MinGasPrice()).
+			SetAction(exec).
+			SetChainID(chain.ChainID()).Build()
+		selp, err := action.Sign(elp, chainCfg.ProducerPrivateKey())
+		if err != nil {
+			return errors.Wrap(err, "failed to sign double-sign report")
+		}
+		ctx := protocol.WithRegistry(context.Background(), registry)
+		if err := ap.Add(ctx, selp); err != nil {
+			return errors.Wrap(err, "failed to add double-sign report to actpool")
+		}
+		log.L().Info("Reported double-sign evidence.", zap.Uint64("height", evidence.Height()))
+		return p2pAgent.BroadcastOutbound(ctx, selp.Proto())
+	}
+}
+
 func (builder *Builder) build(forSubChain, forTest bool) (*ChainService, error) {
 	builder.cs.registry = protocol.NewRegistry()
 	if builder.cs.p2pAgent == nil {
@@ -194,6 +194,7 @@ func (cs *ChainService) NewAPIServer(cfg api.Config, plugins map[int]interface{}
 		api.WithNativeElection(cs.electionCommittee),
 		api.WithAPIStats(cs.apiStats),
 		api.WithSGDIndexer(cs.sgdIndexer),
+		api.WithConsensus(cs.consensus),
 	}
 
 	svr, err := api.NewServerV2(
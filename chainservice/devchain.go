@@ -0,0 +1,51 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package chainservice
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/actpool"
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/consensus/scheme"
+)
+
+// devActPool triggers minting a block on every action added
+type devActPool struct {
+	actpool.ActPool
+	trigger func()
+}
+
+func (ap *devActPool) Add(ctx context.Context, act *action.SealedEnvelope) error {
+	if err := ap.ActPool.Add(ctx, act); err != nil {
+		return err
+	}
+	ap.trigger()
+	return nil
+}
+
+// rewindCB removes the blocks above the target height, and then drops the actions no longer valid from the actpool
+func rewindCB(dao blockdao.BlockDAO, chain blockchain.Blockchain, ap actpool.ActPool) (scheme.RewindCB, error) {
+	remover, ok := dao.(blockdao.BlockRemover)
+	if !ok {
+		return nil, errors.New("block dao does not support removing blocks")
+	}
+	return func(height uint64) error {
+		ctx, err := chain.Context(context.Background())
+		if err != nil {
+			return err
+		}
+		if err := remover.DeleteBlockToTarget(ctx, height); err != nil {
+			return err
+		}
+		ap.Reset()
+		return nil
+	}, nil
+}
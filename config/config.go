@@ -91,6 +91,8 @@ var (
 	Validates = []Validate{
 		ValidateRollDPoS,
 		ValidateArchiveMode,
+		ValidateDevChain,
+		ValidateFork,
 		ValidateDispatcher,
 		ValidateAPI,
 		ValidateActPool,
@@ -258,11 +260,45 @@ func ValidateArchiveMode(cfg Config) error {
 	return errors.Wrap(ErrInvalidCfg, "Archive mode is incompatible with trieless state DB")
 }
 
+// ValidateDevChain validates the dev chain settings, which need the states of past blocks to revert the chain
+func ValidateDevChain(cfg Config) error {
+	if !cfg.Consensus.Dev.Enabled {
+		return nil
+	}
+	if cfg.Consensus.Scheme != StandaloneScheme {
+		return errors.Wrap(ErrInvalidCfg, "dev chain requires standalone scheme")
+	}
+	if !cfg.Chain.EnableArchiveMode || cfg.Chain.EnableTrielessStateDB {
+		return errors.Wrap(ErrInvalidCfg, "dev chain requires archive mode")
+	}
+	if cfg.Chain.EnableAsyncIndexWrite {
+		return errors.Wrap(ErrInvalidCfg, "dev chain is incompatible with async index write")
+	}
+	if _, err := cfg.Consensus.Dev.Balance(); err != nil {
+		return errors.Wrap(ErrInvalidCfg, err.Error())
+	}
+	return nil
+}
+
+// ValidateFork validates the settings of forking from a remote node
+func ValidateFork(cfg Config) error {
+	if cfg.Chain.ForkEndpoint == "" || !cfg.Chain.EnableTrielessStateDB {
+		return nil
+	}
+	return errors.Wrap(ErrInvalidCfg, "forking from a remote node is incompatible with trieless state DB")
+}
+
 // ValidateAPI validates the api configs
 func ValidateAPI(cfg Config) error {
 	if cfg.API.TpsWindow <= 0 {
 		return errors.Wrap(ErrInvalidCfg, "tps window is not a positive integer when the api is enabled")
 	}
+	if rl := cfg.API.RateLimit; rl.Enabled && (rl.RequestsPerSecond <= 0 || rl.Burst <= 0) {
+		return errors.Wrap(ErrInvalidCfg, "rate limit requests per second and burst must be positive")
+	}
+	if _, err := api.NewAuthenticator(cfg.API.Auth); err != nil {
+		return errors.Wrap(ErrInvalidCfg, err.Error())
+	}
 	return nil
 }
 
@@ -333,6 +369,8 @@ func ValidateForkHeights(cfg Config) error {
 		return errors.Wrap(ErrInvalidCfg, "Sumatra is heigher than Tsunami")
 	case hu.TsunamiBlockHeight > hu.UpernavikBlockHeight:
 		return errors.Wrap(ErrInvalidCfg, "Tsunami is heigher than Upernavik")
+	case hu.UpernavikBlockHeight > hu.VanuatuBlockHeight:
+		return errors.Wrap(ErrInvalidCfg, "Upernavik is heigher than Vanuatu")
 	}
 	return nil
 }
@@ -269,6 +269,57 @@ func TestValidateArchiveMode(t *testing.T) {
 	require.NoError(t, errors.Cause(ValidateArchiveMode(cfg)))
 }
 
+func TestValidateDevChain(t *testing.T) {
+	require := require.New(t)
+	cfg := Default
+	cfg.Consensus.Dev.Enabled = true
+	cfg.Consensus.Scheme = RollDPoSScheme
+	require.EqualError(ValidateDevChain(cfg), "dev chain requires standalone scheme: invalid config value")
+	cfg.Consensus.Scheme = StandaloneScheme
+	cfg.Chain.EnableArchiveMode = false
+	require.EqualError(ValidateDevChain(cfg), "dev chain requires archive mode: invalid config value")
+	cfg.Chain.EnableArchiveMode = true
+	cfg.Chain.EnableTrielessStateDB = false
+	cfg.Chain.EnableAsyncIndexWrite = true
+	require.EqualError(ValidateDevChain(cfg), "dev chain is incompatible with async index write: invalid config value")
+	cfg.Chain.EnableAsyncIndexWrite = false
+	cfg.Consensus.Dev.AccountBalance = "-1"
+	require.Equal(ErrInvalidCfg, errors.Cause(ValidateDevChain(cfg)))
+	cfg.Consensus.Dev.AccountBalance = "1000"
+	require.NoError(ValidateDevChain(cfg))
+	cfg.Consensus.Dev.Enabled = false
+	cfg.Consensus.Scheme = RollDPoSScheme
+	require.NoError(ValidateDevChain(cfg))
+}
+
+func TestValidateAPI(t *testing.T) {
+	cfg := Default
+	require.NoError(t, ValidateAPI(cfg))
+	cfg.API.RateLimit.Enabled = true
+	require.NoError(t, ValidateAPI(cfg))
+	cfg.API.RateLimit.Burst = 0
+	require.EqualError(t, ValidateAPI(cfg), "rate limit requests per second and burst must be positive: invalid config value")
+	cfg.API.RateLimit.Enabled = false
+	cfg.API.Auth.Enabled = true
+	cfg.API.Auth.APIKeys = map[string]string{"key": "partner"}
+	require.EqualError(t, ValidateAPI(cfg), "allow-list partner of API key is not defined: invalid config value")
+	cfg.API.RateLimit.Enabled = true
+	cfg.API.TpsWindow = 0
+	require.EqualError(t, ValidateAPI(cfg), "tps window is not a positive integer when the api is enabled: invalid config value")
+}
+
+func TestValidateFork(t *testing.T) {
+	cfg := Default
+	cfg.Chain.ForkEndpoint = "localhost:14014"
+	cfg.Chain.EnableTrielessStateDB = true
+	require.EqualError(t, ValidateFork(cfg), "forking from a remote node is incompatible with trieless state DB: invalid config value")
+	cfg.Chain.EnableTrielessStateDB = false
+	require.NoError(t, ValidateFork(cfg))
+	cfg.Chain.ForkEndpoint = ""
+	cfg.Chain.EnableTrielessStateDB = true
+	require.NoError(t, ValidateFork(cfg))
+}
+
 func TestValidateActPool(t *testing.T) {
 	cfg := Default
 	cfg.ActPool.MaxNumActsPerAcct = 0
@@ -383,6 +434,9 @@ func TestValidateForkHeights(t *testing.T) {
 		{
 			"Tsunami", ErrInvalidCfg, "Tsunami is heigher than Upernavik",
 		},
+		{
+			"Upernavik", ErrInvalidCfg, "Upernavik is heigher than Vanuatu",
+		},
 		{
 			"", nil, "",
 		},
@@ -445,6 +499,9 @@ func newTestCfg(fork string) Config {
 		cfg.Genesis.SumatraBlockHeight = cfg.Genesis.TsunamiBlockHeight + 1
 	case "Tsunami":
 		cfg.Genesis.TsunamiBlockHeight = cfg.Genesis.UpernavikBlockHeight + 1
+	case "Upernavik":
+		cfg.Genesis.VanuatuBlockHeight = 39275561
+		cfg.Genesis.UpernavikBlockHeight = cfg.Genesis.VanuatuBlockHeight + 1
 	}
 	return cfg
 }
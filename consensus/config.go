@@ -1,6 +1,13 @@
 package consensus
 
 import (
+	"fmt"
+	"math/big"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+
 	"github.com/iotexproject/iotex-core/consensus/scheme/rolldpos"
 )
 
@@ -18,6 +25,12 @@ var (
 	DefaultConfig = Config{
 		Scheme:   StandaloneScheme,
 		RollDPoS: rolldpos.DefaultConfig,
+		Dev: DevConfig{
+			Enabled:        false,
+			NumAccounts:    10,
+			AccountBalance: "10000000000000000000000",
+			Seed:           "iotex-dev-chain",
+		},
 	}
 )
 
@@ -27,5 +40,40 @@ type (
 		// There are three schemes that are supported
 		Scheme   string          `yaml:"scheme"`
 		RollDPoS rolldpos.Config `yaml:"rollDPoS"`
+		Dev      DevConfig       `yaml:"dev"`
+	}
+
+	// DevConfig is the config of the dev chain, which mints a block on every incoming action with the standalone scheme
+	DevConfig struct {
+		Enabled bool `yaml:"enabled"`
+		// NumAccounts is the number of prefunded accounts
+		NumAccounts int `yaml:"numAccounts"`
+		// AccountBalance is the balance of each prefunded account in Rau
+		AccountBalance string `yaml:"accountBalance"`
+		// Seed is used to derive the private keys of the prefunded accounts
+		Seed string `yaml:"seed"`
 	}
 )
+
+// Accounts returns the private keys of the prefunded accounts, which are derived from the seed deterministically
+func (cfg DevConfig) Accounts() ([]crypto.PrivateKey, error) {
+	keys := make([]crypto.PrivateKey, 0, cfg.NumAccounts)
+	for i := 0; i < cfg.NumAccounts; i++ {
+		h := hash.Hash256b([]byte(fmt.Sprintf("%s-%d", cfg.Seed, i)))
+		sk, err := crypto.BytesToPrivateKey(h[:])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to derive private key of account %d", i)
+		}
+		keys = append(keys, sk)
+	}
+	return keys, nil
+}
+
+// Balance returns the balance of each prefunded account
+func (cfg DevConfig) Balance() (*big.Int, error) {
+	balance, ok := new(big.Int).SetString(cfg.AccountBalance, 10)
+	if !ok || balance.Sign() < 0 {
+		return nil, errors.Errorf("invalid account balance %s", cfg.AccountBalance)
+	}
+	return balance, nil
+}
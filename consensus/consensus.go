@@ -7,6 +7,7 @@ package consensus
 
 import (
 	"context"
+	"time"
 
 	"github.com/facebookgo/clock"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
@@ -16,11 +17,13 @@ import (
 	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/action/protocol/poll"
 	rp "github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/action/protocol/staking"
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/consensus/scheme"
 	"github.com/iotexproject/iotex-core/consensus/scheme/rolldpos"
+	"github.com/iotexproject/iotex-core/crypto/bls"
 	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/state"
@@ -35,6 +38,8 @@ type Consensus interface {
 	Calibrate(uint64)
 	ValidateBlockFooter(*block.Block) error
 	Metrics() (scheme.ConsensusMetrics, error)
+	Timeline(uint64) (*scheme.ConsensusTimeline, error)
+	DevChain() (*scheme.DevChain, error)
 	Activate(bool)
 	Active() bool
 }
@@ -49,6 +54,8 @@ type optionParams struct {
 	broadcastHandler scheme.Broadcast
 	pp               poll.Protocol
 	rp               *rp.Protocol
+	evidenceHandler  rolldpos.EvidenceHandler
+	rewind           scheme.RewindCB
 }
 
 // Option sets Consensus construction parameter.
@@ -78,6 +85,22 @@ func WithPollProtocol(pp poll.Protocol) Option {
 	}
 }
 
+// WithEvidenceHandler is an option to handle the double-sign evidence detected by consensus
+func WithEvidenceHandler(evidenceHandler rolldpos.EvidenceHandler) Option {
+	return func(ops *optionParams) error {
+		ops.evidenceHandler = evidenceHandler
+		return nil
+	}
+}
+
+// WithDevChain is an option to run the standalone scheme as a dev chain, which rewinds the chain by the callback
+func WithDevChain(rewind scheme.RewindCB) Option {
+	return func(ops *optionParams) error {
+		ops.rewind = rewind
+		return nil
+	}
+}
+
 // NewConsensus creates a IotxConsensus struct.
 func NewConsensus(
 	cfg rolldpos.BuilderConfig,
@@ -132,6 +155,14 @@ func NewConsensus(
 			return addrs, nil
 		}
 		proposersByEpochFunc := delegatesByEpochFunc
+		blsPubKeysFunc := func(height uint64, delegates []string) ([]*bls.PublicKey, error) {
+			csr, err := staking.ConstructBaseView(sf)
+			if err != nil {
+				return nil, err
+			}
+			epochStartHeight := ops.rp.GetEpochHeight(ops.rp.GetEpochNum(height))
+			return staking.DelegateBLSPublicKeys(csr.AllCandidates(), delegates, epochStartHeight)
+		}
 		bd := rolldpos.NewRollDPoSBuilder().
 			SetAddr(cfg.Chain.ProducerAddress().String()).
 			SetPriKey(cfg.Chain.ProducerPrivateKey()).
@@ -142,6 +173,12 @@ func NewConsensus(
 			SetBroadcast(ops.broadcastHandler).
 			SetDelegatesByEpochFunc(delegatesByEpochFunc).
 			SetProposersByEpochFunc(proposersByEpochFunc).
+			SetEvidenceHandler(ops.evidenceHandler).
+			SetBLSPriKey(cfg.Chain.ProducerBLSPrivateKey()).
+			SetBLSPublicKeysFunc(blsPubKeysFunc).
+			SetNumDelegatesByEpochFunc(func(epochNum uint64) (uint64, error) {
+				return ops.rp.NumDelegatesByEpoch(sf, epochNum)
+			}).
 			RegisterProtocol(ops.rp)
 		// TODO: explorer dependency deleted here at #1085, need to revive by migrating to api
 		cs.scheme, err = bd.Build()
@@ -151,8 +188,8 @@ func NewConsensus(
 	case NOOPScheme:
 		cs.scheme = scheme.NewNoop()
 	case StandaloneScheme:
-		mintBlockCB := func() (*block.Block, error) {
-			blk, err := bc.MintNewBlock(clock.Now())
+		mintBlockAt := func(timestamp time.Time) (*block.Block, error) {
+			blk, err := bc.MintNewBlock(timestamp)
 			if err != nil {
 				log.Logger("consensus").Error("Failed to mint a block.", zap.Error(err))
 				return nil, err
@@ -175,6 +212,20 @@ func NewConsensus(
 			}
 			return nil
 		}
+		if ops.rewind != nil {
+			cs.scheme = scheme.NewDevChain(
+				mintBlockAt,
+				commitBlockCB,
+				broadcastBlockCB,
+				ops.rewind,
+				bc,
+				clock,
+			)
+			break
+		}
+		mintBlockCB := func() (*block.Block, error) {
+			return mintBlockAt(clock.Now())
+		}
 		cs.scheme = scheme.NewStandalone(
 			mintBlockCB,
 			commitBlockCB,
@@ -216,6 +267,24 @@ func (c *IotxConsensus) Metrics() (scheme.ConsensusMetrics, error) {
 	return c.scheme.Metrics()
 }
 
+// Timeline returns the consensus timeline of a recent height, which is only recorded by roll-DPoS
+func (c *IotxConsensus) Timeline(height uint64) (*scheme.ConsensusTimeline, error) {
+	rolldpos, ok := c.scheme.(*rolldpos.RollDPoS)
+	if !ok {
+		return nil, errors.Errorf("consensus timeline is not supported by scheme %s", c.cfg.Scheme)
+	}
+	return rolldpos.Timeline(height)
+}
+
+// DevChain returns the dev chain scheme, which is only enabled in dev mode
+func (c *IotxConsensus) DevChain() (*scheme.DevChain, error) {
+	dev, ok := c.scheme.(*scheme.DevChain)
+	if !ok {
+		return nil, errors.New("dev chain is not enabled")
+	}
+	return dev, nil
+}
+
 // HandleConsensusMsg handles consensus messages
 func (c *IotxConsensus) HandleConsensusMsg(msg *iotextypes.ConsensusMessage) error {
 	return c.scheme.HandleConsensusMsg(msg)
@@ -85,14 +85,18 @@ var (
 	}
 )
 
+// TransitionHandler is called after the consensus fsm transits from src to dst on the event
+type TransitionHandler func(evt *ConsensusEvent, src, dst fsm.State)
+
 // ConsensusFSM wraps over the general purpose FSM and implements the consensus logic
 type ConsensusFSM struct {
-	fsm   fsm.FSM
-	evtq  chan *ConsensusEvent
-	close chan interface{}
-	clock clock.Clock
-	ctx   Context
-	wg    sync.WaitGroup
+	fsm          fsm.FSM
+	evtq         chan *ConsensusEvent
+	close        chan interface{}
+	clock        clock.Clock
+	ctx          Context
+	wg           sync.WaitGroup
+	onTransition TransitionHandler
 }
 
 // NewConsensusFSM returns a new fsm
@@ -252,6 +256,11 @@ func (m *ConsensusFSM) Stop(_ context.Context) error {
 	return nil
 }
 
+// SetTransitionHandler sets the handler of state transitions, it should be called before Start
+func (m *ConsensusFSM) SetTransitionHandler(h TransitionHandler) {
+	m.onTransition = h
+}
+
 // CurrentState returns the current state
 func (m *ConsensusFSM) CurrentState() fsm.State {
 	return m.fsm.CurrentState()
@@ -345,6 +354,9 @@ func (m *ConsensusFSM) handle(evt *ConsensusEvent) error {
 			zap.String("evt", string(evt.Type())),
 		)
 		_consensusEvtsMtc.WithLabelValues(string(evt.Type()), "consumed").Inc()
+		if m.onTransition != nil {
+			m.onTransition(evt, src, m.fsm.CurrentState())
+		}
 	case fsm.ErrTransitionNotFound:
 		if m.ctx.IsStaleUnmatchedEvent(evt) {
 			_consensusEvtsMtc.WithLabelValues(string(evt.Type()), "stale").Inc()
@@ -0,0 +1,137 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package scheme
+
+import (
+	"sync"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/routine"
+)
+
+// DevChain is the standalone scheme for local development, which mints a block on every trigger instead of
+// periodically. The time of the blocks can be moved forward, and the chain can be reverted to a snapshot.
+type DevChain struct {
+	*Standalone
+	trigger   *routine.TriggeredTask
+	bc        blockchain.Blockchain
+	mintCb    func(time.Time) (*block.Block, error)
+	commitCb  ConsensusDoneCB
+	pubCb     BroadcastCB
+	rewindCb  RewindCB
+	clk       clock.Clock
+	mutex     sync.Mutex
+	offset    time.Duration
+	snapshots map[uint64]uint64
+	nextID    uint64
+}
+
+// NewDevChain creates a DevChain struct.
+func NewDevChain(
+	mint func(time.Time) (*block.Block, error),
+	commit ConsensusDoneCB,
+	pub BroadcastCB,
+	rewind RewindCB,
+	bc blockchain.Blockchain,
+	clk clock.Clock,
+) *DevChain {
+	d := &DevChain{
+		bc:        bc,
+		mintCb:    mint,
+		commitCb:  commit,
+		pubCb:     pub,
+		rewindCb:  rewind,
+		clk:       clk,
+		snapshots: make(map[uint64]uint64),
+		nextID:    1,
+	}
+	d.trigger = routine.NewTriggeredTask(func() {
+		d.mutex.Lock()
+		defer d.mutex.Unlock()
+		if err := d.mine(); err != nil {
+			log.L().Error("Failed to mint on trigger.", zap.Error(err))
+		}
+	})
+	d.Standalone = &Standalone{task: d.trigger}
+	return d
+}
+
+// Trigger requests minting a block in background
+func (d *DevChain) Trigger() {
+	d.trigger.Trigger()
+}
+
+// Mine mints a block at once. If timestamp is not zero, the block and the following ones are minted from it.
+func (d *DevChain) Mine(timestamp time.Time) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if !timestamp.IsZero() {
+		d.offset = timestamp.Sub(d.clk.Now())
+	}
+	return d.mine()
+}
+
+// IncreaseTime moves the time of the following blocks forward, and returns the total time moved
+func (d *DevChain) IncreaseTime(delta time.Duration) (time.Duration, error) {
+	if delta < 0 {
+		return 0, errors.New("cannot move time backward")
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.offset += delta
+	return d.offset, nil
+}
+
+// Snapshot records the current tip, and returns the id to revert to it
+func (d *DevChain) Snapshot() uint64 {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	id := d.nextID
+	d.nextID++
+	d.snapshots[id] = d.bc.TipHeight()
+	return id
+}
+
+// Revert rewinds the chain to the snapshot, which is removed together with the ones taken after it. It returns
+// false if the snapshot does not exist.
+func (d *DevChain) Revert(id uint64) (bool, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	height, ok := d.snapshots[id]
+	if !ok {
+		return false, nil
+	}
+	if err := d.rewindCb(height); err != nil {
+		return false, errors.Wrapf(err, "failed to revert to snapshot %d at height %d", id, height)
+	}
+	for k := range d.snapshots {
+		if k >= id {
+			delete(d.snapshots, k)
+		}
+	}
+	return true, nil
+}
+
+func (d *DevChain) mine() error {
+	blk, err := d.mintCb(d.clk.Now().Add(d.offset))
+	if err != nil {
+		return err
+	}
+	if err := d.commitCb(blk); err != nil {
+		return err
+	}
+	if err := d.pubCb(blk); err != nil {
+		log.L().Error("Failed to publish event.", zap.Error(err))
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package scheme
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/test/mock/mock_blockchain"
+)
+
+func TestDevChain(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	bc := mock_blockchain.NewMockBlockchain(ctrl)
+	clk := clock.NewMock()
+	clk.Add(time.Hour)
+
+	var (
+		tip     uint64
+		minted  []time.Time
+		rewinds []uint64
+		commits = make(chan struct{}, 1)
+	)
+	bc.EXPECT().TipHeight().DoAndReturn(func() uint64 { return tip }).AnyTimes()
+	d := NewDevChain(
+		func(ts time.Time) (*block.Block, error) {
+			minted = append(minted, ts)
+			return &block.Block{}, nil
+		},
+		func(*block.Block) error {
+			tip++
+			commits <- struct{}{}
+			return nil
+		},
+		func(*block.Block) error { return nil },
+		func(h uint64) error {
+			rewinds = append(rewinds, h)
+			tip = h
+			return nil
+		},
+		bc,
+		clk,
+	)
+	ctx := context.Background()
+	require.NoError(d.Start(ctx))
+	defer func() {
+		require.NoError(d.Stop(ctx))
+	}()
+
+	// mint on trigger
+	d.Trigger()
+	select {
+	case <-commits:
+	case <-time.After(time.Second):
+		require.FailNow("no block minted on trigger")
+	}
+	require.Equal(uint64(1), tip)
+
+	// move the time forward
+	_, err := d.IncreaseTime(-time.Second)
+	require.Error(err)
+	offset, err := d.IncreaseTime(time.Minute)
+	require.NoError(err)
+	require.Equal(time.Minute, offset)
+	id1 := d.Snapshot()
+	require.NoError(d.Mine(time.Time{}))
+	<-commits
+	require.Equal(clk.Now().Add(time.Minute), minted[1])
+	ts := clk.Now().Add(time.Hour)
+	require.NoError(d.Mine(ts))
+	<-commits
+	require.Equal(ts, minted[2])
+	require.Equal(uint64(3), tip)
+
+	// revert to the snapshots
+	id2 := d.Snapshot()
+	id3 := d.Snapshot()
+	ok, err := d.Revert(id2)
+	require.NoError(err)
+	require.True(ok)
+	ok, err = d.Revert(id3)
+	require.NoError(err)
+	require.False(ok)
+	ok, err = d.Revert(id1)
+	require.NoError(err)
+	require.True(ok)
+	require.Equal([]uint64{3, 1}, rewinds)
+	require.Equal(uint64(1), tip)
+}
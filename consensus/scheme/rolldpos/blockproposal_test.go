@@ -11,6 +11,7 @@ import (
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/test/identityset"
@@ -41,7 +42,8 @@ func TestNewBlockProposal(t *testing.T) {
 	require.NoError(bp3.LoadProto(pro, block.NewDeserializer(0)))
 	pro3, err := bp3.Proto()
 	require.NoError(err)
-	require.EqualValues(pro, pro3)
+	// reading the unknown fields of pro initializes its internal message state
+	require.True(proto.Equal(pro, pro3))
 }
 func getBlock(t *testing.T) block.Block {
 	require := require.New(t)
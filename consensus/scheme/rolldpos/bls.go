@@ -0,0 +1,91 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"time"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/crypto/bls"
+	"github.com/iotexproject/iotex-core/endorsement"
+)
+
+type (
+	// BLSPublicKeysFunc returns the BLS public keys of the delegates as of the start of the epoch
+	// of the height, in the same order with nil for the delegates not registering one
+	BLSPublicKeysFunc func(height uint64, delegates []string) ([]*bls.PublicKey, error)
+
+	// blsAggregator signs the commit votes with the BLS key, and aggregates the commit
+	// endorsements in the block footer from the fork height
+	blsAggregator struct {
+		sk        *bls.PrivateKey
+		pubKeys   BLSPublicKeysFunc
+		isEnabled func(uint64) bool
+	}
+)
+
+func (a *blsAggregator) enabled(height uint64) bool {
+	return a != nil && a.isEnabled != nil && a.isEnabled(height)
+}
+
+// endorseCommit endorses the commit vote, with the BLS signature if the node has a BLS key
+func (a *blsAggregator) endorseCommit(height uint64, signer crypto.PrivateKey, vote *ConsensusVote, ts time.Time) (*endorsement.Endorsement, error) {
+	if !a.enabled(height) || a.sk == nil {
+		return endorsement.Endorse(signer, vote, ts)
+	}
+	return endorsement.EndorseWithBLS(signer, a.sk, vote, ts)
+}
+
+// finalize aggregates the BLS signatures of the commit endorsements signed at ts into the footer
+func (a *blsAggregator) finalize(blk *block.Block, delegates []string, ens []*endorsement.Endorsement, ts time.Time) error {
+	if !a.enabled(blk.Height()) || a.pubKeys == nil {
+		return blk.Finalize(ens, ts)
+	}
+	pubKeys, err := a.pubKeys(blk.Height(), delegates)
+	if err != nil {
+		return errors.Wrap(err, "failed to get BLS public keys of delegates")
+	}
+	blkHash := blk.HashBlock()
+	aggregated, rest, err := endorsement.AggregateEndorsements(NewConsensusVote(blkHash[:], COMMIT), ens, delegates, pubKeys, ts)
+	if err != nil {
+		return err
+	}
+	if aggregated == nil {
+		return blk.Finalize(ens, ts)
+	}
+	return blk.FinalizeWithAggregation(aggregated, rest, ts)
+}
+
+// verify verifies the aggregated commit endorsement, and returns the delegates signing it
+func (a *blsAggregator) verify(
+	height uint64,
+	delegates []string,
+	vote *ConsensusVote,
+	en *endorsement.AggregatedEndorsement,
+) ([]string, error) {
+	if !a.enabled(height) || a.pubKeys == nil {
+		return nil, errors.Errorf("aggregated endorsement is not allowed at height %d", height)
+	}
+	pubKeys, err := a.pubKeys(height, delegates)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get BLS public keys of delegates")
+	}
+	if len(pubKeys) != len(delegates) {
+		return nil, errors.Errorf("%d BLS public keys for %d delegates", len(pubKeys), len(delegates))
+	}
+	indexes, err := endorsement.VerifyAggregatedEndorsement(vote, en, pubKeys)
+	if err != nil {
+		return nil, err
+	}
+	signers := make([]string, 0, len(indexes))
+	for _, i := range indexes {
+		signers = append(signers, delegates[i])
+	}
+	return signers, nil
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/consensus/consensusfsm"
+	"github.com/iotexproject/iotex-core/crypto/bls"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_blockchain"
+)
+
+func TestValidateBlockFooterWithAggregation(t *testing.T) {
+	r := require.New(t)
+	ctrl := gomock.NewController(t)
+	bc := mock_blockchain.NewMockBlockchain(ctrl)
+	bc.EXPECT().BlockFooterByHeight(uint64(8)).Return(&block.Footer{}, nil).AnyTimes()
+
+	g := genesis.Default
+	g.NumDelegates = 4
+	g.NumSubEpochs = 1
+	g.BlockInterval = 10 * time.Second
+	g.Timestamp = int64(1500000000)
+	g.ToBeEnabledBlockHeight = 0
+	bc.EXPECT().Genesis().Return(g).AnyTimes()
+	delegatesByEpoch := func(uint64) ([]string, error) {
+		return []string{
+			identityset.Address(0).String(),
+			identityset.Address(1).String(),
+			identityset.Address(2).String(),
+			identityset.Address(3).String(),
+		}, nil
+	}
+	blsKeys := make(map[string]*bls.PrivateKey)
+	for i := 0; i < 3; i++ {
+		sk, err := bls.GenerateKey()
+		r.NoError(err)
+		blsKeys[identityset.Address(i).String()] = sk
+	}
+	rp, err := NewRollDPoSBuilder().
+		SetConfig(BuilderConfig{
+			Chain:              blockchain.DefaultConfig,
+			Consensus:          DefaultConfig,
+			DardanellesUpgrade: consensusfsm.DefaultDardanellesUpgradeConfig,
+			DB:                 db.DefaultConfig,
+			Genesis:            g,
+			SystemActive:       true,
+		}).
+		SetAddr(identityset.Address(1).String()).
+		SetPriKey(identityset.PrivateKey(1)).
+		SetChainManager(NewChainManager(bc)).
+		SetBroadcast(func(_ proto.Message) error {
+			return nil
+		}).
+		SetDelegatesByEpochFunc(delegatesByEpoch).
+		SetProposersByEpochFunc(delegatesByEpoch).
+		SetBLSPublicKeysFunc(func(_ uint64, delegates []string) ([]*bls.PublicKey, error) {
+			pubKeys := make([]*bls.PublicKey, len(delegates))
+			for i, addr := range delegates {
+				if sk, ok := blsKeys[addr]; ok {
+					pubKeys[i] = sk.PublicKey()
+				}
+			}
+			return pubKeys, nil
+		}).
+		SetClock(clock.NewMock()).
+		RegisterProtocol(rolldpos.NewProtocol(g.NumCandidateDelegates, g.NumDelegates, g.NumSubEpochs)).
+		Build()
+	r.NoError(err)
+
+	ts := time.Unix(1500000000, 0)
+	finalize := func(endorsers ...int) *block.Block {
+		blk := makeBlock(t, 1, 0, false, 9)
+		blkHash := blk.HashBlock()
+		vote := NewConsensusVote(blkHash[:], COMMIT)
+		var ens []*endorsement.Endorsement
+		for _, i := range endorsers {
+			var (
+				en  *endorsement.Endorsement
+				err error
+			)
+			if sk, ok := blsKeys[identityset.Address(i).String()]; ok {
+				en, err = endorsement.EndorseWithBLS(identityset.PrivateKey(i), sk, vote, ts)
+			} else {
+				en, err = endorsement.Endorse(identityset.PrivateKey(i), vote, ts)
+			}
+			r.NoError(err)
+			ens = append(ens, en)
+		}
+		round, err := rp.ctx.RoundCalculator().NewRound(9, g.BlockInterval, blk.Timestamp(), nil)
+		r.NoError(err)
+		r.NoError(rp.bls.finalize(blk, round.Delegates(), ens, ts))
+		return blk
+	}
+
+	// 2 aggregated and 1 individual endorsements
+	blk := finalize(0, 2, 3)
+	r.NotNil(blk.AggregatedEndorsement())
+	r.Len(blk.Endorsements(), 1)
+	r.NoError(rp.ValidateBlockFooter(blk))
+
+	// the block survives the serialization
+	pb := blk.ConvertToBlockPb()
+	deserialized, err := block.NewDeserializer(0).FromBlockProto(pb)
+	r.NoError(err)
+	r.NoError(rp.ValidateBlockFooter(deserialized))
+
+	// all aggregated
+	blk = finalize(0, 1, 2)
+	r.Empty(blk.Endorsements())
+	r.NoError(rp.ValidateBlockFooter(blk))
+
+	// not enough endorsements
+	blk = finalize(0, 3)
+	r.ErrorIs(rp.ValidateBlockFooter(blk), ErrInsufficientEndorsements)
+
+	// aggregated endorsement is not allowed before the fork
+	blk = finalize(0, 1, 2)
+	rp.bls.isEnabled = func(uint64) bool { return false }
+	r.Error(rp.ValidateBlockFooter(blk))
+}
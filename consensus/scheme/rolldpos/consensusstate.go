@@ -0,0 +1,224 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/consensus/scheme/rolldpos/endorsementpb"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/endorsement"
+)
+
+var (
+	// ErrConflictingEndorsement indicates that signing the endorsement conflicts with a signed one
+	ErrConflictingEndorsement = errors.New("conflict with the signed endorsement")
+	_consensusStatusKey       = []byte("consensus")
+)
+
+// consensusState keeps the lock and the last signed round of the delegate, which
+// are written to the consensus db before any endorsement is broadcast, so that
+// the delegate won't sign conflicting endorsements after a restart
+type consensusState struct {
+	mutex sync.Mutex
+	kv    db.KVStore
+
+	signedHeight    uint64
+	signedRound     uint32
+	proposedBlkHash []byte
+	signedVotes     map[ConsensusVoteTopic][]byte
+
+	lockedHeight uint64
+	lockStatus   status
+	blockInLock  []byte
+	proofOfLock  []*endorsement.Endorsement
+}
+
+func newConsensusState(kv db.KVStore) *consensusState {
+	return &consensusState{
+		kv:          kv,
+		signedVotes: map[ConsensusVoteTopic][]byte{},
+	}
+}
+
+// Load reads the state from the consensus db
+func (s *consensusState) Load() error {
+	if s.kv == nil {
+		return nil
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	value, err := s.kv.Get(_eManagerNS, _consensusStatusKey)
+	switch errors.Cause(err) {
+	case nil:
+		statusPb := &endorsementpb.ConsensusStatus{}
+		if err := proto.Unmarshal(value, statusPb); err != nil {
+			return err
+		}
+		return s.fromProto(statusPb)
+	case db.ErrNotExist:
+		return nil
+	default:
+		return err
+	}
+}
+
+// SignProposal checks and records the block proposal to sign
+func (s *consensusState) SignProposal(height uint64, round uint32, blkHash []byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := s.startRound(height, round); err != nil {
+		return err
+	}
+	if s.proposedBlkHash != nil {
+		if !bytes.Equal(s.proposedBlkHash, blkHash) {
+			return errors.Wrapf(ErrConflictingEndorsement, "another block has been proposed at height %d round %d", height, round)
+		}
+		return nil
+	}
+	s.proposedBlkHash = blkHash
+	if err := s.persist(); err != nil {
+		s.proposedBlkHash = nil
+		return err
+	}
+	return nil
+}
+
+// SignVote checks and records the consensus vote to sign
+func (s *consensusState) SignVote(height uint64, round uint32, topic ConsensusVoteTopic, blkHash []byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := s.startRound(height, round); err != nil {
+		return err
+	}
+	if signed, ok := s.signedVotes[topic]; ok {
+		if !bytes.Equal(signed, blkHash) {
+			return errors.Wrapf(ErrConflictingEndorsement, "another block has been endorsed on topic %d at height %d round %d", topic, height, round)
+		}
+		return nil
+	}
+	s.signedVotes[topic] = blkHash
+	if err := s.persist(); err != nil {
+		delete(s.signedVotes, topic)
+		return err
+	}
+	return nil
+}
+
+// SetLock records the lock of the height
+func (s *consensusState) SetLock(height uint64, st status, blockInLock []byte, proofOfLock []*endorsement.Endorsement) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.lockedHeight = height
+	s.lockStatus = st
+	s.blockInLock = blockInLock
+	s.proofOfLock = proofOfLock
+	return s.persist()
+}
+
+// Lock returns the recorded lock of the height
+func (s *consensusState) Lock(height uint64) (status, []byte, []*endorsement.Endorsement) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if height == 0 || s.lockedHeight != height {
+		return _open, nil, nil
+	}
+	return s.lockStatus, s.blockInLock, s.proofOfLock
+}
+
+// InProgress returns true if the delegate has signed or locked at the height
+func (s *consensusState) InProgress(height uint64) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return height != 0 && (s.signedHeight == height || s.lockedHeight == height)
+}
+
+func (s *consensusState) startRound(height uint64, round uint32) error {
+	switch {
+	case height < s.signedHeight || height == s.signedHeight && round < s.signedRound:
+		return errors.Wrapf(
+			ErrConflictingEndorsement,
+			"cannot sign at height %d round %d, already signed at height %d round %d",
+			height, round, s.signedHeight, s.signedRound,
+		)
+	case height == s.signedHeight && round == s.signedRound:
+		return nil
+	}
+	s.signedHeight = height
+	s.signedRound = round
+	s.proposedBlkHash = nil
+	s.signedVotes = map[ConsensusVoteTopic][]byte{}
+	return nil
+}
+
+func (s *consensusState) persist() error {
+	if s.kv == nil {
+		return nil
+	}
+	statusPb, err := s.toProto()
+	if err != nil {
+		return err
+	}
+	value, err := proto.Marshal(statusPb)
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(s.kv.Put(_eManagerNS, _consensusStatusKey, value), "failed to persist consensus status")
+}
+
+func (s *consensusState) toProto() (*endorsementpb.ConsensusStatus, error) {
+	statusPb := &endorsementpb.ConsensusStatus{
+		SignedHeight:    s.signedHeight,
+		SignedRound:     s.signedRound,
+		ProposedBlkHash: s.proposedBlkHash,
+		LockedHeight:    s.lockedHeight,
+		LockStatus:      uint32(s.lockStatus),
+		BlockInLock:     s.blockInLock,
+	}
+	for _, topic := range []ConsensusVoteTopic{PROPOSAL, LOCK, COMMIT} {
+		if blkHash, ok := s.signedVotes[topic]; ok {
+			statusPb.SignedVotes = append(statusPb.SignedVotes, &endorsementpb.SignedVote{
+				Topic:   uint32(topic),
+				BlkHash: blkHash,
+			})
+		}
+	}
+	for _, en := range s.proofOfLock {
+		enPb, err := en.Proto()
+		if err != nil {
+			return nil, err
+		}
+		statusPb.ProofOfLock = append(statusPb.ProofOfLock, enPb)
+	}
+	return statusPb, nil
+}
+
+func (s *consensusState) fromProto(statusPb *endorsementpb.ConsensusStatus) error {
+	proofOfLock := make([]*endorsement.Endorsement, 0, len(statusPb.ProofOfLock))
+	for _, enPb := range statusPb.ProofOfLock {
+		en := &endorsement.Endorsement{}
+		if err := en.LoadProto(enPb); err != nil {
+			return err
+		}
+		proofOfLock = append(proofOfLock, en)
+	}
+	s.signedHeight = statusPb.SignedHeight
+	s.signedRound = statusPb.SignedRound
+	s.proposedBlkHash = statusPb.ProposedBlkHash
+	s.signedVotes = map[ConsensusVoteTopic][]byte{}
+	for _, vote := range statusPb.SignedVotes {
+		s.signedVotes[ConsensusVoteTopic(vote.Topic)] = vote.BlkHash
+	}
+	s.lockedHeight = statusPb.LockedHeight
+	s.lockStatus = status(statusPb.LockStatus)
+	s.blockInLock = statusPb.BlockInLock
+	s.proofOfLock = proofOfLock
+	return nil
+}
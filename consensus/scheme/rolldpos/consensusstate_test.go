@@ -0,0 +1,66 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestConsensusState(t *testing.T) {
+	require := require.New(t)
+	kv := db.NewMemKVStore()
+	require.NoError(kv.Start(context.Background()))
+	s := newConsensusState(kv)
+	require.NoError(s.Load())
+	require.False(s.InProgress(10))
+
+	blk1, blk2 := []byte("block1"), []byte("block2")
+	require.NoError(s.SignProposal(10, 1, blk1))
+	require.NoError(s.SignProposal(10, 1, blk1))
+	require.ErrorIs(s.SignProposal(10, 1, blk2), ErrConflictingEndorsement)
+	require.NoError(s.SignVote(10, 1, PROPOSAL, blk1))
+	require.ErrorIs(s.SignVote(10, 1, PROPOSAL, blk2), ErrConflictingEndorsement)
+	require.ErrorIs(s.SignVote(10, 1, PROPOSAL, nil), ErrConflictingEndorsement)
+	proof := []*endorsement.Endorsement{
+		endorsement.NewEndorsement(time.Unix(1700000000, 0), identityset.PrivateKey(1).PublicKey(), []byte("sig")),
+	}
+	require.NoError(s.SetLock(10, _locked, blk1, proof))
+	require.NoError(s.SignVote(10, 1, LOCK, blk1))
+	require.True(s.InProgress(10))
+
+	// reload after restart
+	s = newConsensusState(kv)
+	require.NoError(s.Load())
+	require.True(s.InProgress(10))
+	require.False(s.InProgress(11))
+	st, blkInLock, proofOfLock := s.Lock(10)
+	require.Equal(_locked, st)
+	require.Equal(blk1, blkInLock)
+	require.Equal(proof, proofOfLock)
+	st, blkInLock, proofOfLock = s.Lock(11)
+	require.Equal(_open, st)
+	require.Nil(blkInLock)
+	require.Nil(proofOfLock)
+	require.ErrorIs(s.SignProposal(10, 1, blk2), ErrConflictingEndorsement)
+	require.ErrorIs(s.SignVote(10, 1, LOCK, blk2), ErrConflictingEndorsement)
+	require.NoError(s.SignVote(10, 1, COMMIT, blk1))
+
+	// older rounds cannot be signed
+	require.ErrorIs(s.SignVote(10, 0, PROPOSAL, blk2), ErrConflictingEndorsement)
+	require.ErrorIs(s.SignVote(9, 3, PROPOSAL, blk2), ErrConflictingEndorsement)
+	// a new round starts over
+	require.NoError(s.SignVote(10, 2, PROPOSAL, blk2))
+	require.NoError(s.SignProposal(11, 0, blk2))
+	require.True(s.InProgress(11))
+}
@@ -210,6 +210,164 @@ func (x *EndorsementManager) GetCachedMintedBlk() *iotextypes.Block {
 	return nil
 }
 
+type SignedVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic   uint32 `protobuf:"varint,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	BlkHash []byte `protobuf:"bytes,2,opt,name=blkHash,proto3" json:"blkHash,omitempty"`
+}
+
+func (x *SignedVote) Reset() {
+	*x = SignedVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignedVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedVote) ProtoMessage() {}
+
+func (x *SignedVote) ProtoReflect() protoreflect.Message {
+	mi := &file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedVote.ProtoReflect.Descriptor instead.
+func (*SignedVote) Descriptor() ([]byte, []int) {
+	return file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SignedVote) GetTopic() uint32 {
+	if x != nil {
+		return x.Topic
+	}
+	return 0
+}
+
+func (x *SignedVote) GetBlkHash() []byte {
+	if x != nil {
+		return x.BlkHash
+	}
+	return nil
+}
+
+type ConsensusStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SignedHeight    uint64                    `protobuf:"varint,1,opt,name=signedHeight,proto3" json:"signedHeight,omitempty"`
+	SignedRound     uint32                    `protobuf:"varint,2,opt,name=signedRound,proto3" json:"signedRound,omitempty"`
+	ProposedBlkHash []byte                    `protobuf:"bytes,3,opt,name=proposedBlkHash,proto3" json:"proposedBlkHash,omitempty"`
+	SignedVotes     []*SignedVote             `protobuf:"bytes,4,rep,name=signedVotes,proto3" json:"signedVotes,omitempty"`
+	LockedHeight    uint64                    `protobuf:"varint,5,opt,name=lockedHeight,proto3" json:"lockedHeight,omitempty"`
+	LockStatus      uint32                    `protobuf:"varint,6,opt,name=lockStatus,proto3" json:"lockStatus,omitempty"`
+	BlockInLock     []byte                    `protobuf:"bytes,7,opt,name=blockInLock,proto3" json:"blockInLock,omitempty"`
+	ProofOfLock     []*iotextypes.Endorsement `protobuf:"bytes,8,rep,name=proofOfLock,proto3" json:"proofOfLock,omitempty"`
+}
+
+func (x *ConsensusStatus) Reset() {
+	*x = ConsensusStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsensusStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsensusStatus) ProtoMessage() {}
+
+func (x *ConsensusStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsensusStatus.ProtoReflect.Descriptor instead.
+func (*ConsensusStatus) Descriptor() ([]byte, []int) {
+	return file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ConsensusStatus) GetSignedHeight() uint64 {
+	if x != nil {
+		return x.SignedHeight
+	}
+	return 0
+}
+
+func (x *ConsensusStatus) GetSignedRound() uint32 {
+	if x != nil {
+		return x.SignedRound
+	}
+	return 0
+}
+
+func (x *ConsensusStatus) GetProposedBlkHash() []byte {
+	if x != nil {
+		return x.ProposedBlkHash
+	}
+	return nil
+}
+
+func (x *ConsensusStatus) GetSignedVotes() []*SignedVote {
+	if x != nil {
+		return x.SignedVotes
+	}
+	return nil
+}
+
+func (x *ConsensusStatus) GetLockedHeight() uint64 {
+	if x != nil {
+		return x.LockedHeight
+	}
+	return 0
+}
+
+func (x *ConsensusStatus) GetLockStatus() uint32 {
+	if x != nil {
+		return x.LockStatus
+	}
+	return 0
+}
+
+func (x *ConsensusStatus) GetBlockInLock() []byte {
+	if x != nil {
+		return x.BlockInLock
+	}
+	return nil
+}
+
+func (x *ConsensusStatus) GetProofOfLock() []*iotextypes.Endorsement {
+	if x != nil {
+		return x.ProofOfLock
+	}
+	return nil
+}
+
 var File_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto protoreflect.FileDescriptor
 
 var file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_rawDesc = []byte{
@@ -252,7 +410,33 @@ var file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_rawDes
 	0x68, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6b, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
 	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x4d, 0x69, 0x6e,
-	0x74, 0x65, 0x64, 0x42, 0x6c, 0x6b, 0x42, 0x4c, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x74, 0x65, 0x64, 0x42, 0x6c, 0x6b, 0x22, 0x3c, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x56, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c, 0x6b,
+	0x48, 0x61, 0x73, 0x68, 0x22, 0xdf, 0x02, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x28,
+	0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6b, 0x48, 0x61, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
+	0x64, 0x42, 0x6c, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3b, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x6f, 0x63,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x49, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x39, 0x0a, 0x0b, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x4c, 0x6f, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6e,
+	0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x4f, 0x66, 0x4c, 0x6f, 0x63, 0x6b, 0x42, 0x4c, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
 	0x74, 0x2f, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x6e,
 	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x2f, 0x72, 0x6f,
@@ -272,25 +456,29 @@ func file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_rawDe
 	return file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_rawDescData
 }
 
-var file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_goTypes = []interface{}{
 	(*EndorserEndorsementCollection)(nil), // 0: endorsementpb.endorserEndorsementCollection
 	(*BlockEndorsementCollection)(nil),    // 1: endorsementpb.blockEndorsementCollection
 	(*EndorsementManager)(nil),            // 2: endorsementpb.endorsementManager
-	(*iotextypes.Endorsement)(nil),        // 3: iotextypes.Endorsement
-	(*iotextypes.Block)(nil),              // 4: iotextypes.Block
+	(*SignedVote)(nil),                    // 3: endorsementpb.signedVote
+	(*ConsensusStatus)(nil),               // 4: endorsementpb.consensusStatus
+	(*iotextypes.Endorsement)(nil),        // 5: iotextypes.Endorsement
+	(*iotextypes.Block)(nil),              // 6: iotextypes.Block
 }
 var file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_depIdxs = []int32{
-	3, // 0: endorsementpb.endorserEndorsementCollection.endorsements:type_name -> iotextypes.Endorsement
-	4, // 1: endorsementpb.blockEndorsementCollection.blk:type_name -> iotextypes.Block
+	5, // 0: endorsementpb.endorserEndorsementCollection.endorsements:type_name -> iotextypes.Endorsement
+	6, // 1: endorsementpb.blockEndorsementCollection.blk:type_name -> iotextypes.Block
 	0, // 2: endorsementpb.blockEndorsementCollection.blockMap:type_name -> endorsementpb.endorserEndorsementCollection
 	1, // 3: endorsementpb.endorsementManager.blockEndorsements:type_name -> endorsementpb.blockEndorsementCollection
-	4, // 4: endorsementpb.endorsementManager.cachedMintedBlk:type_name -> iotextypes.Block
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	6, // 4: endorsementpb.endorsementManager.cachedMintedBlk:type_name -> iotextypes.Block
+	3, // 5: endorsementpb.consensusStatus.signedVotes:type_name -> endorsementpb.signedVote
+	5, // 6: endorsementpb.consensusStatus.proofOfLock:type_name -> iotextypes.Endorsement
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_init() }
@@ -335,6 +523,30 @@ func file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_init(
 				return nil
 			}
 		}
+		file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsensusStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -342,7 +554,7 @@ func file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_init(
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
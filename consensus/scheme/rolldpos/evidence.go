@@ -0,0 +1,95 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/action/protocol/staking"
+	"github.com/iotexproject/iotex-core/endorsement"
+)
+
+type (
+	// EvidenceHandler handles the double-sign evidence found in the received consensus messages
+	EvidenceHandler func(*staking.DoubleSignEvidence) error
+
+	voteKey struct {
+		height    uint64
+		topic     ConsensusVoteTopic
+		timestamp int64
+		endorser  string
+	}
+
+	reportKey struct {
+		height   uint64
+		endorser string
+	}
+
+	// doubleSignDetector keeps the first vote of each delegate on a topic of a round,
+	// and reports the evidence once a conflicting vote is received
+	doubleSignDetector struct {
+		mutex    sync.Mutex
+		votes    map[voteKey]*iotextypes.ConsensusMessage
+		reported map[reportKey]struct{}
+	}
+)
+
+func newDoubleSignDetector() *doubleSignDetector {
+	return &doubleSignDetector{
+		votes:    map[voteKey]*iotextypes.ConsensusMessage{},
+		reported: map[reportKey]struct{}{},
+	}
+}
+
+// Detect records the verified vote, and returns the evidence if it conflicts with a recorded one.
+// Votes below minHeight are pruned.
+func (d *doubleSignDetector) Detect(
+	minHeight uint64,
+	msg *iotextypes.ConsensusMessage,
+	vote *ConsensusVote,
+	en *endorsement.Endorsement,
+) *staking.DoubleSignEvidence {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.prune(minHeight)
+	endorser := en.Endorser().HexString()
+	key := voteKey{
+		height:    msg.GetHeight(),
+		topic:     vote.Topic(),
+		timestamp: en.Timestamp().UnixNano(),
+		endorser:  endorser,
+	}
+	prev, ok := d.votes[key]
+	if !ok {
+		d.votes[key] = msg
+		return nil
+	}
+	if bytes.Equal(prev.GetVote().GetBlockHash(), vote.BlockHash()) {
+		return nil
+	}
+	rKey := reportKey{height: msg.GetHeight(), endorser: endorser}
+	if _, ok := d.reported[rKey]; ok {
+		return nil
+	}
+	d.reported[rKey] = struct{}{}
+	return staking.NewDoubleSignEvidence(prev, msg)
+}
+
+func (d *doubleSignDetector) prune(minHeight uint64) {
+	for k := range d.votes {
+		if k.height < minHeight {
+			delete(d.votes, k)
+		}
+	}
+	for k := range d.reported {
+		if k.height < minHeight {
+			delete(d.reported, k)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action/protocol/staking"
+	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestDoubleSignDetector(t *testing.T) {
+	r := require.New(t)
+	ts := time.Unix(1700000000, 0)
+	d := newDoubleSignDetector()
+	detect := func(sk crypto.PrivateKey, height uint64, topic ConsensusVoteTopic, blkHash []byte) *staking.DoubleSignEvidence {
+		vote := NewConsensusVote(blkHash, topic)
+		en, err := endorsement.Endorse(sk, vote, ts)
+		r.NoError(err)
+		msg, err := NewEndorsedConsensusMessage(height, vote, en).Proto()
+		r.NoError(err)
+		return d.Detect(height, msg, vote, en)
+	}
+	sk := identityset.PrivateKey(1)
+	r.Nil(detect(sk, 10, LOCK, []byte("block1")))
+	r.Nil(detect(sk, 10, LOCK, []byte("block1")))
+	r.Nil(detect(sk, 10, COMMIT, []byte("block2")))
+	r.Nil(detect(identityset.PrivateKey(2), 10, LOCK, []byte("block2")))
+	evidence := detect(sk, 10, LOCK, []byte("block2"))
+	r.NotNil(evidence)
+	offender, err := evidence.Offender()
+	r.NoError(err)
+	r.Equal(identityset.Address(1).String(), offender.String())
+	// the offender is reported once per height
+	r.Nil(detect(sk, 10, LOCK, []byte("block3")))
+	r.Nil(detect(sk, 10, COMMIT, []byte("block1")))
+
+	// votes of old heights are pruned
+	r.Nil(detect(sk, 11, LOCK, []byte("block1")))
+	r.Len(d.votes, 1)
+	r.Empty(d.reported)
+}
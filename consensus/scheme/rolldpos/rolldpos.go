@@ -22,6 +22,7 @@ import (
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/consensus/consensusfsm"
 	"github.com/iotexproject/iotex-core/consensus/scheme"
+	"github.com/iotexproject/iotex-core/crypto/bls"
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/endorsement"
 	"github.com/iotexproject/iotex-core/pkg/log"
@@ -146,10 +147,13 @@ func (cm *chainManager) ChainAddress() string {
 
 // RollDPoS is Roll-DPoS consensus main entrance
 type RollDPoS struct {
-	cfsm       *consensusfsm.ConsensusFSM
-	ctx        RDPoSCtx
-	startDelay time.Duration
-	ready      chan interface{}
+	cfsm            *consensusfsm.ConsensusFSM
+	ctx             RDPoSCtx
+	startDelay      time.Duration
+	ready           chan interface{}
+	detector        *doubleSignDetector
+	evidenceHandler EvidenceHandler
+	bls             *blsAggregator
 }
 
 // Start starts RollDPoS consensus
@@ -215,12 +219,22 @@ func (r *RollDPoS) HandleConsensusMsg(msg *iotextypes.ConsensusMessage) error {
 		if err := r.ctx.CheckBlockProposer(endorsedMessage.Height(), consensusMessage, en); err != nil {
 			return errors.Wrap(err, "failed to verify block proposal")
 		}
+		blkHash := consensusMessage.block.HashBlock()
+		r.ctx.Timeline().AddProposal(endorsedMessage.Height(), blkHash[:], en)
 		r.cfsm.ProduceReceiveBlockEvent(endorsedMessage)
 		return nil
 	case *ConsensusVote:
 		if err := r.ctx.CheckVoteEndorser(endorsedMessage.Height(), consensusMessage, en); err != nil {
 			return errors.Wrapf(err, "failed to verify vote")
 		}
+		r.ctx.Timeline().AddEndorsement(endorsedMessage.Height(), consensusMessage.Topic(), consensusMessage.BlockHash(), en)
+		if r.evidenceHandler != nil {
+			if evidence := r.detector.Detect(consensusHeight, msg, consensusMessage, en); evidence != nil {
+				if err := r.evidenceHandler(evidence); err != nil {
+					log.Logger("consensus").Error("failed to handle double-sign evidence", zap.Error(err))
+				}
+			}
+		}
 		switch consensusMessage.Topic() {
 		case PROPOSAL:
 			r.cfsm.ProduceReceiveProposalEndorsementEvent(endorsedMessage)
@@ -266,7 +280,25 @@ func (r *RollDPoS) ValidateBlockFooter(blk *block.Block) error {
 			return err
 		}
 	}
-	if !round.EndorsedByMajority(blkHash[:], []ConsensusVoteTopic{COMMIT}) {
+	aggregated := blk.AggregatedEndorsement()
+	if aggregated == nil {
+		if !round.EndorsedByMajority(blkHash[:], []ConsensusVoteTopic{COMMIT}) {
+			return ErrInsufficientEndorsements
+		}
+		return nil
+	}
+	signers, err := r.bls.verify(height, round.Delegates(), NewConsensusVote(blkHash[:], COMMIT), aggregated)
+	if err != nil {
+		return errors.Wrap(err, "invalid aggregated endorsement")
+	}
+	endorsers := make(map[string]struct{}, len(signers))
+	for _, addr := range signers {
+		endorsers[addr] = struct{}{}
+	}
+	for _, en := range round.Endorsements(blkHash[:], []ConsensusVoteTopic{COMMIT}) {
+		endorsers[en.Endorser().Address().String()] = struct{}{}
+	}
+	if 3*len(endorsers) <= 2*len(round.Delegates()) {
 		return ErrInsufficientEndorsements
 	}
 
@@ -290,6 +322,11 @@ func (r *RollDPoS) Metrics() (scheme.ConsensusMetrics, error) {
 	}, nil
 }
 
+// Timeline returns the consensus timeline of a recent height
+func (r *RollDPoS) Timeline(height uint64) (*scheme.ConsensusTimeline, error) {
+	return r.ctx.Timeline().Timeline(height)
+}
+
 // NumPendingEvts returns the number of pending events
 func (r *RollDPoS) NumPendingEvts() int {
 	return r.cfsm.NumPendingEvents()
@@ -341,6 +378,10 @@ type (
 		rp                   *rolldpos.Protocol
 		delegatesByEpochFunc NodesSelectionByEpochFunc
 		proposersByEpochFunc NodesSelectionByEpochFunc
+		evidenceHandler      EvidenceHandler
+		blsPriKey            *bls.PrivateKey
+		blsPubKeysFunc       BLSPublicKeysFunc
+		numDelegatesFunc     NumDelegatesByEpochFunc
 	}
 )
 
@@ -407,6 +448,30 @@ func (b *Builder) SetProposersByEpochFunc(
 	return b
 }
 
+// SetEvidenceHandler sets the handler of the detected double-sign evidence
+func (b *Builder) SetEvidenceHandler(evidenceHandler EvidenceHandler) *Builder {
+	b.evidenceHandler = evidenceHandler
+	return b
+}
+
+// SetBLSPriKey sets the BLS private key to sign the commit votes
+func (b *Builder) SetBLSPriKey(blsPriKey *bls.PrivateKey) *Builder {
+	b.blsPriKey = blsPriKey
+	return b
+}
+
+// SetBLSPublicKeysFunc sets the function to read the BLS public keys of delegates
+func (b *Builder) SetBLSPublicKeysFunc(blsPubKeysFunc BLSPublicKeysFunc) *Builder {
+	b.blsPubKeysFunc = blsPubKeysFunc
+	return b
+}
+
+// SetNumDelegatesByEpochFunc sets the function to read the number of delegates of an epoch
+func (b *Builder) SetNumDelegatesByEpochFunc(numDelegatesFunc NumDelegatesByEpochFunc) *Builder {
+	b.numDelegatesFunc = numDelegatesFunc
+	return b
+}
+
 // RegisterProtocol sets the rolldpos protocol
 func (b *Builder) RegisterProtocol(rp *rolldpos.Protocol) *Builder {
 	b.rp = rp
@@ -449,10 +514,21 @@ func (b *Builder) Build() (*RollDPoS, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "error when constructing the consensus FSM")
 	}
+	cfsm.SetTransitionHandler(ctx.Timeline().AddTransition)
+	aggregator := &blsAggregator{
+		sk:        b.blsPriKey,
+		pubKeys:   b.blsPubKeysFunc,
+		isEnabled: b.cfg.Genesis.IsToBeEnabled,
+	}
+	ctx.setBLSAggregator(aggregator)
+	ctx.setNumDelegatesByEpochFunc(b.numDelegatesFunc)
 	return &RollDPoS{
-		cfsm:       cfsm,
-		ctx:        ctx,
-		startDelay: b.cfg.Consensus.Delay,
-		ready:      make(chan interface{}),
+		cfsm:            cfsm,
+		ctx:             ctx,
+		startDelay:      b.cfg.Consensus.Delay,
+		ready:           make(chan interface{}),
+		detector:        newDoubleSignDetector(),
+		evidenceHandler: b.evidenceHandler,
+		bls:             aggregator,
 	}, nil
 }
@@ -71,6 +71,8 @@ func init() {
 type (
 	// NodesSelectionByEpochFunc defines a function to select nodes
 	NodesSelectionByEpochFunc func(uint64) ([]string, error)
+	// NumDelegatesByEpochFunc defines a function to get the number of delegates of an epoch
+	NumDelegatesByEpochFunc func(uint64) (uint64, error)
 
 	// RDPoSCtx is the context of RollDPoS
 	RDPoSCtx interface {
@@ -78,9 +80,12 @@ type (
 		Chain() ChainManager
 		BlockDeserializer() *block.Deserializer
 		RoundCalculator() *roundCalculator
+		Timeline() *timelineRecorder
 		Clock() clock.Clock
 		CheckBlockProposer(uint64, *blockProposal, *endorsement.Endorsement) error
 		CheckVoteEndorser(uint64, *ConsensusVote, *endorsement.Endorsement) error
+		setBLSAggregator(*blsAggregator)
+		setNumDelegatesByEpochFunc(NumDelegatesByEpochFunc)
 	}
 
 	rollDPoSCtx struct {
@@ -92,6 +97,9 @@ type (
 		broadcastHandler  scheme.Broadcast
 		roundCalc         *roundCalculator
 		eManagerDB        db.KVStore
+		cState            *consensusState
+		timeline          *timelineRecorder
+		bls               *blsAggregator
 		toleratedOvertime time.Duration
 
 		encodedAddr string
@@ -169,21 +177,39 @@ func NewRollDPoSCtx(
 		clock:             clock,
 		roundCalc:         roundCalc,
 		eManagerDB:        eManagerDB,
+		cState:            newConsensusState(eManagerDB),
+		timeline:          newTimelineRecorder(_timelineSize, clock),
 		toleratedOvertime: toleratedOvertime,
 	}, nil
 }
 
+func (ctx *rollDPoSCtx) setBLSAggregator(a *blsAggregator) {
+	ctx.bls = a
+}
+
+func (ctx *rollDPoSCtx) setNumDelegatesByEpochFunc(f NumDelegatesByEpochFunc) {
+	ctx.roundCalc.numDelegatesByEpochFunc = f
+}
+
 func (ctx *rollDPoSCtx) Start(c context.Context) (err error) {
 	var eManager *endorsementManager
 	if ctx.eManagerDB != nil {
 		if err := ctx.eManagerDB.Start(c); err != nil {
 			return errors.Wrap(err, "Error when starting the collectionDB")
 		}
-		eManager, err = newEndorsementManager(ctx.eManagerDB, ctx.blockDeserializer)
+		if eManager, err = newEndorsementManager(ctx.eManagerDB, ctx.blockDeserializer); err != nil {
+			return err
+		}
+		if err = ctx.cState.Load(); err != nil {
+			return errors.Wrap(err, "failed to load consensus status")
+		}
 	}
 	ctx.round, err = ctx.roundCalc.NewRoundWithToleration(0, ctx.BlockInterval(0), ctx.clock.Now(), eManager, ctx.toleratedOvertime)
-
-	return err
+	if err != nil {
+		return err
+	}
+	ctx.round.cState = ctx.cState
+	return nil
 }
 
 func (ctx *rollDPoSCtx) Stop(c context.Context) error {
@@ -205,6 +231,10 @@ func (ctx *rollDPoSCtx) RoundCalculator() *roundCalculator {
 	return ctx.roundCalc
 }
 
+func (ctx *rollDPoSCtx) Timeline() *timelineRecorder {
+	return ctx.timeline
+}
+
 func (ctx *rollDPoSCtx) Clock() clock.Clock {
 	return ctx.clock
 }
@@ -343,6 +373,7 @@ func (ctx *rollDPoSCtx) Prepare() error {
 		zap.String("roundStartTime", newRound.roundStartTime.String()),
 	)
 	ctx.round = newRound
+	ctx.timeline.AddRound(newRound)
 	_consensusHeightMtc.WithLabelValues().Set(float64(ctx.round.height))
 	_timeSlotMtc.WithLabelValues().Set(float64(ctx.round.roundNum))
 	return nil
@@ -505,7 +536,9 @@ func (ctx *rollDPoSCtx) Commit(msg interface{}) (bool, error) {
 	if ctx.round.Height()%100 == 0 {
 		ctx.logger().Info("consensus reached", zap.Uint64("blockHeight", ctx.round.Height()))
 	}
-	if err := pendingBlock.Finalize(
+	if err := ctx.bls.finalize(
+		pendingBlock,
+		ctx.round.Delegates(),
 		ctx.round.Endorsements(blkHash, []ConsensusVoteTopic{COMMIT}),
 		ctx.round.StartTime().Add(
 			ctx.AcceptBlockTTL(ctx.round.height)+ctx.AcceptProposalEndorsementTTL(ctx.round.height)+ctx.AcceptLockEndorsementTTL(ctx.round.height),
@@ -652,6 +685,10 @@ func (ctx *rollDPoSCtx) isDelegate() bool {
 }
 
 func (ctx *rollDPoSCtx) endorseBlockProposal(proposal *blockProposal) (*EndorsedConsensusMessage, error) {
+	blkHash := proposal.block.HashBlock()
+	if err := ctx.cState.SignProposal(proposal.block.Height(), ctx.round.Number(), blkHash[:]); err != nil {
+		return nil, err
+	}
 	en, err := endorsement.Endorse(ctx.priKey, proposal, ctx.round.StartTime())
 	if err != nil {
 		return nil, err
@@ -742,7 +779,18 @@ func (ctx *rollDPoSCtx) newEndorsement(
 		blkHash,
 		topic,
 	)
-	en, err := endorsement.Endorse(ctx.priKey, vote, timestamp)
+	if err := ctx.cState.SignVote(ctx.round.Height(), ctx.round.Number(), topic, blkHash); err != nil {
+		return nil, err
+	}
+	var (
+		en  *endorsement.Endorsement
+		err error
+	)
+	if topic == COMMIT {
+		en, err = ctx.bls.endorseCommit(ctx.round.Height(), ctx.priKey, vote, timestamp)
+	} else {
+		en, err = endorsement.Endorse(ctx.priKey, vote, timestamp)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -9,9 +9,11 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 
 	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
 	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/pkg/log"
 )
 
 var errInvalidCurrentTime = errors.New("invalid current time")
@@ -23,6 +25,9 @@ type roundCalculator struct {
 	delegatesByEpochFunc NodesSelectionByEpochFunc
 	proposersByEpochFunc NodesSelectionByEpochFunc
 	beringHeight         uint64
+	// numDelegatesByEpochFunc reads the number of delegates approved by governance,
+	// the number in genesis is used if it is nil
+	numDelegatesByEpochFunc NumDelegatesByEpochFunc
 }
 
 // UpdateRound updates previous roundCtx
@@ -63,7 +68,8 @@ func (c *roundCalculator) UpdateRound(round *roundCtx, height uint64, blockInter
 	var status status
 	var blockInLock []byte
 	var proofOfLock []*endorsement.Endorsement
-	if height == round.Height() {
+	switch {
+	case height == round.Height():
 		err = round.eManager.Cleanup(roundStartTime)
 		if err != nil {
 			return nil, err
@@ -71,7 +77,18 @@ func (c *roundCalculator) UpdateRound(round *roundCtx, height uint64, blockInter
 		status = round.status
 		blockInLock = round.blockInLock
 		proofOfLock = round.proofOfLock
-	} else {
+	case round.Height() == 0 && round.cState != nil && round.cState.InProgress(height):
+		// recover the lock and the endorsements of the height after a restart
+		err = round.eManager.Cleanup(roundStartTime)
+		if err != nil {
+			return nil, err
+		}
+		status, blockInLock, proofOfLock = round.cState.Lock(height)
+		if status == _locked && round.block(blockInLock) == nil {
+			log.L().Warn("locked block is not found", zap.Uint64("height", height), log.Hex("block", blockInLock))
+			status, blockInLock, proofOfLock = _open, nil, nil
+		}
+	default:
 		err = round.eManager.Cleanup(time.Time{})
 		if err != nil {
 			return nil, err
@@ -90,6 +107,7 @@ func (c *roundCalculator) UpdateRound(round *roundCtx, height uint64, blockInter
 		roundStartTime:     roundStartTime,
 		nextRoundStartTime: roundStartTime.Add(blockInterval),
 		eManager:           round.eManager,
+		cState:             round.cState,
 		status:             status,
 		blockInLock:        blockInLock,
 		proofOfLock:        proofOfLock,
@@ -270,9 +288,14 @@ func (c *roundCalculator) calculateProposer(
 	round uint32,
 	proposers []string,
 ) (proposer string, err error) {
-	// TODO use number of proposers
 	numProposers := c.rp.NumDelegates()
-	if numProposers != uint64(len(proposers)) {
+	if c.numDelegatesByEpochFunc != nil {
+		if numProposers, err = c.numDelegatesByEpochFunc(c.rp.GetEpochNum(height)); err != nil {
+			return
+		}
+	}
+	// the proposers are fewer than the number of delegates if there are not enough candidates
+	if len(proposers) == 0 || uint64(len(proposers)) > numProposers {
 		err = errors.New("invalid proposer list")
 		return
 	}
@@ -280,6 +303,6 @@ func (c *roundCalculator) calculateProposer(
 	if c.timeBasedRotation {
 		idx += uint64(round)
 	}
-	proposer = proposers[idx%numProposers]
+	proposer = proposers[idx%uint64(len(proposers))]
 	return
 }
@@ -57,18 +57,59 @@ func TestUpdateRound(t *testing.T) {
 	ra, err = rc.UpdateRound(ra, 51, time.Second, time.Unix(1562382522, 0), time.Second)
 	require.NoError(err)
 	require.Equal(identityset.Address(10).String(), ra.proposer)
+
+	// recover the lock after a restart
+	blk := getBlock(t)
+	blkHash := blk.HashBlock()
+	cState := newConsensusState(nil)
+	require.NoError(cState.SetLock(51, _locked, blkHash[:], nil))
+	for _, c := range []struct {
+		withBlock bool
+		status    status
+	}{
+		{false, _open},
+		{true, _locked},
+	} {
+		ra, err = rc.NewRound(0, time.Second, time.Unix(1562382522, 0), nil)
+		require.NoError(err)
+		ra.cState = cState
+		if c.withBlock {
+			require.NoError(ra.AddBlock(&blk))
+		}
+		ra, err = rc.UpdateRound(ra, 51, time.Second, time.Unix(1562382522, 0), time.Second)
+		require.NoError(err)
+		require.Equal(c.status, ra.status)
+		require.Equal(cState, ra.cState)
+	}
+	require.Equal(blkHash[:], ra.HashOfBlockInLock())
+	require.NotNil(ra.Block(blkHash[:]))
 }
 
 func TestNewRound(t *testing.T) {
 	require := require.New(t)
 	rc := makeRoundCalculator(t)
-	_, err := rc.calculateProposer(5, 1, []string{"1", "2", "3", "4", "5"})
+	_, err := rc.calculateProposer(5, 1, nil)
 	require.Error(err)
 	var validDelegates [24]string
 	for i := 0; i < 24; i++ {
 		validDelegates[i] = identityset.Address(i).String()
 	}
-	proposer, err := rc.calculateProposer(5, 1, validDelegates[:])
+	_, err = rc.calculateProposer(5, 1, append(validDelegates[:], "25"))
+	require.Error(err)
+	// the number of delegates is changed by governance
+	rc.numDelegatesByEpochFunc = func(uint64) (uint64, error) { return 5, nil }
+	proposer, err := rc.calculateProposer(5, 1, []string{"1", "2", "3", "4", "5"})
+	require.NoError(err)
+	require.Equal("2", proposer)
+	_, err = rc.calculateProposer(5, 1, []string{"1", "2", "3", "4", "5", "6"})
+	require.Error(err)
+	// the governed number of delegates exceeds the number of candidates
+	rc.numDelegatesByEpochFunc = func(uint64) (uint64, error) { return 30, nil }
+	proposer, err = rc.calculateProposer(5, 1, []string{"1", "2", "3", "4"})
+	require.NoError(err)
+	require.Equal("3", proposer)
+	rc.numDelegatesByEpochFunc = nil
+	proposer, err = rc.calculateProposer(5, 1, validDelegates[:])
 	require.NoError(err)
 	require.Equal(validDelegates[6], proposer)
 
@@ -271,5 +312,6 @@ func makeRoundCalculator(t *testing.T) *roundCalculator {
 		delegatesByEpoch,
 		delegatesByEpoch,
 		0,
+		nil,
 	}
 }
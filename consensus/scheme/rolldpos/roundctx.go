@@ -44,6 +44,7 @@ type roundCtx struct {
 	proofOfLock []*endorsement.Endorsement
 	status      status
 	eManager    *endorsementManager
+	cState      *consensusState
 }
 
 func (ctx *roundCtx) Log(l *zap.Logger) *zap.Logger {
@@ -225,12 +226,18 @@ func (ctx *roundCtx) AddVoteEndorsement(
 	if !ctx.isMajority(endorsements) {
 		return nil
 	}
+	lockStatus := _locked
 	if len(blockHash) == 0 {
 		// TODO: (zhi) look into details of unlock
-		ctx.status = _unlocked
-	} else {
-		ctx.status = _locked
+		lockStatus = _unlocked
 	}
+	if ctx.cState != nil {
+		// persist the lock before acting on it
+		if err := ctx.cState.SetLock(ctx.height, lockStatus, blockHash, endorsements); err != nil {
+			return err
+		}
+	}
+	ctx.status = lockStatus
 	ctx.blockInLock = blockHash
 	ctx.proofOfLock = endorsements
 
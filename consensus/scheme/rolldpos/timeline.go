@@ -0,0 +1,178 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"encoding/hex"
+	"sync"
+
+	"github.com/facebookgo/clock"
+	"github.com/iotexproject/go-fsm"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/consensus/consensusfsm"
+	"github.com/iotexproject/iotex-core/consensus/scheme"
+	"github.com/iotexproject/iotex-core/endorsement"
+)
+
+const (
+	// _timelineSize is the number of recent heights whose timelines are kept
+	_timelineSize = 720
+	// _maxEndorsementsPerRound is the number of endorsements kept for a round,
+	// which is enough for the votes of 24 delegates on 3 topics with replays
+	_maxEndorsementsPerRound = 128
+	// _maxProposalsPerRound is the number of block proposals kept for a round
+	_maxProposalsPerRound = 16
+	// _maxRoundsPerHeight is the number of rounds kept for a height, the record of
+	// the last one is replaced by the latest round once it is reached
+	_maxRoundsPerHeight = 64
+	// _maxTransitionsPerHeight is the number of fsm transitions kept for a height
+	_maxTransitionsPerHeight = 1024
+)
+
+// timelineRecorder keeps the consensus timelines of recent heights in a ring buffer
+type timelineRecorder struct {
+	mutex     sync.RWMutex
+	clock     clock.Clock
+	timelines []*scheme.ConsensusTimeline
+}
+
+func newTimelineRecorder(size int, clock clock.Clock) *timelineRecorder {
+	return &timelineRecorder{
+		clock:     clock,
+		timelines: make([]*scheme.ConsensusTimeline, size),
+	}
+}
+
+// Timeline returns a copy of the timeline of the height
+func (tr *timelineRecorder) Timeline(height uint64) (*scheme.ConsensusTimeline, error) {
+	tr.mutex.RLock()
+	defer tr.mutex.RUnlock()
+	t := tr.timelines[height%uint64(len(tr.timelines))]
+	if t == nil || t.Height != height {
+		return nil, errors.Wrapf(scheme.ErrTimelineNotFound, "height %d", height)
+	}
+	return &scheme.ConsensusTimeline{
+		Height:       t.Height,
+		Rounds:       append([]*scheme.RoundRecord{}, t.Rounds...),
+		Proposals:    append([]*scheme.ConsensusMessageRecord{}, t.Proposals...),
+		Endorsements: append([]*scheme.ConsensusMessageRecord{}, t.Endorsements...),
+		Transitions:  append([]*scheme.StateTransition{}, t.Transitions...),
+		FinalState:   t.FinalState,
+	}, nil
+}
+
+// AddRound records the output of the round calculator
+func (tr *timelineRecorder) AddRound(round *roundCtx) {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+	t := tr.timeline(round.Height())
+	if t == nil {
+		return
+	}
+	record := &scheme.RoundRecord{
+		Round:              round.Number(),
+		EpochNum:           round.EpochNum(),
+		EpochStartHeight:   round.EpochStartHeight(),
+		StartTime:          round.StartTime(),
+		NextRoundStartTime: round.NextRoundStartTime(),
+		Proposer:           round.Proposer(),
+		Delegates:          round.Delegates(),
+	}
+	if n := len(t.Rounds); n > 0 && (t.Rounds[n-1].Round == record.Round || n >= _maxRoundsPerHeight) {
+		t.Rounds[n-1] = record
+		return
+	}
+	t.Rounds = append(t.Rounds, record)
+}
+
+// AddProposal records a received block proposal
+func (tr *timelineRecorder) AddProposal(height uint64, blkHash []byte, en *endorsement.Endorsement) {
+	tr.addMessage(height, "", blkHash, en, true)
+}
+
+// AddEndorsement records a received consensus vote
+func (tr *timelineRecorder) AddEndorsement(height uint64, topic ConsensusVoteTopic, blkHash []byte, en *endorsement.Endorsement) {
+	tr.addMessage(height, iotextypes.ConsensusVote_Topic(topic).String(), blkHash, en, false)
+}
+
+// AddTransition records a transition of the consensus fsm
+func (tr *timelineRecorder) AddTransition(evt *consensusfsm.ConsensusEvent, src, dst fsm.State) {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+	t := tr.timeline(evt.Height())
+	if t == nil {
+		return
+	}
+	t.FinalState = string(dst)
+	if len(t.Transitions) >= _maxTransitionsPerHeight {
+		return
+	}
+	t.Transitions = append(t.Transitions, &scheme.StateTransition{
+		Event: string(evt.Type()),
+		From:  string(src),
+		To:    string(dst),
+		Time:  tr.clock.Now(),
+	})
+}
+
+func (tr *timelineRecorder) addMessage(height uint64, topic string, blkHash []byte, en *endorsement.Endorsement, isProposal bool) {
+	record := &scheme.ConsensusMessageRecord{
+		Topic:      topic,
+		BlockHash:  hex.EncodeToString(blkHash),
+		EndorsedAt: en.Timestamp(),
+		ReceivedAt: tr.clock.Now(),
+	}
+	if addr := en.Endorser().Address(); addr != nil {
+		record.Endorser = addr.String()
+	}
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+	t := tr.timeline(height)
+	if t == nil {
+		return
+	}
+	if n := len(t.Rounds); n > 0 {
+		record.Round = t.Rounds[n-1].Round
+	}
+	if isProposal {
+		if countOfRound(t.Proposals, record.Round) < _maxProposalsPerRound {
+			t.Proposals = append(t.Proposals, record)
+		}
+		return
+	}
+	if countOfRound(t.Endorsements, record.Round) < _maxEndorsementsPerRound {
+		t.Endorsements = append(t.Endorsements, record)
+	}
+}
+
+// countOfRound counts the records of the round, the records are appended in the order of rounds
+func countOfRound(records []*scheme.ConsensusMessageRecord, round uint32) int {
+	count := 0
+	for i := len(records) - 1; i >= 0 && records[i].Round == round; i-- {
+		count++
+	}
+	return count
+}
+
+// timeline returns the timeline of the height, the slot of an older height is reused.
+// It returns nil if the slot has been taken by a newer height.
+func (tr *timelineRecorder) timeline(height uint64) *scheme.ConsensusTimeline {
+	if height == 0 {
+		return nil
+	}
+	idx := height % uint64(len(tr.timelines))
+	t := tr.timelines[idx]
+	switch {
+	case t == nil || t.Height < height:
+		t = &scheme.ConsensusTimeline{Height: height}
+		tr.timelines[idx] = t
+	case t.Height > height:
+		return nil
+	}
+	return t
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/consensus/consensusfsm"
+	"github.com/iotexproject/iotex-core/consensus/scheme"
+	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestTimelineRecorder(t *testing.T) {
+	r := require.New(t)
+	c := clock.NewMock()
+	c.Add(time.Unix(1700000000, 0).Sub(c.Now()))
+	tr := newTimelineRecorder(4, c)
+
+	_, err := tr.Timeline(5)
+	r.ErrorIs(err, scheme.ErrTimelineNotFound)
+	round := &roundCtx{
+		height:    5,
+		roundNum:  0,
+		epochNum:  1,
+		proposer:  identityset.Address(1).String(),
+		delegates: []string{identityset.Address(1).String(), identityset.Address(2).String()},
+	}
+	tr.AddRound(round)
+	tr.AddRound(round)
+	round.roundNum = 1
+	round.proposer = identityset.Address(2).String()
+	tr.AddRound(round)
+	en := endorsement.NewEndorsement(c.Now(), identityset.PrivateKey(2).PublicKey(), []byte("sig"))
+	c.Add(time.Second)
+	tr.AddProposal(5, []byte{1, 2}, en)
+	tr.AddEndorsement(5, LOCK, []byte{1, 2}, en)
+	tr.AddTransition(consensusfsm.NewConsensusEvent("E_RECEIVE_BLOCK", nil, 5, 1, c.Now()), "S_ACCEPT_BLOCK_PROPOSAL", "S_ACCEPT_PROPOSAL_ENDORSEMENT")
+
+	timeline, err := tr.Timeline(5)
+	r.NoError(err)
+	r.Equal(uint64(5), timeline.Height)
+	r.Len(timeline.Rounds, 2)
+	r.Equal(uint32(1), timeline.Rounds[1].Round)
+	r.Equal(identityset.Address(2).String(), timeline.Rounds[1].Proposer)
+	r.Len(timeline.Proposals, 1)
+	proposal := timeline.Proposals[0]
+	r.Equal("0102", proposal.BlockHash)
+	r.Equal(identityset.Address(2).String(), proposal.Endorser)
+	r.True(proposal.EndorsedAt.Equal(time.Unix(1700000000, 0)))
+	r.True(proposal.ReceivedAt.Equal(time.Unix(1700000001, 0)))
+	r.Len(timeline.Endorsements, 1)
+	r.Equal("LOCK", timeline.Endorsements[0].Topic)
+	r.Equal(uint32(1), timeline.Endorsements[0].Round)
+	r.Len(timeline.Transitions, 1)
+	r.Equal("S_ACCEPT_PROPOSAL_ENDORSEMENT", timeline.FinalState)
+	// the returned timeline is a copy
+	timeline.Rounds = nil
+	timeline, err = tr.Timeline(5)
+	r.NoError(err)
+	r.Len(timeline.Rounds, 2)
+
+	// the slot is reused by a newer height, and older heights are dropped
+	tr.AddProposal(9, []byte{3}, en)
+	_, err = tr.Timeline(5)
+	r.ErrorIs(err, scheme.ErrTimelineNotFound)
+	tr.AddProposal(5, []byte{3}, en)
+	_, err = tr.Timeline(5)
+	r.ErrorIs(err, scheme.ErrTimelineNotFound)
+	timeline, err = tr.Timeline(9)
+	r.NoError(err)
+	r.Len(timeline.Proposals, 1)
+}
+
+func TestTimelineRecorderCap(t *testing.T) {
+	r := require.New(t)
+	c := clock.NewMock()
+	tr := newTimelineRecorder(4, c)
+	round := &roundCtx{height: 5}
+	tr.AddRound(round)
+	en := endorsement.NewEndorsement(c.Now(), identityset.PrivateKey(2).PublicKey(), []byte("sig"))
+	for i := 0; i < _maxEndorsementsPerRound+10; i++ {
+		tr.AddEndorsement(5, COMMIT, []byte{1}, en)
+	}
+	timeline, err := tr.Timeline(5)
+	r.NoError(err)
+	r.Len(timeline.Endorsements, _maxEndorsementsPerRound)
+
+	// the cap applies to each round
+	round.roundNum = 1
+	tr.AddRound(round)
+	tr.AddEndorsement(5, COMMIT, []byte{1}, en)
+	timeline, err = tr.Timeline(5)
+	r.NoError(err)
+	r.Len(timeline.Endorsements, _maxEndorsementsPerRound+1)
+	r.Equal(uint32(1), timeline.Endorsements[_maxEndorsementsPerRound].Round)
+
+	// proposals are capped for each round as well
+	for i := 0; i < _maxProposalsPerRound+10; i++ {
+		tr.AddProposal(5, []byte{1}, en)
+	}
+	timeline, err = tr.Timeline(5)
+	r.NoError(err)
+	r.Len(timeline.Proposals, _maxProposalsPerRound)
+
+	// the rounds beyond the cap replace the last record, so that the latest round is kept
+	for i := 2; i < _maxRoundsPerHeight+10; i++ {
+		round.roundNum = uint32(i)
+		tr.AddRound(round)
+	}
+	timeline, err = tr.Timeline(5)
+	r.NoError(err)
+	r.Len(timeline.Rounds, _maxRoundsPerHeight)
+	r.Equal(uint32(_maxRoundsPerHeight+9), timeline.Rounds[_maxRoundsPerHeight-1].Round)
+	r.Equal(uint32(_maxRoundsPerHeight-2), timeline.Rounds[_maxRoundsPerHeight-2].Round)
+
+	// the transitions are capped, while the final state is still updated
+	for i := 0; i < _maxTransitionsPerHeight+10; i++ {
+		tr.AddTransition(consensusfsm.NewConsensusEvent("E_RECEIVE_BLOCK", nil, 5, 1, c.Now()), "S_ACCEPT_BLOCK_PROPOSAL", "S_ACCEPT_PROPOSAL_ENDORSEMENT")
+	}
+	tr.AddTransition(consensusfsm.NewConsensusEvent("E_PREPARE", nil, 5, 1, c.Now()), "S_ACCEPT_PROPOSAL_ENDORSEMENT", "S_PREPARE")
+	timeline, err = tr.Timeline(5)
+	r.NoError(err)
+	r.Len(timeline.Transitions, _maxTransitionsPerHeight)
+	r.Equal("S_PREPARE", timeline.FinalState)
+}
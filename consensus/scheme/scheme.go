@@ -22,6 +22,9 @@ type TellPeerCB func(proto.Message) error
 // ConsensusDoneCB defines the callback when consensus is reached
 type ConsensusDoneCB func(*block.Block) error
 
+// RewindCB defines the callback to rewind the chain to a height
+type RewindCB func(uint64) error
+
 // BroadcastCB defines the callback to publish the consensus result
 type BroadcastCB func(*block.Block) error
 
@@ -14,6 +14,7 @@ import (
 
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/routine"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
@@ -21,7 +22,7 @@ import (
 
 // Standalone is the consensus scheme that periodically create blocks
 type Standalone struct {
-	task *routine.RecurringTask
+	task lifecycle.StartStopper
 }
 
 type standaloneHandler struct {
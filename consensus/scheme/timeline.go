@@ -0,0 +1,56 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package scheme
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrTimelineNotFound indicates the timeline of the height is not recorded
+var ErrTimelineNotFound = errors.New("consensus timeline not found")
+
+type (
+	// ConsensusTimeline records how the consensus went at a height
+	ConsensusTimeline struct {
+		Height       uint64                    `json:"height"`
+		Rounds       []*RoundRecord            `json:"rounds"`
+		Proposals    []*ConsensusMessageRecord `json:"proposals"`
+		Endorsements []*ConsensusMessageRecord `json:"endorsements"`
+		Transitions  []*StateTransition        `json:"transitions"`
+		FinalState   string                    `json:"finalState"`
+	}
+
+	// RoundRecord is the output of the round calculator
+	RoundRecord struct {
+		Round              uint32    `json:"round"`
+		EpochNum           uint64    `json:"epochNum"`
+		EpochStartHeight   uint64    `json:"epochStartHeight"`
+		StartTime          time.Time `json:"startTime"`
+		NextRoundStartTime time.Time `json:"nextRoundStartTime"`
+		Proposer           string    `json:"proposer"`
+		Delegates          []string  `json:"delegates"`
+	}
+
+	// ConsensusMessageRecord is a received block proposal or endorsement
+	ConsensusMessageRecord struct {
+		Topic      string    `json:"topic,omitempty"`
+		BlockHash  string    `json:"blockHash"`
+		Endorser   string    `json:"endorser"`
+		EndorsedAt time.Time `json:"endorsedAt"`
+		ReceivedAt time.Time `json:"receivedAt"`
+		Round      uint32    `json:"round"`
+	}
+
+	// StateTransition is a transition of the consensus fsm
+	StateTransition struct {
+		Event string    `json:"event"`
+		From  string    `json:"from"`
+		To    string    `json:"to"`
+		Time  time.Time `json:"time"`
+	}
+)
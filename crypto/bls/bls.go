@@ -0,0 +1,233 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+// Package bls implements BLS signatures over BLS12-381, with public keys in G1 and signatures in G2,
+// following the proof-of-possession scheme of the IETF BLS signature draft.
+package bls
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/pkg/errors"
+)
+
+const (
+	// PublicKeySize is the size of a compressed public key
+	PublicKeySize = bls12381.SizeOfG1AffineCompressed
+	// SignatureSize is the size of a compressed signature
+	SignatureSize = bls12381.SizeOfG2AffineCompressed
+	// PrivateKeySize is the size of a private key
+	PrivateKeySize = fr.Bytes
+)
+
+var (
+	_signatureDST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+	_popDST       = []byte("BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+	// ErrInvalidKey indicates the key is not a valid BLS key
+	ErrInvalidKey = errors.New("invalid BLS key")
+	// ErrInvalidSignature indicates the signature is not a valid BLS signature
+	ErrInvalidSignature = errors.New("invalid BLS signature")
+)
+
+type (
+	// PrivateKey is a BLS private key
+	PrivateKey struct {
+		sk *big.Int
+		pk *PublicKey
+	}
+
+	// PublicKey is a BLS public key
+	PublicKey struct {
+		p bls12381.G1Affine
+	}
+)
+
+// GenerateKey generates a random private key
+func GenerateKey() (*PrivateKey, error) {
+	for {
+		var e fr.Element
+		if _, err := e.SetRandom(); err != nil {
+			return nil, err
+		}
+		if !e.IsZero() {
+			return newPrivateKey(e.BigInt(new(big.Int))), nil
+		}
+	}
+}
+
+// PrivateKeyFromBytes loads a private key from bytes
+func PrivateKeyFromBytes(b []byte) (*PrivateKey, error) {
+	if len(b) != PrivateKeySize {
+		return nil, errors.Wrapf(ErrInvalidKey, "private key size %d", len(b))
+	}
+	sk := new(big.Int).SetBytes(b)
+	if sk.Sign() == 0 || sk.Cmp(fr.Modulus()) >= 0 {
+		return nil, errors.Wrap(ErrInvalidKey, "private key out of range")
+	}
+	return newPrivateKey(sk), nil
+}
+
+// HexStringToPrivateKey loads a private key from a hex string
+func HexStringToPrivateKey(s string) (*PrivateKey, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return PrivateKeyFromBytes(b)
+}
+
+func newPrivateKey(sk *big.Int) *PrivateKey {
+	pk := &PublicKey{}
+	pk.p.ScalarMultiplicationBase(sk)
+	return &PrivateKey{sk: sk, pk: pk}
+}
+
+// Bytes returns the bytes of the private key
+func (k *PrivateKey) Bytes() []byte {
+	b := make([]byte, PrivateKeySize)
+	return k.sk.FillBytes(b)
+}
+
+// HexString returns the private key in hex
+func (k *PrivateKey) HexString() string {
+	return hex.EncodeToString(k.Bytes())
+}
+
+// PublicKey returns the public key
+func (k *PrivateKey) PublicKey() *PublicKey {
+	return k.pk
+}
+
+// Sign signs the message
+func (k *PrivateKey) Sign(msg []byte) ([]byte, error) {
+	return k.sign(msg, _signatureDST)
+}
+
+// ProvePossession signs the public key, proving the possession of the private key
+func (k *PrivateKey) ProvePossession() ([]byte, error) {
+	return k.sign(k.pk.Bytes(), _popDST)
+}
+
+func (k *PrivateKey) sign(msg, dst []byte) ([]byte, error) {
+	h, err := bls12381.HashToG2(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	var sig bls12381.G2Affine
+	sig.ScalarMultiplication(&h, k.sk)
+	b := sig.Bytes()
+	return b[:], nil
+}
+
+// PublicKeyFromBytes loads a public key from its compressed form
+func PublicKeyFromBytes(b []byte) (*PublicKey, error) {
+	if len(b) != PublicKeySize {
+		return nil, errors.Wrapf(ErrInvalidKey, "public key size %d", len(b))
+	}
+	pk := &PublicKey{}
+	if _, err := pk.p.SetBytes(b); err != nil {
+		return nil, errors.Wrap(ErrInvalidKey, err.Error())
+	}
+	if pk.p.IsInfinity() {
+		return nil, errors.Wrap(ErrInvalidKey, "public key is the identity")
+	}
+	return pk, nil
+}
+
+// Bytes returns the compressed public key
+func (pk *PublicKey) Bytes() []byte {
+	b := pk.p.Bytes()
+	return b[:]
+}
+
+// HexString returns the compressed public key in hex
+func (pk *PublicKey) HexString() string {
+	return hex.EncodeToString(pk.Bytes())
+}
+
+// Verify verifies the signature of the message
+func (pk *PublicKey) Verify(msg, sig []byte) bool {
+	return verify(&pk.p, msg, sig, _signatureDST)
+}
+
+// VerifyPossession verifies the proof of possession of the public key
+func (pk *PublicKey) VerifyPossession(proof []byte) bool {
+	return verify(&pk.p, pk.Bytes(), proof, _popDST)
+}
+
+// AggregateSignatures aggregates the signatures into one
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.Wrap(ErrInvalidSignature, "no signature to aggregate")
+	}
+	var agg bls12381.G2Jac
+	for _, b := range sigs {
+		sig, err := signatureFromBytes(b)
+		if err != nil {
+			return nil, err
+		}
+		agg.AddMixed(sig)
+	}
+	var sig bls12381.G2Affine
+	sig.FromJacobian(&agg)
+	b := sig.Bytes()
+	return b[:], nil
+}
+
+// AggregatePublicKeys aggregates the public keys into one
+func AggregatePublicKeys(pks []*PublicKey) (*PublicKey, error) {
+	if len(pks) == 0 {
+		return nil, errors.Wrap(ErrInvalidKey, "no public key to aggregate")
+	}
+	var agg bls12381.G1Jac
+	for _, pk := range pks {
+		agg.AddMixed(&pk.p)
+	}
+	pk := &PublicKey{}
+	pk.p.FromJacobian(&agg)
+	return pk, nil
+}
+
+// FastAggregateVerify verifies the aggregated signature of the same message signed by all the public keys.
+// The public keys must have been checked by VerifyPossession.
+func FastAggregateVerify(pks []*PublicKey, msg, sig []byte) bool {
+	pk, err := AggregatePublicKeys(pks)
+	if err != nil {
+		return false
+	}
+	return pk.Verify(msg, sig)
+}
+
+func signatureFromBytes(b []byte) (*bls12381.G2Affine, error) {
+	if len(b) != SignatureSize {
+		return nil, errors.Wrapf(ErrInvalidSignature, "signature size %d", len(b))
+	}
+	sig := &bls12381.G2Affine{}
+	if _, err := sig.SetBytes(b); err != nil {
+		return nil, errors.Wrap(ErrInvalidSignature, err.Error())
+	}
+	return sig, nil
+}
+
+// verify checks e(pk, H(msg)) == e(g1, sig)
+func verify(pk *bls12381.G1Affine, msg, sigBytes, dst []byte) bool {
+	sig, err := signatureFromBytes(sigBytes)
+	if err != nil {
+		return false
+	}
+	h, err := bls12381.HashToG2(msg, dst)
+	if err != nil {
+		return false
+	}
+	_, _, g1, _ := bls12381.Generators()
+	var negG1 bls12381.G1Affine
+	negG1.Neg(&g1)
+	ok, err := bls12381.PairingCheck([]bls12381.G1Affine{*pk, negG1}, []bls12381.G2Affine{h, *sig})
+	return err == nil && ok
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package bls
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	r := require.New(t)
+	sk, err := GenerateKey()
+	r.NoError(err)
+	sk2, err := PrivateKeyFromBytes(sk.Bytes())
+	r.NoError(err)
+	r.Equal(sk.PublicKey().Bytes(), sk2.PublicKey().Bytes())
+	_, err = PrivateKeyFromBytes(make([]byte, PrivateKeySize))
+	r.ErrorIs(err, ErrInvalidKey)
+
+	msg := []byte("hello")
+	sig, err := sk.Sign(msg)
+	r.NoError(err)
+	r.Len(sig, SignatureSize)
+	pk, err := PublicKeyFromBytes(sk.PublicKey().Bytes())
+	r.NoError(err)
+	r.True(pk.Verify(msg, sig))
+	r.False(pk.Verify([]byte("world"), sig))
+	r.False(pk.Verify(msg, sig[1:]))
+	_, err = PublicKeyFromBytes(sig[:PublicKeySize])
+	r.ErrorIs(err, ErrInvalidKey)
+
+	proof, err := sk.ProvePossession()
+	r.NoError(err)
+	r.True(pk.VerifyPossession(proof))
+	// the proof of possession is not a signature of the public key
+	r.False(pk.Verify(pk.Bytes(), proof))
+}
+
+func TestAggregate(t *testing.T) {
+	r := require.New(t)
+	msg := []byte("block hash")
+	var (
+		pks  []*PublicKey
+		sigs [][]byte
+	)
+	for i := 0; i < 4; i++ {
+		sk, err := GenerateKey()
+		r.NoError(err)
+		sig, err := sk.Sign(msg)
+		r.NoError(err)
+		pks = append(pks, sk.PublicKey())
+		sigs = append(sigs, sig)
+	}
+	agg, err := AggregateSignatures(sigs)
+	r.NoError(err)
+	r.True(FastAggregateVerify(pks, msg, agg))
+	r.False(FastAggregateVerify(pks[1:], msg, agg))
+	r.False(FastAggregateVerify(pks, []byte("other"), agg))
+	r.False(FastAggregateVerify(nil, msg, agg))
+	_, err = AggregateSignatures(nil)
+	r.ErrorIs(err, ErrInvalidSignature)
+}
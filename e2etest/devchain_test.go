@@ -0,0 +1,107 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package e2etest
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/server/itx"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestDevChain(t *testing.T) {
+	require := require.New(t)
+
+	cfg := config.Default
+	dir := t.TempDir()
+	cfg.Chain.TrieDBPatchFile = ""
+	cfg.Chain.TrieDBPath = filepath.Join(dir, "trie.db")
+	cfg.Chain.ChainDBPath = filepath.Join(dir, "chain.db")
+	cfg.Chain.IndexDBPath = filepath.Join(dir, "index.db")
+	cfg.Chain.BloomfilterIndexDBPath = filepath.Join(dir, "bloomfilter.index.db")
+	cfg.Chain.CandidateIndexDBPath = filepath.Join(dir, "candidate.index.db")
+	cfg.Chain.StakingIndexDBPath = filepath.Join(dir, "staking.index.db")
+	cfg.Chain.SGDIndexDBPath = filepath.Join(dir, "sgd.index.db")
+	cfg.Chain.ContractStakingIndexDBPath = filepath.Join(dir, "contractstaking.index.db")
+	cfg.Chain.EnableArchiveMode = true
+	cfg.Chain.EnableTrielessStateDB = false
+	cfg.Chain.EnableAsyncIndexWrite = false
+	cfg.Consensus.Scheme = config.StandaloneScheme
+	cfg.Consensus.Dev.Enabled = true
+	cfg.ActPool.MinGasPriceStr = "0"
+	cfg.Network.Port = testutil.RandomPort()
+	cfg.API.GRPCPort = 0
+	cfg.API.HTTPPort = 0
+	cfg.API.WebSocketPort = 0
+	cfg.Genesis.EnableGravityChainVoting = false
+	require.NoError(config.ValidateDevChain(cfg))
+
+	ctx := context.Background()
+	svr, err := itx.NewServer(cfg)
+	require.NoError(err)
+	require.NoError(svr.Start(ctx))
+	defer func() {
+		require.NoError(svr.Stop(ctx))
+	}()
+	cs := svr.ChainService(cfg.Chain.ID)
+	bc, sf := cs.Blockchain(), cs.StateFactory()
+	dev, err := cs.Consensus().DevChain()
+	require.NoError(err)
+
+	balance := func() *big.Int {
+		acc, err := accountutil.AccountState(genesis.WithGenesisContext(ctx, cfg.Genesis), sf, identityset.Address(1))
+		require.NoError(err)
+		return acc.Balance
+	}
+	transfer := func(nonce uint64) {
+		tsf, err := action.SignedTransfer(identityset.Address(0).String(), identityset.PrivateKey(1), nonce, big.NewInt(1), nil, 100000, big.NewInt(0))
+		require.NoError(err)
+		require.NoError(cs.HandleAction(ctx, tsf.Proto()))
+	}
+	waitHeight := func(height uint64) {
+		require.NoError(testutil.WaitUntil(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+			return bc.TipHeight() == height, nil
+		}))
+	}
+
+	// a block is minted on every action
+	initBalance := balance()
+	snapshot := dev.Snapshot()
+	transfer(1)
+	waitHeight(1)
+	transfer(2)
+	waitHeight(2)
+	require.Equal(new(big.Int).Sub(initBalance, big.NewInt(2)), balance())
+
+	// mint empty blocks with the time moved forward
+	_, err = dev.IncreaseTime(time.Hour)
+	require.NoError(err)
+	require.NoError(dev.Mine(time.Time{}))
+	header, err := bc.BlockHeaderByHeight(3)
+	require.NoError(err)
+	require.True(header.Timestamp().After(time.Now().Add(time.Hour - time.Minute)))
+
+	// revert to the snapshot, and then the chain continues from it
+	ok, err := dev.Revert(snapshot)
+	require.NoError(err)
+	require.True(ok)
+	require.Equal(uint64(0), bc.TipHeight())
+	require.Equal(initBalance, balance())
+	transfer(1)
+	waitHeight(1)
+	require.Equal(new(big.Int).Sub(initBalance, big.NewInt(1)), balance())
+}
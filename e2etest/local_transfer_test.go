@@ -362,12 +362,18 @@ func TestLocalTransfer(t *testing.T) {
 
 	// Start server
 	ctx, stopServer := context.WithCancel(ctx)
+	stopped := make(chan struct{})
 	defer func() {
 		require.NoError(probeSvr.Stop(ctx))
 		stopServer()
+		// wait for the server to stop, so that its ports are free for the following tests
+		<-stopped
 	}()
 
-	go itx.StartServer(ctx, svr, probeSvr, cfg)
+	go func() {
+		itx.StartServer(ctx, svr, probeSvr, cfg)
+		close(stopped)
+	}()
 
 	// target address for grpc connection. Default is "127.0.0.1:14014"
 	grpcAddr := fmt.Sprintf("127.0.0.1:%d", apiPort)
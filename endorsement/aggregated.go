@@ -0,0 +1,141 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package endorsement
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/iotexproject/iotex-core/crypto/bls"
+	"github.com/iotexproject/iotex-core/endorsement/endorsementpb"
+)
+
+// AggregatedEndorsement is the aggregated BLS signature of a document signed by a set of endorsers
+// at the same time. The endorsers are kept as a bitmap over an ordered list known to the verifier.
+type AggregatedEndorsement struct {
+	ts        time.Time
+	signers   []byte
+	signature []byte
+}
+
+// AggregateEndorsements aggregates the BLS signatures of the document endorsed at ts. pubKeys are the
+// BLS public keys of the ordered endorsers list, and the endorsers are indexed by their positions.
+// The endorsements not aggregated, for lacking a valid BLS signature, are returned as well.
+func AggregateEndorsements(
+	doc Document,
+	ens []*Endorsement,
+	endorsers []string,
+	pubKeys []*bls.PublicKey,
+	ts time.Time,
+) (*AggregatedEndorsement, []*Endorsement, error) {
+	if len(endorsers) != len(pubKeys) {
+		return nil, nil, errors.Errorf("%d public keys for %d endorsers", len(pubKeys), len(endorsers))
+	}
+	hash, err := hashDocWithTime(doc, ts)
+	if err != nil {
+		return nil, nil, err
+	}
+	index := make(map[string]int, len(endorsers))
+	for i, addr := range endorsers {
+		index[addr] = i
+	}
+	var (
+		signers = make([]byte, (len(endorsers)+7)/8)
+		sigs    [][]byte
+		rest    []*Endorsement
+	)
+	for _, en := range ens {
+		i, ok := -1, false
+		if addr := en.Endorser().Address(); addr != nil {
+			i, ok = index[addr.String()]
+		}
+		if !ok || pubKeys[i] == nil || len(en.blsSignature) == 0 || !en.ts.Equal(ts) ||
+			signers[i/8]&(1<<(i%8)) != 0 || !pubKeys[i].Verify(hash, en.blsSignature) {
+			rest = append(rest, en)
+			continue
+		}
+		signers[i/8] |= 1 << (i % 8)
+		sigs = append(sigs, en.blsSignature)
+	}
+	if len(sigs) == 0 {
+		return nil, ens, nil
+	}
+	sig, err := bls.AggregateSignatures(sigs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &AggregatedEndorsement{ts: ts.UTC(), signers: signers, signature: sig}, rest, nil
+}
+
+// VerifyAggregatedEndorsement checks the aggregated signature against the document, and returns the
+// indexes of the signers. pubKeys are the BLS public keys of the ordered endorsers list.
+func VerifyAggregatedEndorsement(doc Document, en *AggregatedEndorsement, pubKeys []*bls.PublicKey) ([]int, error) {
+	if len(en.signers) != (len(pubKeys)+7)/8 {
+		return nil, errors.Errorf("signer bitmap size %d mismatches %d endorsers", len(en.signers), len(pubKeys))
+	}
+	var (
+		signers []int
+		pks     []*bls.PublicKey
+	)
+	for i := range pubKeys {
+		if en.signers[i/8]&(1<<(i%8)) == 0 {
+			continue
+		}
+		if pubKeys[i] == nil {
+			return nil, errors.Errorf("endorser %d has no BLS public key", i)
+		}
+		signers = append(signers, i)
+		pks = append(pks, pubKeys[i])
+	}
+	hash, err := hashDocWithTime(doc, en.ts)
+	if err != nil {
+		return nil, err
+	}
+	if !bls.FastAggregateVerify(pks, hash, en.signature) {
+		return nil, errors.New("failed to verify the aggregated signature")
+	}
+	return signers, nil
+}
+
+// Timestamp returns the signature time
+func (en *AggregatedEndorsement) Timestamp() time.Time {
+	return en.ts
+}
+
+// Signers returns the bitmap of the signers
+func (en *AggregatedEndorsement) Signers() []byte {
+	return append([]byte{}, en.signers...)
+}
+
+// Signature returns the aggregated signature
+func (en *AggregatedEndorsement) Signature() []byte {
+	return append([]byte{}, en.signature...)
+}
+
+// Proto converts an aggregated endorsement to protobuf message
+func (en *AggregatedEndorsement) Proto() *endorsementpb.AggregatedEndorsement {
+	return &endorsementpb.AggregatedEndorsement{
+		Timestamp: timestamppb.New(en.ts),
+		Signers:   en.Signers(),
+		Signature: en.Signature(),
+	}
+}
+
+// LoadProto converts a protobuf message to aggregated endorsement
+func (en *AggregatedEndorsement) LoadProto(ePb *endorsementpb.AggregatedEndorsement) error {
+	if err := ePb.Timestamp.CheckValid(); err != nil {
+		return err
+	}
+	if len(ePb.Signature) != bls.SignatureSize {
+		return errors.Wrapf(bls.ErrInvalidSignature, "signature size %d", len(ePb.Signature))
+	}
+	en.ts = ePb.Timestamp.AsTime()
+	en.signers = append([]byte{}, ePb.Signers...)
+	en.signature = append([]byte{}, ePb.Signature...)
+	return nil
+}
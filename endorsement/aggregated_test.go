@@ -0,0 +1,152 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package endorsement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/crypto/bls"
+	"github.com/iotexproject/iotex-core/endorsement/endorsementpb"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+type testDoc []byte
+
+func (d testDoc) Hash() ([]byte, error) { return d, nil }
+
+func newTestEndorsements(t testing.TB, n int, doc Document, ts time.Time) ([]string, []*bls.PublicKey, []*Endorsement) {
+	var (
+		endorsers = make([]string, n)
+		pubKeys   = make([]*bls.PublicKey, n)
+		ens       = make([]*Endorsement, n)
+	)
+	for i := 0; i < n; i++ {
+		sk, err := bls.GenerateKey()
+		require.NoError(t, err)
+		endorsers[i] = identityset.Address(i).String()
+		pubKeys[i] = sk.PublicKey()
+		ens[i], err = EndorseWithBLS(identityset.PrivateKey(i), sk, doc, ts)
+		require.NoError(t, err)
+	}
+	return endorsers, pubKeys, ens
+}
+
+func TestEndorsementWithBLS(t *testing.T) {
+	r := require.New(t)
+	doc := testDoc("block")
+	_, pubKeys, ens := newTestEndorsements(t, 1, doc, time.Unix(1700000000, 0))
+	r.True(VerifyEndorsement(doc, ens[0]))
+	pb, err := ens[0].Proto()
+	r.NoError(err)
+	en := &Endorsement{}
+	r.NoError(en.LoadProto(pb))
+	r.True(VerifyEndorsement(doc, en))
+	r.Equal(ens[0].BLSSignature(), en.BLSSignature())
+	// the BLS signature is a field of endorsementpb.Endorsement
+	b, err := proto.Marshal(pb)
+	r.NoError(err)
+	extPb := &endorsementpb.Endorsement{}
+	r.NoError(proto.Unmarshal(b, extPb))
+	r.Equal(ens[0].Signature(), extPb.Signature)
+	r.Equal(ens[0].BLSSignature(), extPb.BlsSignature)
+	hash, err := hashDocWithTime(doc, en.Timestamp())
+	r.NoError(err)
+	r.True(pubKeys[0].Verify(hash, en.BLSSignature()))
+
+	// endorsement without BLS signature
+	en, err = Endorse(identityset.PrivateKey(1), doc, time.Unix(1700000000, 0))
+	r.NoError(err)
+	pb, err = en.Proto()
+	r.NoError(err)
+	r.NoError(en.LoadProto(pb))
+	r.Nil(en.BLSSignature())
+}
+
+func TestAggregateEndorsements(t *testing.T) {
+	r := require.New(t)
+	doc := testDoc("block")
+	ts := time.Unix(1700000000, 0)
+	endorsers, pubKeys, ens := newTestEndorsements(t, 10, doc, ts)
+	// endorser 3 has no registered key, endorser 5 signed at another time,
+	// and endorser 7 carries an invalid BLS signature
+	pubKeys[3] = nil
+	ens[5], _ = EndorseWithBLS(identityset.PrivateKey(5), mustGenerateKey(t), doc, ts.Add(time.Second))
+	ens[7].blsSignature = ens[6].blsSignature
+
+	aggregated, rest, err := AggregateEndorsements(doc, ens, endorsers, pubKeys, ts)
+	r.NoError(err)
+	r.Len(rest, 3)
+	r.Equal([]byte{0b01010111, 0b11}, aggregated.Signers())
+	signers, err := VerifyAggregatedEndorsement(doc, aggregated, pubKeys)
+	r.NoError(err)
+	r.Equal([]int{0, 1, 2, 4, 6, 8, 9}, signers)
+	_, err = VerifyAggregatedEndorsement(testDoc("other"), aggregated, pubKeys)
+	r.Error(err)
+	_, err = VerifyAggregatedEndorsement(doc, aggregated, pubKeys[:8])
+	r.Error(err)
+
+	b, err := proto.Marshal(aggregated.Proto())
+	r.NoError(err)
+	loaded := &AggregatedEndorsement{}
+	r.NoError(loaded.LoadProto(aggregated.Proto()))
+	r.Equal(aggregated.Signers(), loaded.Signers())
+	_, err = VerifyAggregatedEndorsement(doc, loaded, pubKeys)
+	r.NoError(err)
+	r.Less(len(b), 7*crypto.Secp256k1SigSizeWithRecID)
+
+	// nothing to aggregate
+	aggregated, rest, err = AggregateEndorsements(doc, ens[3:4], endorsers, pubKeys, ts)
+	r.NoError(err)
+	r.Nil(aggregated)
+	r.Len(rest, 1)
+}
+
+func mustGenerateKey(t testing.TB) *bls.PrivateKey {
+	sk, err := bls.GenerateKey()
+	require.NoError(t, err)
+	return sk
+}
+
+func BenchmarkVerifyEndorsements(b *testing.B) {
+	const n = 24
+	doc := testDoc("block")
+	ts := time.Unix(1700000000, 0)
+	endorsers, pubKeys, ens := newTestEndorsements(b, n, doc, ts)
+	aggregated, _, err := AggregateEndorsements(doc, ens, endorsers, pubKeys, ts)
+	require.NoError(b, err)
+
+	b.Run("secp256k1", func(b *testing.B) {
+		size := 0
+		for _, en := range ens {
+			en.blsSignature = nil
+			pb, err := en.Proto()
+			require.NoError(b, err)
+			size += proto.Size(pb)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, en := range ens {
+				if !VerifyEndorsement(doc, en) {
+					b.Fatal("failed to verify endorsement")
+				}
+			}
+		}
+		b.ReportMetric(float64(size), "footer-bytes")
+	})
+	b.Run("bls-aggregated", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := VerifyAggregatedEndorsement(doc, aggregated, pubKeys); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.ReportMetric(float64(proto.Size(aggregated.Proto())), "footer-bytes")
+	})
+}
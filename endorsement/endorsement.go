@@ -11,8 +11,12 @@ import (
 	"github.com/iotexproject/go-pkgs/crypto"
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/iotexproject/iotex-core/crypto/bls"
+	"github.com/iotexproject/iotex-core/endorsement/endorsementpb"
 	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
 )
 
@@ -27,6 +31,8 @@ type (
 		ts        time.Time
 		endorser  crypto.PublicKey
 		signature []byte
+		// blsSignature is the optional BLS signature of the same hash, which can be aggregated
+		blsSignature []byte
 	}
 
 	// EndorsedDocument is an signed document
@@ -79,6 +85,30 @@ func Endorse(
 	return NewEndorsement(ts, signer.PublicKey(), sig), nil
 }
 
+// EndorseWithBLS endorses a document, and signs it with the BLS key as well
+func EndorseWithBLS(
+	signer crypto.PrivateKey,
+	blsSigner *bls.PrivateKey,
+	doc Document,
+	ts time.Time,
+) (*Endorsement, error) {
+	hash, err := hashDocWithTime(doc, ts)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := signer.Sign(hash)
+	if err != nil {
+		return nil, err
+	}
+	blsSig, err := blsSigner.Sign(hash)
+	if err != nil {
+		return nil, err
+	}
+	en := NewEndorsement(ts, signer.PublicKey(), sig)
+	en.blsSignature = blsSig
+	return en, nil
+}
+
 // VerifyEndorsedDocument checks an endorsed document
 func VerifyEndorsedDocument(endorsedDoc EndorsedDocument) bool {
 	return VerifyEndorsement(endorsedDoc.Document(), endorsedDoc.Endorsement())
@@ -112,14 +142,36 @@ func (en *Endorsement) Signature() []byte {
 	return signature
 }
 
+// BLSSignature returns the BLS signature of this endorsement, nil if not signed with a BLS key
+func (en *Endorsement) BLSSignature() []byte {
+	if len(en.blsSignature) == 0 {
+		return nil
+	}
+	signature := make([]byte, len(en.blsSignature))
+	copy(signature, en.blsSignature)
+
+	return signature
+}
+
 // Proto converts an endorsement to protobuf message
 func (en *Endorsement) Proto() (*iotextypes.Endorsement, error) {
 	ts := timestamppb.New(en.ts)
-	return &iotextypes.Endorsement{
+	ePb := &iotextypes.Endorsement{
 		Timestamp: ts,
 		Endorser:  en.endorser.Bytes(),
 		Signature: en.Signature(),
-	}, nil
+	}
+	if len(en.blsSignature) != 0 {
+		// the BLS signature is a field of endorsementpb.Endorsement, which iotextypes.Endorsement
+		// carries as an unknown field
+		// TODO: set iotextypes.Endorsement.BlsSignature (field 4) directly once iotex-proto defines it
+		ext, err := proto.Marshal(&endorsementpb.Endorsement{BlsSignature: en.blsSignature})
+		if err != nil {
+			return nil, err
+		}
+		ePb.ProtoReflect().SetUnknown(ext)
+	}
+	return ePb, nil
 }
 
 // LoadProto converts a protobuf message to endorsement
@@ -135,6 +187,20 @@ func (en *Endorsement) LoadProto(ePb *iotextypes.Endorsement) (err error) {
 	}
 	en.signature = make([]byte, len(ePb.Signature))
 	copy(en.signature, ePb.Signature)
+	en.blsSignature = nil
+	// TODO: read iotextypes.Endorsement.BlsSignature once iotex-proto defines it
+	if ext := ePb.ProtoReflect().GetUnknown(); len(ext) != 0 {
+		extPb := &endorsementpb.Endorsement{}
+		if err = proto.Unmarshal(ext, extPb); err != nil {
+			return err
+		}
+		if len(extPb.BlsSignature) != 0 {
+			if len(extPb.BlsSignature) != bls.SignatureSize {
+				return errors.Wrapf(bls.ErrInvalidSignature, "signature size %d", len(extPb.BlsSignature))
+			}
+			en.blsSignature = extPb.BlsSignature
+		}
+	}
 
 	return nil
 }
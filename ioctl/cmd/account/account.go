@@ -150,6 +150,10 @@ func PrivateKeyFromSigner(signer, password string) (crypto.PrivateKey, error) {
 		err    error
 	)
 
+	if prvKey, err = externalSignerKey(signer); err != nil || prvKey != nil {
+		return prvKey, err
+	}
+
 	if !IsSignerExist(signer) && !util.AliasIsHdwalletKey(signer) {
 		return nil, fmt.Errorf("invalid address #%s", signer)
 	}
@@ -176,6 +180,38 @@ func PrivateKeyFromSigner(signer, password string) (crypto.PrivateKey, error) {
 	return keyStoreAccountToPrivateKey(signer, password)
 }
 
+// externalSignerKey returns the key held by the configured external signer,
+// or nil if no external signer is configured or it does not manage the signer
+func externalSignerKey(signer string) (crypto.PrivateKey, error) {
+	if config.ReadConfig.ExternalSigner == "" || util.AliasIsHdwalletKey(signer) {
+		return nil, nil
+	}
+	addrString, err := util.Address(signer)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := address.FromString(addrString)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account #%s, addr %s", signer, addrString)
+	}
+	extSigner, err := NewExternalSigner(config.ReadConfig.ExternalSigner)
+	if err != nil {
+		return nil, output.NewError(output.ConfigError, "invalid external signer", err)
+	}
+	exist, err := extSigner.HasAccount(addr)
+	if err != nil {
+		return nil, output.NewError(output.NetworkError, "failed to list accounts of external signer", err)
+	}
+	if !exist {
+		return nil, nil
+	}
+	prvKey, err := extSigner.PrivateKey(addr)
+	if err != nil {
+		return nil, output.NewError(output.CryptoError, "failed to get key from external signer", err)
+	}
+	return prvKey, nil
+}
+
 // GetAccountMeta gets account metadata
 func GetAccountMeta(addr string) (*iotextypes.AccountMeta, error) {
 	conn, err := util.ConnectToEndpoint(config.ReadConfig.SecureConnect && !config.Insecure)
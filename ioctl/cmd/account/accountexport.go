@@ -54,6 +54,9 @@ func accountExport(arg string) error {
 	if err != nil {
 		return output.NewError(output.KeystoreError, "failed to get private key from keystore", err)
 	}
+	if IsExternalKey(prvKey) {
+		return output.NewError(output.KeystoreError, "failed to export private key", ErrExternalKey)
+	}
 	output.PrintResult(prvKey.HexString())
 	prvKey.Zero()
 	return nil
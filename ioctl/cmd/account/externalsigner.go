@@ -0,0 +1,250 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package account
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/iotex-address/address"
+)
+
+const (
+	_unixSignerPrefix = "unix://"
+
+	// external signer JSON-RPC methods, account_list is compatible with clef
+	_methodAccountList = "account_list"
+	_methodPublicKey   = "iotex_publicKey"
+	_methodSignHash    = "iotex_signHash"
+
+	_externalSignerTimeout = 2 * time.Minute
+)
+
+// Errors
+var (
+	// ErrExternalKey indicates the private key is held by an external signer and cannot be read
+	ErrExternalKey = errors.New("private key is held by external signer")
+)
+
+type (
+	// ExternalSigner is a client of an external signer daemon speaking JSON-RPC 2.0
+	// over a Unix socket ("unix:///path/to/signer.ipc") or HTTP ("http://127.0.0.1:8550").
+	// The private keys never leave the signer process.
+	ExternalSigner struct {
+		url    string
+		client *http.Client
+		id     uint64
+	}
+
+	// externalKey implements crypto.PrivateKey by forwarding signing requests to the external signer
+	externalKey struct {
+		signer *ExternalSigner
+		addr   address.Address
+		pubKey crypto.PublicKey
+	}
+
+	jsonRPCRequest struct {
+		JSONRPC string        `json:"jsonrpc"`
+		ID      uint64        `json:"id"`
+		Method  string        `json:"method"`
+		Params  []interface{} `json:"params"`
+	}
+
+	jsonRPCError struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+
+	jsonRPCResponse struct {
+		JSONRPC string          `json:"jsonrpc"`
+		ID      uint64          `json:"id"`
+		Result  json.RawMessage `json:"result,omitempty"`
+		Error   *jsonRPCError   `json:"error,omitempty"`
+	}
+)
+
+// NewExternalSigner creates a client of the external signer at endpoint
+func NewExternalSigner(endpoint string) (*ExternalSigner, error) {
+	switch {
+	case strings.HasPrefix(endpoint, _unixSignerPrefix):
+		path := strings.TrimPrefix(endpoint, _unixSignerPrefix)
+		if path == "" {
+			return nil, errors.Errorf("invalid external signer endpoint %s", endpoint)
+		}
+		return &ExternalSigner{
+			url: "http://unix",
+			client: &http.Client{
+				Timeout: _externalSignerTimeout,
+				Transport: &http.Transport{
+					DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+						var d net.Dialer
+						return d.DialContext(ctx, "unix", path)
+					},
+				},
+			},
+		}, nil
+	case strings.HasPrefix(endpoint, "http://"), strings.HasPrefix(endpoint, "https://"):
+		return &ExternalSigner{
+			url:    endpoint,
+			client: &http.Client{Timeout: _externalSignerTimeout},
+		}, nil
+	default:
+		return nil, errors.Errorf("invalid external signer endpoint %s", endpoint)
+	}
+}
+
+// Accounts returns the accounts managed by the external signer
+func (s *ExternalSigner) Accounts() ([]address.Address, error) {
+	var ethAddrs []string
+	if err := s.call(_methodAccountList, &ethAddrs); err != nil {
+		return nil, err
+	}
+	addrs := make([]address.Address, 0, len(ethAddrs))
+	for _, ethAddr := range ethAddrs {
+		if !common.IsHexAddress(ethAddr) {
+			return nil, errors.Errorf("invalid account %s returned by external signer", ethAddr)
+		}
+		addr, err := address.FromBytes(common.HexToAddress(ethAddr).Bytes())
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+// HasAccount returns true if the account is managed by the external signer
+func (s *ExternalSigner) HasAccount(addr address.Address) (bool, error) {
+	addrs, err := s.Accounts()
+	if err != nil {
+		return false, err
+	}
+	for _, a := range addrs {
+		if bytes.Equal(a.Bytes(), addr.Bytes()) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// PublicKey returns the public key of the account
+func (s *ExternalSigner) PublicKey(addr address.Address) (crypto.PublicKey, error) {
+	var pubKeyHex string
+	if err := s.call(_methodPublicKey, &pubKeyHex, common.BytesToAddress(addr.Bytes()).Hex()); err != nil {
+		return nil, err
+	}
+	pubKey, err := crypto.HexStringToPublicKey(strings.TrimPrefix(pubKeyHex, "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid public key returned by external signer")
+	}
+	if !bytes.Equal(pubKey.Address().Bytes(), addr.Bytes()) {
+		return nil, errors.Errorf("public key returned by external signer does not match account %s", addr.String())
+	}
+	return pubKey, nil
+}
+
+// SignHash signs the hash with the account's key
+func (s *ExternalSigner) SignHash(addr address.Address, h []byte) ([]byte, error) {
+	var sigHex string
+	if err := s.call(_methodSignHash, &sigHex, common.BytesToAddress(addr.Bytes()).Hex(), "0x"+hex.EncodeToString(h)); err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(strings.TrimPrefix(sigHex, "0x"))
+}
+
+// PrivateKey returns a crypto.PrivateKey whose signing is delegated to the external signer
+func (s *ExternalSigner) PrivateKey(addr address.Address) (crypto.PrivateKey, error) {
+	pubKey, err := s.PublicKey(addr)
+	if err != nil {
+		return nil, err
+	}
+	return &externalKey{
+		signer: s,
+		addr:   addr,
+		pubKey: pubKey,
+	}, nil
+}
+
+func (s *ExternalSigner) call(method string, result interface{}, params ...interface{}) error {
+	if params == nil {
+		params = []interface{}{}
+	}
+	body, err := json.Marshal(&jsonRPCRequest{
+		JSONRPC: "2.0",
+		ID:      atomic.AddUint64(&s.id, 1),
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to external signer")
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read external signer response")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("external signer returned status %s", resp.Status)
+	}
+	var rpcResp jsonRPCResponse
+	if err := json.Unmarshal(respBody, &rpcResp); err != nil {
+		return errors.Wrap(err, "failed to decode external signer response")
+	}
+	if rpcResp.Error != nil {
+		return fmt.Errorf("external signer %s failed: %s (code %d)", method, rpcResp.Error.Message, rpcResp.Error.Code)
+	}
+	return json.Unmarshal(rpcResp.Result, result)
+}
+
+// Bytes returns nil since the key never leaves the external signer
+func (k *externalKey) Bytes() []byte { return nil }
+
+// HexString returns empty string since the key never leaves the external signer
+func (k *externalKey) HexString() string { return "" }
+
+// EcdsaPrivateKey returns nil since the key never leaves the external signer
+func (k *externalKey) EcdsaPrivateKey() interface{} { return nil }
+
+// PublicKey returns the public key
+func (k *externalKey) PublicKey() crypto.PublicKey { return k.pubKey }
+
+// Sign asks the external signer to sign the hash, and verifies the returned signature
+func (k *externalKey) Sign(h []byte) ([]byte, error) {
+	sig, err := k.signer.SignHash(k.addr, h)
+	if err != nil {
+		return nil, err
+	}
+	if !k.pubKey.Verify(h, sig) {
+		return nil, errors.New("invalid signature returned by external signer")
+	}
+	return sig, nil
+}
+
+// Zero is a no-op since the key never leaves the external signer
+func (k *externalKey) Zero() {}
+
+// IsExternalKey returns true if the key is held by an external signer
+func IsExternalKey(key crypto.PrivateKey) bool {
+	_, ok := key.(*externalKey)
+	return ok
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package account
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/ioctl/config"
+)
+
+// mockSignerServer is a minimal external signer daemon holding a single key
+type mockSignerServer struct {
+	key crypto.PrivateKey
+}
+
+func (m *mockSignerServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var (
+		req  jsonRPCRequest
+		resp = jsonRPCResponse{JSONRPC: "2.0"}
+	)
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	resp.ID = req.ID
+	ethAddr := common.BytesToAddress(m.key.PublicKey().Address().Bytes()).Hex()
+	var result interface{}
+	switch req.Method {
+	case _methodAccountList:
+		result = []string{ethAddr}
+	case _methodPublicKey:
+		result = m.key.PublicKey().HexString()
+	case _methodSignHash:
+		if req.Params[0].(string) != ethAddr {
+			resp.Error = &jsonRPCError{Code: -32000, Message: "unknown account"}
+			break
+		}
+		h, err := hex.DecodeString(strings.TrimPrefix(req.Params[1].(string), "0x"))
+		if err != nil {
+			resp.Error = &jsonRPCError{Code: -32602, Message: err.Error()}
+			break
+		}
+		sig, err := m.key.Sign(h)
+		if err != nil {
+			resp.Error = &jsonRPCError{Code: -32000, Message: err.Error()}
+			break
+		}
+		result = "0x" + hex.EncodeToString(sig)
+	default:
+		resp.Error = &jsonRPCError{Code: -32601, Message: "method not found"}
+	}
+	if resp.Error == nil {
+		resp.Result, _ = json.Marshal(result)
+	}
+	_ = json.NewEncoder(w).Encode(&resp)
+}
+
+func TestExternalSigner(t *testing.T) {
+	r := require.New(t)
+
+	key, err := crypto.GenerateKey()
+	r.NoError(err)
+	socket := filepath.Join(t.TempDir(), "signer.ipc")
+	ln, err := net.Listen("unix", socket)
+	r.NoError(err)
+	srv := &http.Server{Handler: &mockSignerServer{key: key}}
+	go srv.Serve(ln)
+	defer srv.Close()
+
+	_, err = NewExternalSigner("tcp://127.0.0.1:8550")
+	r.Error(err)
+	_, err = NewExternalSigner("unix://")
+	r.Error(err)
+
+	signer, err := NewExternalSigner("unix://" + socket)
+	r.NoError(err)
+	addrs, err := signer.Accounts()
+	r.NoError(err)
+	r.Len(addrs, 1)
+	r.Equal(key.PublicKey().Address().String(), addrs[0].String())
+
+	other, err := crypto.GenerateKey()
+	r.NoError(err)
+	exist, err := signer.HasAccount(other.PublicKey().Address())
+	r.NoError(err)
+	r.False(exist)
+	_, err = signer.PublicKey(other.PublicKey().Address())
+	r.Error(err)
+
+	t.Run("PrivateKeyFromSigner", func(t *testing.T) {
+		r := require.New(t)
+		config.ReadConfig.Wallet = t.TempDir()
+		config.ReadConfig.ExternalSigner = "unix://" + socket
+		defer func() { config.ReadConfig.ExternalSigner = "" }()
+
+		prvKey, err := PrivateKeyFromSigner(key.PublicKey().Address().String(), "")
+		r.NoError(err)
+		r.True(IsExternalKey(prvKey))
+		r.Nil(prvKey.Bytes())
+		r.Equal(key.PublicKey().HexString(), prvKey.PublicKey().HexString())
+
+		// sign an action, the signature is produced by the signer daemon
+		tx, err := action.NewTransfer(1, big.NewInt(1), other.PublicKey().Address().String(), nil, 10000, big.NewInt(1))
+		r.NoError(err)
+		elp := (&action.EnvelopeBuilder{}).SetNonce(1).SetGasLimit(10000).
+			SetGasPrice(big.NewInt(1)).SetAction(tx).SetChainID(1).Build()
+		sealed, err := action.Sign(elp, prvKey)
+		r.NoError(err)
+		r.NoError(sealed.VerifySignature())
+		r.Equal(key.PublicKey().Address().String(), sealed.SenderAddress().String())
+
+		// account not managed by the signer falls back to local keystore
+		_, err = PrivateKeyFromSigner(other.PublicKey().Address().String(), "")
+		r.Contains(err.Error(), "invalid address")
+	})
+}
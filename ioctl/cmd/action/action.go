@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"time"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
 	"github.com/iotexproject/go-pkgs/hash"
@@ -60,6 +61,11 @@ var (
 	_bytecodeFlag = flag.NewStringVarP("bytecode", "b", "", "set the byte code")
 	_yesFlag      = flag.BoolVarP("assume-yes", "y", false, "answer yes for all confirmations")
 	_passwordFlag = flag.NewStringVarP("password", "P", "", "input password for account")
+
+	_waitFlag          = flag.BoolVarP("wait", "", false, "wait for the action to be mined and print its receipt")
+	_confirmationsFlag = flag.NewUint64VarP("confirmations", "", 1, "number of blocks to confirm the action, including the one it is mined in")
+	_waitTimeoutFlag   = flag.NewDurationVarP("wait-timeout", "", 5*time.Minute, "time to wait for the action to be mined and confirmed")
+	_abiFlag           = flag.NewStringVar("abi", "", "ABI file to decode the logs in the receipt")
 )
 
 // ActionCmd represents the action command
@@ -90,6 +96,9 @@ func init() {
 	ActionCmd.AddCommand(_actionClaimCmd)
 	ActionCmd.AddCommand(_actionDepositCmd)
 	ActionCmd.AddCommand(_actionSendRawCmd)
+	ActionCmd.AddCommand(_actionBatchCmd)
+	ActionCmd.AddCommand(_actionWatchCmd)
+	ActionCmd.AddCommand(_actionDelegateConfigCmd)
 	ActionCmd.PersistentFlags().StringVar(&config.ReadConfig.Endpoint, "endpoint",
 		config.ReadConfig.Endpoint, config.TranslateInLang(_flagActionEndPointUsages,
 			config.UILanguage))
@@ -141,6 +150,10 @@ func RegisterWriteCommand(cmd *cobra.Command) {
 	_nonceFlag.RegisterCommand(cmd)
 	_yesFlag.RegisterCommand(cmd)
 	_passwordFlag.RegisterCommand(cmd)
+	_waitFlag.RegisterCommand(cmd)
+	_confirmationsFlag.RegisterCommand(cmd)
+	_waitTimeoutFlag.RegisterCommand(cmd)
+	_abiFlag.RegisterCommand(cmd)
 }
 
 // gasPriceInRau returns the suggest gas price
@@ -231,7 +244,7 @@ func SendRaw(selp *iotextypes.Action) error {
 		message.URL = config.ReadConfig.Explorer + txhash
 	}
 	fmt.Println(message.String())
-	return nil
+	return waitAction(txhash)
 }
 
 // SendRawAndRespond sends raw action to blockchain with response and error return
@@ -262,8 +275,11 @@ func SendRawAndRespond(selp *iotextypes.Action) (*iotexapi.SendActionResponse, e
 
 // SendAction sends signed action to blockchain
 func SendAction(elp action.Envelope, signer string) error {
-	_, err := SendActionAndResponse(elp, signer)
-	return err
+	resp, err := SendActionAndResponse(elp, signer)
+	if err != nil || resp == nil {
+		return err
+	}
+	return waitAction(resp.ActionHash)
 }
 
 // SendActionAndResponse sends signed action to blockchain with response and error return
@@ -324,8 +340,11 @@ func SendActionAndResponse(elp action.Envelope, signer string) (*iotexapi.SendAc
 
 // Execute sends signed execution transaction to blockchain
 func Execute(contract string, amount *big.Int, bytecode []byte) error {
-	_, err := ExecuteAndResponse(contract, amount, bytecode)
-	return err
+	resp, err := ExecuteAndResponse(contract, amount, bytecode)
+	if err != nil || resp == nil {
+		return err
+	}
+	return waitAction(resp.ActionHash)
 }
 
 // ExecuteAndResponse sends signed execution transaction to blockchain and with response and error return
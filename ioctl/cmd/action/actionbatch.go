@@ -0,0 +1,606 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/ioctl/cmd/account"
+	"github.com/iotexproject/iotex-core/ioctl/cmd/alias"
+	"github.com/iotexproject/iotex-core/ioctl/cmd/bc"
+	"github.com/iotexproject/iotex-core/ioctl/config"
+	"github.com/iotexproject/iotex-core/ioctl/output"
+	"github.com/iotexproject/iotex-core/ioctl/util"
+)
+
+// Multi-language support
+var (
+	_actionBatchCmdShorts = map[config.Language]string{
+		config.English: "Send a batch of transfers, xrc20 transfers and contract calls from a CSV/JSON file",
+		config.Chinese: "从CSV/JSON文件批量发送转账、xrc20转账和合约调用",
+	}
+	_actionBatchCmdUses = map[config.Language]string{
+		config.English: "batch FILE [-s SIGNER] [-n NONCE] [-l GAS_LIMIT] [-p GAS_PRICE] [-P PASSWORD] [-y] " +
+			"[--concurrency N] [--wait] [--wait-timeout DURATION] [--report REPORT_FILE]",
+		config.Chinese: "batch 文件 [-s 签署人] [-n NONCE] [-l GAS限制] [-p GAS价格] [-P 密码] [-y] " +
+			"[--concurrency 并发数] [--wait] [--wait-timeout 等待时间] [--report 报告文件]",
+	}
+	_flagBatchConcurrencyUsages = map[config.Language]string{
+		config.English: "max number of actions sent or receipts waited for concurrently",
+		config.Chinese: "同时发送交易或等待交易回执的最大数量",
+	}
+	_flagBatchReportUsages = map[config.Language]string{
+		config.English: "write the result report to file (.csv or .json), default to stdout",
+		config.Chinese: "将结果报告写入文件（.csv或.json），默认输出到标准输出",
+	}
+)
+
+// batch action types
+const (
+	_batchTransfer  = "transfer"
+	_batchXrc20     = "xrc20"
+	_batchExecution = "execution"
+)
+
+var (
+	_batchConcurrency uint
+	_batchReportFile  string
+
+	_batchReportHeader = []string{"index", "type", "to", "amount", "nonce", "hash", "status", "error"}
+)
+
+// _actionBatchCmd represents the action batch command
+var _actionBatchCmd = &cobra.Command{
+	Use:   config.TranslateInLang(_actionBatchCmdUses, config.UILanguage),
+	Short: config.TranslateInLang(_actionBatchCmdShorts, config.UILanguage),
+	Long: `Send a batch of actions listed in a CSV or JSON file, signed by the same signer with sequential nonces.
+
+Each entry has the following fields:
+  type      transfer, xrc20 or execution (default transfer)
+  to        recipient address of transfer and xrc20, or contract address of execution
+  amount    amount of IOTX for transfer and execution, or amount of token for xrc20
+  token     xrc20 contract address, for xrc20 only
+  data      hex encoded payload of transfer or call data of execution
+  gasLimit  gas limit, default to the --gas-limit flag if it is set, otherwise estimated
+
+A CSV file must have a header line with the field names, a JSON file contains an array of objects.
+
+The actions are sent in windows of --concurrency consecutive nonces, the actions in a window are sent concurrently.
+If an action fails to be sent, the actions in the following windows are reported as blocked and not sent.
+The command exits with an error if any action fails, is blocked, reverts or times out waiting for its receipt.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		err := batch(cmd, args[0])
+		return output.PrintError(err)
+	},
+}
+
+type (
+	batchEntry struct {
+		Type     string `json:"type"`
+		To       string `json:"to"`
+		Amount   string `json:"amount"`
+		Token    string `json:"token,omitempty"`
+		Data     string `json:"data,omitempty"`
+		GasLimit uint64 `json:"gasLimit,omitempty"`
+	}
+
+	batchResult struct {
+		Index  int    `json:"index"`
+		Type   string `json:"type"`
+		To     string `json:"to"`
+		Amount string `json:"amount"`
+		Nonce  uint64 `json:"nonce"`
+		Hash   string `json:"hash,omitempty"`
+		Status string `json:"status"`
+		Error  string `json:"error,omitempty"`
+	}
+
+	batchMessage struct {
+		Results []*batchResult `json:"results"`
+	}
+)
+
+func (m *batchMessage) String() string {
+	if output.Format == "" {
+		lines := []string{strings.Join(_batchReportHeader, "\t")}
+		for _, r := range m.Results {
+			lines = append(lines, strings.Join(r.record(), "\t"))
+		}
+		return strings.Join(lines, "\n")
+	}
+	return output.FormatString(output.Result, m)
+}
+
+func (r *batchResult) record() []string {
+	return []string{strconv.Itoa(r.Index), r.Type, r.To, r.Amount, strconv.FormatUint(r.Nonce, 10), r.Hash, r.Status, r.Error}
+}
+
+func init() {
+	RegisterWriteCommand(_actionBatchCmd)
+	_actionBatchCmd.Flags().UintVar(&_batchConcurrency, "concurrency", 4,
+		config.TranslateInLang(_flagBatchConcurrencyUsages, config.UILanguage))
+	_actionBatchCmd.Flags().StringVar(&_batchReportFile, "report", "",
+		config.TranslateInLang(_flagBatchReportUsages, config.UILanguage))
+}
+
+func batch(cmd *cobra.Command, file string) error {
+	entries, err := readBatchFile(file)
+	if err != nil {
+		return output.NewError(output.ReadFileError, "failed to read batch file "+file, err)
+	}
+	if len(entries) == 0 {
+		return output.NewError(output.InputError, "no action in batch file", nil)
+	}
+	if _batchConcurrency == 0 {
+		return output.NewError(output.FlagError, "concurrency must be positive", nil)
+	}
+	signer, err := Signer()
+	if err != nil {
+		return output.NewError(output.AddressError, "failed to get signer address", err)
+	}
+	if util.AliasIsHdwalletKey(signer) {
+		return output.NewError(output.InputError, "HDWallet key is not supported in batch", nil)
+	}
+	startNonce, err := nonce(signer)
+	if err != nil {
+		return output.NewError(0, "failed to get nonce", err)
+	}
+	// the default gas limit of the flag is too high to be charged for every action, so it is only used if it is set
+	var gasLimit uint64
+	if cmd.Flags().Changed(_gasLimitFlag.Label()) {
+		gasLimit = _gasLimitFlag.Value().(uint64)
+	}
+	gasPriceRau, err := gasPriceInRau()
+	if err != nil {
+		return output.NewError(0, "failed to get gas price", err)
+	}
+	chainMeta, err := bc.GetChainMeta()
+	if err != nil {
+		return output.NewError(0, "failed to get chain meta", err)
+	}
+	prvKey, err := account.PrivateKeyFromSigner(signer, _passwordFlag.Value().(string))
+	if err != nil {
+		return err
+	}
+	defer prvKey.Zero()
+
+	var (
+		results  = make([]*batchResult, len(entries))
+		selps    = make([]*iotextypes.Action, len(entries))
+		decimals = make(map[string]int)
+		total    = new(big.Int)
+	)
+	for i, entry := range entries {
+		elp, err := entry.envelope(signer, startNonce+uint64(i), gasLimit, gasPriceRau, decimals)
+		if err != nil {
+			return output.NewError(output.InputError, fmt.Sprintf("invalid entry #%d", i), err)
+		}
+		elp.SetChainID(chainMeta.GetChainID())
+		sealed, err := action.Sign(elp, prvKey)
+		if err != nil {
+			return output.NewError(output.CryptoError, fmt.Sprintf("failed to sign entry #%d", i), err)
+		}
+		cost, err := sealed.Cost()
+		if err != nil {
+			return output.NewError(output.RuntimeError, fmt.Sprintf("failed to check cost of entry #%d", i), err)
+		}
+		total.Add(total, cost)
+		h, err := sealed.Hash()
+		if err != nil {
+			return output.NewError(output.CryptoError, fmt.Sprintf("failed to hash entry #%d", i), err)
+		}
+		selps[i] = sealed.Proto()
+		results[i] = &batchResult{
+			Index:  i,
+			Type:   entry.Type,
+			To:     entry.To,
+			Amount: entry.Amount,
+			Nonce:  startNonce + uint64(i),
+			Hash:   hex.EncodeToString(h[:]),
+		}
+	}
+
+	accountMeta, err := account.GetAccountMeta(signer)
+	if err != nil {
+		return output.NewError(0, "failed to get account meta", err)
+	}
+	balance, ok := new(big.Int).SetString(accountMeta.Balance, 10)
+	if !ok {
+		return output.NewError(output.ConvertError, "failed to convert balance into big int", nil)
+	}
+	if balance.Cmp(total) < 0 {
+		return output.NewError(output.ValidationError, "balance is not enough for the batch", nil)
+	}
+	if _yesFlag.Value() == false {
+		var confirm string
+		info := fmt.Sprintf("%d actions from %s with nonce %d to %d, max cost %s IOTX\n\nPlease confirm your action.\n",
+			len(selps), signer, results[0].Nonce, results[len(results)-1].Nonce, util.RauToString(total, util.IotxDecimalNum))
+		message := output.ConfirmationMessage{Info: info, Options: []string{"yes"}}
+		fmt.Println(message.String())
+		if _, err := fmt.Scanf("%s", &confirm); err != nil {
+			return output.NewError(output.InputError, "failed to input yes", err)
+		}
+		if !strings.EqualFold(confirm, "yes") {
+			output.PrintResult("quit")
+			return nil
+		}
+	}
+
+	conn, err := util.ConnectToEndpoint(config.ReadConfig.SecureConnect && !config.Insecure)
+	if err != nil {
+		return output.NewError(output.NetworkError, "failed to connect to endpoint", err)
+	}
+	defer conn.Close()
+	cli := iotexapi.NewAPIServiceClient(conn)
+	ctx := context.Background()
+	if jwtMD, err := util.JwtAuth(); err == nil {
+		ctx = metautils.NiceMD(jwtMD).ToOutgoing(ctx)
+	}
+
+	sendBatch(results, _batchConcurrency, func(i int) error {
+		_, err := cli.SendAction(ctx, &iotexapi.SendActionRequest{Action: selps[i]})
+		return err
+	})
+	wait := _waitFlag.Value() == true
+	if wait {
+		runBatch(len(results), _batchConcurrency, func(i int) {
+			if results[i].Status == "pending" {
+				waitBatchReceipt(ctx, cli, results[i], _waitTimeoutFlag.Value().(time.Duration))
+			}
+		})
+	}
+	if err := writeBatchReport(_batchReportFile, results); err != nil {
+		return err
+	}
+	if failed := countBatchFailures(results, wait); failed > 0 {
+		return output.NewError(output.RuntimeError, fmt.Sprintf("%d of %d actions failed", failed, len(results)), nil)
+	}
+	return nil
+}
+
+// sendBatch sends the actions in windows of consecutive nonces, the actions in a window are sent concurrently.
+// Once an action of a window fails, the following windows are not sent, since their actions can never be mined
+// before the nonce of the failed one is filled.
+func sendBatch(results []*batchResult, window uint, send func(int) error) {
+	var blocked *batchResult
+	for start := 0; start < len(results); start += int(window) {
+		end := start + int(window)
+		if end > len(results) {
+			end = len(results)
+		}
+		if blocked != nil {
+			for _, r := range results[start:end] {
+				r.Status = "blocked"
+				r.Error = fmt.Sprintf("action with nonce %d is not sent", blocked.Nonce)
+			}
+			continue
+		}
+		runBatch(end-start, window, func(i int) {
+			r := results[start+i]
+			if err := send(start + i); err != nil {
+				r.Status = "failed"
+				if sta, ok := status.FromError(err); ok {
+					r.Error = sta.Message()
+				} else {
+					r.Error = err.Error()
+				}
+				return
+			}
+			r.Status = "pending"
+		})
+		for _, r := range results[start:end] {
+			if r.Status == "failed" {
+				blocked = r
+				break
+			}
+		}
+	}
+}
+
+// countBatchFailures returns the number of actions not sent, or not succeeded if their receipts are waited for
+func countBatchFailures(results []*batchResult, wait bool) int {
+	var n int
+	for _, r := range results {
+		if (wait && r.Status != "success") || (!wait && r.Status != "pending") {
+			n++
+		}
+	}
+	return n
+}
+
+// runBatch calls f for index 0 to n-1 with at most concurrency calls in parallel
+func runBatch(n int, concurrency uint, f func(int)) {
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, concurrency)
+	)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			f(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+func waitBatchReceipt(ctx context.Context, cli iotexapi.APIServiceClient, result *batchResult, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		resp, err := cli.GetReceiptByAction(ctx, &iotexapi.GetReceiptByActionRequest{ActionHash: result.Hash})
+		switch {
+		case err == nil:
+			receipt := resp.GetReceiptInfo().GetReceipt()
+			if receipt.GetStatus() == uint64(iotextypes.ReceiptStatus_Success) {
+				result.Status = "success"
+			} else {
+				result.Status = "reverted"
+				result.Error = iotextypes.ReceiptStatus_name[int32(receipt.GetStatus())]
+				if receipt.GetExecutionRevertMsg() != "" {
+					result.Error = receipt.GetExecutionRevertMsg()
+				}
+			}
+			return
+		case status.Code(err) != codes.NotFound && status.Code(err) != codes.DeadlineExceeded:
+			result.Status = "failed"
+			result.Error = err.Error()
+			return
+		}
+		select {
+		case <-ctx.Done():
+			result.Status = "failed"
+			result.Error = "timed out waiting for receipt"
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *batchEntry) envelope(signer string, nonce, defaultGasLimit uint64, gasPrice *big.Int, decimals map[string]int) (action.Envelope, error) {
+	var (
+		gasLimit = e.GasLimit
+		payload  action.Envelope
+	)
+	if gasLimit == 0 {
+		gasLimit = defaultGasLimit
+	}
+	data, err := hex.DecodeString(util.TrimHexPrefix(e.Data))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode data")
+	}
+	switch e.Type {
+	case _batchTransfer:
+		recipient, err := util.Address(e.To)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid recipient")
+		}
+		amount, err := util.StringToRau(e.Amount, util.IotxDecimalNum)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid amount")
+		}
+		if gasLimit == 0 {
+			gasLimit = action.TransferBaseIntrinsicGas + action.TransferPayloadGas*uint64(len(data))
+		}
+		tx, err := action.NewTransfer(nonce, amount, recipient, data, gasLimit, gasPrice)
+		if err != nil {
+			return nil, err
+		}
+		payload = (&action.EnvelopeBuilder{}).SetNonce(nonce).SetGasPrice(gasPrice).SetGasLimit(gasLimit).SetAction(tx).Build()
+	case _batchXrc20:
+		token, err := util.Address(e.Token)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid token")
+		}
+		recipient, err := alias.EtherAddress(e.To)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid recipient")
+		}
+		decimal, ok := decimals[token]
+		if !ok {
+			if decimal, err = xrc20Decimals(token); err != nil {
+				return nil, err
+			}
+			decimals[token] = decimal
+		}
+		amount, err := util.StringToRau(e.Amount, decimal)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid amount")
+		}
+		if data, err = _xrc20ABI.Pack("transfer", recipient, amount); err != nil {
+			return nil, err
+		}
+		if payload, err = batchExecution(signer, token, nonce, big.NewInt(0), gasLimit, gasPrice, data); err != nil {
+			return nil, err
+		}
+	case _batchExecution:
+		contract, err := util.Address(e.To)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid contract")
+		}
+		amount := big.NewInt(0)
+		if e.Amount != "" {
+			if amount, err = util.StringToRau(e.Amount, util.IotxDecimalNum); err != nil {
+				return nil, errors.Wrap(err, "invalid amount")
+			}
+		}
+		if payload, err = batchExecution(signer, contract, nonce, amount, gasLimit, gasPrice, data); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.Errorf("unknown action type %s", e.Type)
+	}
+	return payload, nil
+}
+
+func batchExecution(signer, contract string, nonce uint64, amount *big.Int, gasLimit uint64, gasPrice *big.Int, data []byte) (action.Envelope, error) {
+	tx, err := action.NewExecution(contract, nonce, amount, gasLimit, gasPrice, data)
+	if err != nil {
+		return nil, err
+	}
+	if gasLimit == 0 {
+		if tx, err = fixGasLimit(signer, tx); err != nil {
+			return nil, err
+		}
+		gasLimit = tx.GasLimit()
+	}
+	return (&action.EnvelopeBuilder{}).SetNonce(nonce).SetGasPrice(gasPrice).SetGasLimit(gasLimit).SetAction(tx).Build(), nil
+}
+
+func xrc20Decimals(token string) (int, error) {
+	contract, err := address.FromString(token)
+	if err != nil {
+		return 0, err
+	}
+	result, err := Read(contract, "0", common.FromHex("313ce567"))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to read decimals")
+	}
+	if result == "" {
+		return 0, nil
+	}
+	decimal, err := strconv.ParseInt(result, 16, 8)
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid decimals")
+	}
+	return int(decimal), nil
+}
+
+func readBatchFile(file string) ([]*batchEntry, error) {
+	f, err := os.Open(filepath.Clean(file))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var entries []*batchEntry
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".json":
+		entries, err = parseBatchJSON(f)
+	case ".csv":
+		entries, err = parseBatchCSV(f)
+	default:
+		return nil, errors.Errorf("unsupported batch file %s, must be .csv or .json", file)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		e.Type = strings.ToLower(strings.TrimSpace(e.Type))
+		if e.Type == "" {
+			e.Type = _batchTransfer
+		}
+	}
+	return entries, nil
+}
+
+func parseBatchJSON(r io.Reader) ([]*batchEntry, error) {
+	var entries []*batchEntry
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func parseBatchCSV(r io.Reader) ([]*batchEntry, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		columns[strings.TrimSpace(name)] = i
+	}
+	if _, ok := columns["to"]; !ok {
+		return nil, errors.New("missing column \"to\" in csv header")
+	}
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	entries := make([]*batchEntry, 0, len(records)-1)
+	for i, record := range records[1:] {
+		entry := &batchEntry{
+			Type:   field(record, "type"),
+			To:     field(record, "to"),
+			Amount: field(record, "amount"),
+			Token:  field(record, "token"),
+			Data:   field(record, "data"),
+		}
+		if gasLimit := field(record, "gasLimit"); gasLimit != "" {
+			if entry.GasLimit, err = strconv.ParseUint(gasLimit, 10, 64); err != nil {
+				return nil, errors.Wrapf(err, "invalid gas limit in line %d", i+2)
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func writeBatchReport(file string, results []*batchResult) error {
+	if file == "" {
+		message := batchMessage{Results: results}
+		fmt.Println(message.String())
+		return nil
+	}
+	f, err := os.Create(filepath.Clean(file))
+	if err != nil {
+		return output.NewError(output.WriteFileError, "failed to create report file "+file, err)
+	}
+	defer f.Close()
+	if strings.ToLower(filepath.Ext(file)) == ".json" {
+		encoder := json.NewEncoder(f)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(results)
+	} else {
+		w := csv.NewWriter(f)
+		records := [][]string{_batchReportHeader}
+		for _, r := range results {
+			records = append(records, r.record())
+		}
+		err = w.WriteAll(records)
+	}
+	if err != nil {
+		return output.NewError(output.WriteFileError, "failed to write report file "+file, err)
+	}
+	output.PrintResult("Batch report has been written to " + file)
+	return nil
+}
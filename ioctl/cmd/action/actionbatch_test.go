@@ -0,0 +1,128 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadBatchFile(t *testing.T) {
+	r := require.New(t)
+	dir := t.TempDir()
+
+	csvFile := filepath.Join(dir, "batch.csv")
+	r.NoError(os.WriteFile(csvFile, []byte(`type,to,amount,token,data,gasLimit
+transfer,io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms,1.5,,,
+XRC20 ,io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms,10,io1hp6y4eqr90j7tmul4w2wa8pm7wx462hq0mg4tw,,
+execution,io1hp6y4eqr90j7tmul4w2wa8pm7wx462hq0mg4tw,0,,0xa9059cbb,50000
+,io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms,2,,,
+`), 0600))
+	entries, err := readBatchFile(csvFile)
+	r.NoError(err)
+	r.Len(entries, 4)
+	r.Equal(_batchTransfer, entries[0].Type)
+	r.Equal("1.5", entries[0].Amount)
+	r.Equal(_batchXrc20, entries[1].Type)
+	r.Equal("io1hp6y4eqr90j7tmul4w2wa8pm7wx462hq0mg4tw", entries[1].Token)
+	r.Equal(_batchExecution, entries[2].Type)
+	r.Equal("0xa9059cbb", entries[2].Data)
+	r.Equal(uint64(50000), entries[2].GasLimit)
+	r.Equal(_batchTransfer, entries[3].Type)
+
+	jsonFile := filepath.Join(dir, "batch.json")
+	r.NoError(os.WriteFile(jsonFile, []byte(`[
+{"to": "io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms", "amount": "1"},
+{"type": "xrc20", "to": "io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms", "amount": "3", "token": "io1hp6y4eqr90j7tmul4w2wa8pm7wx462hq0mg4tw", "gasLimit": 60000}
+]`), 0600))
+	entries, err = readBatchFile(jsonFile)
+	r.NoError(err)
+	r.Len(entries, 2)
+	r.Equal(_batchTransfer, entries[0].Type)
+	r.Equal(_batchXrc20, entries[1].Type)
+	r.Equal(uint64(60000), entries[1].GasLimit)
+
+	badFile := filepath.Join(dir, "batch.csv")
+	r.NoError(os.WriteFile(badFile, []byte("type,amount\ntransfer,1\n"), 0600))
+	_, err = readBatchFile(badFile)
+	r.ErrorContains(err, "missing column")
+
+	_, err = readBatchFile(filepath.Join(dir, "batch.txt"))
+	r.Error(err)
+}
+
+func TestRunBatch(t *testing.T) {
+	r := require.New(t)
+	var (
+		running, maxRunning int32
+		done                = make([]bool, 20)
+	)
+	runBatch(len(done), 3, func(i int) {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		done[i] = true
+		atomic.AddInt32(&running, -1)
+	})
+	r.LessOrEqual(maxRunning, int32(3))
+	for _, d := range done {
+		r.True(d)
+	}
+}
+
+func TestSendBatch(t *testing.T) {
+	r := require.New(t)
+	results := make([]*batchResult, 7)
+	for i := range results {
+		results[i] = &batchResult{Index: i, Nonce: uint64(10 + i)}
+	}
+	var (
+		mu   sync.Mutex
+		sent []int
+	)
+	sendBatch(results, 3, func(i int) error {
+		mu.Lock()
+		sent = append(sent, i)
+		mu.Unlock()
+		if i == 4 {
+			return errors.New("nonce too low")
+		}
+		return nil
+	})
+	sort.Ints(sent)
+	r.Equal([]int{0, 1, 2, 3, 4, 5}, sent)
+	for _, i := range []int{0, 1, 2, 3, 5} {
+		r.Equal("pending", results[i].Status)
+	}
+	r.Equal("failed", results[4].Status)
+	r.Equal("nonce too low", results[4].Error)
+	r.Equal("blocked", results[6].Status)
+	r.Equal("action with nonce 14 is not sent", results[6].Error)
+}
+
+func TestCountBatchFailures(t *testing.T) {
+	r := require.New(t)
+	results := []*batchResult{
+		{Status: "success"},
+		{Status: "pending"},
+		{Status: "failed", Error: "timed out waiting for receipt"},
+		{Status: "blocked"},
+		{Status: "reverted"},
+	}
+	r.Equal(3, countBatchFailures(results[1:], false))
+	r.Equal(4, countBatchFailures(results, true))
+}
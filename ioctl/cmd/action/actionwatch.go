@@ -0,0 +1,210 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/ioctl/config"
+	"github.com/iotexproject/iotex-core/ioctl/output"
+	"github.com/iotexproject/iotex-core/ioctl/util"
+)
+
+// Multi-language support
+var (
+	_actionWatchCmdShorts = map[config.Language]string{
+		config.English: "Wait for an action to be mined and print its receipt",
+		config.Chinese: "等待交易被打包并打印收据",
+	}
+	_actionWatchCmdUses = map[config.Language]string{
+		config.English: "watch ACTION_HASH [--abi ABI_PATH] [--confirmations N] [--wait-timeout DURATION]",
+		config.Chinese: "watch 交易哈希 [--abi ABI文件路径] [--confirmations 确认数] [--wait-timeout 等待时间]",
+	}
+)
+
+// _actionWatchCmd represents the action watch command
+var _actionWatchCmd = &cobra.Command{
+	Use:   config.TranslateInLang(_actionWatchCmdUses, config.UILanguage),
+	Short: config.TranslateInLang(_actionWatchCmdShorts, config.UILanguage),
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		err := watch(args[0])
+		return output.PrintError(err)
+	},
+}
+
+type watchMessage struct {
+	Hash          string              `json:"hash"`
+	Confirmations uint64              `json:"confirmations"`
+	Receipt       *iotextypes.Receipt `json:"receipt"`
+	DecodedLogs   []*util.DecodedLog  `json:"decodedLogs,omitempty"`
+}
+
+func (m *watchMessage) String() string {
+	if output.Format == "" {
+		message := fmt.Sprintf("Action %s has been mined with %d confirmation(s)\n\n", m.Hash, m.Confirmations) +
+			printReceiptProto(m.Receipt)
+		for _, l := range m.DecodedLogs {
+			message += "\nevent " + l.Event + "("
+			for i, arg := range l.Args {
+				if i > 0 {
+					message += ", "
+				}
+				message += arg.Name + "=" + arg.Value
+			}
+			message += ")"
+		}
+		return message
+	}
+	return output.FormatString(output.Result, m)
+}
+
+func init() {
+	_abiFlag.RegisterCommand(_actionWatchCmd)
+	_confirmationsFlag.RegisterCommand(_actionWatchCmd)
+	_waitTimeoutFlag.RegisterCommand(_actionWatchCmd)
+}
+
+// waitAction watches the action if --wait is set
+func waitAction(hash string) error {
+	if _waitFlag.Value() == false {
+		return nil
+	}
+	return watch(hash)
+}
+
+// watch waits until the action is mined and confirmed by enough blocks, and prints its receipt
+func watch(hash string) error {
+	actHash, err := hex.DecodeString(util.TrimHexPrefix(hash))
+	if err != nil || len(actHash) != 32 {
+		return output.NewError(output.InputError, "invalid action hash "+hash, err)
+	}
+	var contractABI *abi.ABI
+	if abiFile := _abiFlag.Value().(string); abiFile != "" {
+		if contractABI, err = util.ReadABIFile(abiFile); err != nil {
+			return output.NewError(output.ReadFileError, "failed to read abi file "+abiFile, err)
+		}
+	}
+	confirmations := _confirmationsFlag.Value().(uint64)
+	if confirmations == 0 {
+		confirmations = 1
+	}
+
+	conn, err := util.ConnectToEndpoint(config.ReadConfig.SecureConnect && !config.Insecure)
+	if err != nil {
+		return output.NewError(output.NetworkError, "failed to connect to endpoint", err)
+	}
+	defer conn.Close()
+	cli := iotexapi.NewAPIServiceClient(conn)
+	ctx := context.Background()
+	if jwtMD, err := util.JwtAuth(); err == nil {
+		ctx = metautils.NiceMD(jwtMD).ToOutgoing(ctx)
+	}
+	if timeout := _waitTimeoutFlag.Value().(time.Duration); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	receipt, confirmed, err := waitReceipt(ctx, cli, actHash, confirmations)
+	if err != nil {
+		return err
+	}
+
+	message := watchMessage{
+		Hash:          hex.EncodeToString(actHash),
+		Confirmations: confirmed,
+		Receipt:       receipt,
+	}
+	if contractABI != nil {
+		for _, l := range receipt.Logs {
+			decoded, err := util.DecodeLog(contractABI, l.Topics, l.Data)
+			if err != nil {
+				return output.NewError(output.SerializationError, "failed to decode log", err)
+			}
+			if decoded != nil {
+				message.DecodedLogs = append(message.DecodedLogs, decoded)
+			}
+		}
+	}
+	fmt.Println(message.String())
+	if receipt.Status != uint64(iotextypes.ReceiptStatus_Success) {
+		reason := Match(strconv.Itoa(int(receipt.Status)), "status")
+		if receipt.ExecutionRevertMsg != "" {
+			reason += ": " + receipt.ExecutionRevertMsg
+		}
+		return output.NewError(output.RuntimeError, "action failed "+reason, nil)
+	}
+	return nil
+}
+
+// waitReceipt waits until the action is mined and confirmed by enough blocks, and returns its receipt with the number
+// of confirmations
+func waitReceipt(ctx context.Context, cli iotexapi.APIServiceClient, actHash []byte, confirmations uint64) (*iotextypes.Receipt, uint64, error) {
+	// subscribe before querying the receipt so that no block is missed in between
+	stream, err := cli.StreamBlocks(ctx, &iotexapi.StreamBlocksRequest{})
+	if err != nil {
+		return nil, 0, watchError(err, "failed to invoke StreamBlocks api")
+	}
+	var (
+		receipt *iotextypes.Receipt
+		tip     uint64
+	)
+	resp, err := cli.GetReceiptByAction(ctx, &iotexapi.GetReceiptByActionRequest{ActionHash: hex.EncodeToString(actHash)})
+	switch {
+	case err == nil:
+		receipt = resp.GetReceiptInfo().GetReceipt()
+		chainMeta, err := cli.GetChainMeta(ctx, &iotexapi.GetChainMetaRequest{})
+		if err != nil {
+			return nil, 0, watchError(err, "failed to invoke GetChainMeta api")
+		}
+		tip = chainMeta.GetChainMeta().GetHeight()
+	case status.Code(err) != codes.NotFound:
+		return nil, 0, watchError(err, "failed to invoke GetReceiptByAction api")
+	}
+	for receipt == nil || tip+1 < receipt.BlkHeight+confirmations {
+		blk, err := stream.Recv()
+		if err != nil {
+			return nil, 0, watchError(err, "failed to receive block")
+		}
+		tip = blk.GetBlock().GetBlock().GetHeader().GetCore().GetHeight()
+		if receipt != nil {
+			continue
+		}
+		for _, r := range blk.GetBlock().GetReceipts() {
+			if bytes.Equal(r.ActHash, actHash) {
+				receipt = r
+				break
+			}
+		}
+	}
+	return receipt, tip + 1 - receipt.BlkHeight, nil
+}
+
+func watchError(err error, msg string) error {
+	switch status.Code(err) {
+	case codes.DeadlineExceeded:
+		return output.NewError(output.NetworkError, "timeout waiting for action", nil)
+	case codes.Unknown:
+		return output.NewError(output.NetworkError, msg, err)
+	default:
+		return output.NewError(output.APIError, status.Convert(err).Message(), nil)
+	}
+}
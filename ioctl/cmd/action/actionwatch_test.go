@@ -0,0 +1,118 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi/mock_iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/ioctl/output"
+)
+
+// blockStream streams the blocks in the channel, and fails with deadline exceeded once the context is done
+type blockStream struct {
+	grpc.ClientStream
+	ctx    context.Context
+	blocks chan *iotexapi.StreamBlocksResponse
+}
+
+func (s *blockStream) Recv() (*iotexapi.StreamBlocksResponse, error) {
+	select {
+	case <-s.ctx.Done():
+		return nil, status.Error(codes.DeadlineExceeded, s.ctx.Err().Error())
+	case blk := <-s.blocks:
+		return blk, nil
+	}
+}
+
+func streamedBlock(height uint64, receipts ...*iotextypes.Receipt) *iotexapi.StreamBlocksResponse {
+	return &iotexapi.StreamBlocksResponse{
+		Block: &iotexapi.BlockInfo{
+			Block: &iotextypes.Block{
+				Header: &iotextypes.BlockHeader{Core: &iotextypes.BlockHeaderCore{Height: height}},
+			},
+			Receipts: receipts,
+		},
+	}
+}
+
+func TestWaitReceipt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	actHash := make([]byte, 32)
+	actHash[0] = 1
+	receipt := &iotextypes.Receipt{ActHash: actHash, BlkHeight: 11, Status: uint64(iotextypes.ReceiptStatus_Success)}
+	newClient := func(ctx context.Context, blocks chan *iotexapi.StreamBlocksResponse) *mock_iotexapi.MockAPIServiceClient {
+		cli := mock_iotexapi.NewMockAPIServiceClient(ctrl)
+		cli.EXPECT().StreamBlocks(gomock.Any(), gomock.Any()).Return(&blockStream{ctx: ctx, blocks: blocks}, nil)
+		return cli
+	}
+
+	t.Run("mined in streamed block", func(t *testing.T) {
+		r := require.New(t)
+		blocks := make(chan *iotexapi.StreamBlocksResponse, 4)
+		cli := newClient(context.Background(), blocks)
+		cli.EXPECT().GetReceiptByAction(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "not found"))
+		blocks <- streamedBlock(10)
+		blocks <- streamedBlock(11, &iotextypes.Receipt{ActHash: make([]byte, 32)}, receipt)
+		blocks <- streamedBlock(12)
+		blocks <- streamedBlock(13)
+
+		mined, confirmed, err := waitReceipt(context.Background(), cli, actHash, 3)
+		r.NoError(err)
+		r.Equal(receipt, mined)
+		r.EqualValues(3, confirmed)
+		r.Empty(blocks)
+	})
+
+	t.Run("already mined and confirmed", func(t *testing.T) {
+		r := require.New(t)
+		cli := newClient(context.Background(), nil)
+		cli.EXPECT().GetReceiptByAction(gomock.Any(), gomock.Any()).Return(&iotexapi.GetReceiptByActionResponse{
+			ReceiptInfo: &iotexapi.ReceiptInfo{Receipt: receipt},
+		}, nil)
+		cli.EXPECT().GetChainMeta(gomock.Any(), gomock.Any()).Return(&iotexapi.GetChainMetaResponse{
+			ChainMeta: &iotextypes.ChainMeta{Height: 15},
+		}, nil)
+
+		mined, confirmed, err := waitReceipt(context.Background(), cli, actHash, 2)
+		r.NoError(err)
+		r.Equal(receipt, mined)
+		r.EqualValues(5, confirmed)
+	})
+
+	t.Run("timeout waiting for confirmations", func(t *testing.T) {
+		r := require.New(t)
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		blocks := make(chan *iotexapi.StreamBlocksResponse, 1)
+		cli := newClient(ctx, blocks)
+		cli.EXPECT().GetReceiptByAction(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "not found"))
+		blocks <- streamedBlock(11, receipt)
+
+		_, _, err := waitReceipt(ctx, cli, actHash, 2)
+		r.Error(err)
+		r.Equal(output.NetworkError, err.(output.ErrorMessage).Code)
+		r.Contains(err.Error(), "timeout waiting for action")
+	})
+
+	t.Run("no wait without flag", func(t *testing.T) {
+		r := require.New(t)
+		r.Equal(false, _waitFlag.Value())
+		r.NoError(waitAction("invalid hash"))
+	})
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/poll"
+	"github.com/iotexproject/iotex-core/ioctl/config"
+	"github.com/iotexproject/iotex-core/ioctl/output"
+)
+
+// Multi-language support
+var (
+	_delegateConfigCmdShorts = map[config.Language]string{
+		config.English: "Propose or approve the delegate set parameters as a delegate",
+		config.Chinese: "作为代表提议或批准代表集参数",
+	}
+	_delegateConfigProposeCmdUses = map[config.Language]string{
+		config.English: "propose NUM_DELEGATES NUM_CANDIDATE_DELEGATES PRODUCTIVITY_THRESHOLD PROBATION_INTENSITY_RATE" +
+			" [-s SIGNER] [-n NONCE] [-l GAS_LIMIT] [-p GAS_PRICE] [-P PASSWORD] [-y]",
+		config.Chinese: "propose 代表数 候选代表数 生产率阈值 惩罚强度" +
+			" [-s 签署人] [-n NONCE] [-l GAS限制] [-p GAS价格] [-P 密码] [-y]",
+	}
+	_delegateConfigProposeCmdShorts = map[config.Language]string{
+		config.English: "Propose the delegate set parameters for the next epoch",
+		config.Chinese: "提议下一个纪元的代表集参数",
+	}
+	_delegateConfigApproveCmdUses = map[config.Language]string{
+		config.English: "approve PROPOSAL_ID" +
+			" [-s SIGNER] [-n NONCE] [-l GAS_LIMIT] [-p GAS_PRICE] [-P PASSWORD] [-y]",
+		config.Chinese: "approve 提议ID" +
+			" [-s 签署人] [-n NONCE] [-l GAS限制] [-p GAS价格] [-P 密码] [-y]",
+	}
+	_delegateConfigApproveCmdShorts = map[config.Language]string{
+		config.English: "Approve a proposal of the delegate set parameters",
+		config.Chinese: "批准代表集参数提议",
+	}
+)
+
+var (
+	_actionDelegateConfigCmd = &cobra.Command{
+		Use:   "delegateconfig",
+		Short: config.TranslateInLang(_delegateConfigCmdShorts, config.UILanguage),
+	}
+
+	_delegateConfigProposeCmd = &cobra.Command{
+		Use:   config.TranslateInLang(_delegateConfigProposeCmdUses, config.UILanguage),
+		Short: config.TranslateInLang(_delegateConfigProposeCmdShorts, config.UILanguage),
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			err := delegateConfigPropose(args)
+			return output.PrintError(err)
+		},
+	}
+
+	_delegateConfigApproveCmd = &cobra.Command{
+		Use:   config.TranslateInLang(_delegateConfigApproveCmdUses, config.UILanguage),
+		Short: config.TranslateInLang(_delegateConfigApproveCmdShorts, config.UILanguage),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			err := delegateConfigApprove(args)
+			return output.PrintError(err)
+		},
+	}
+)
+
+func init() {
+	RegisterWriteCommand(_delegateConfigProposeCmd)
+	RegisterWriteCommand(_delegateConfigApproveCmd)
+	_actionDelegateConfigCmd.AddCommand(_delegateConfigProposeCmd)
+	_actionDelegateConfigCmd.AddCommand(_delegateConfigApproveCmd)
+}
+
+func delegateConfigPropose(args []string) error {
+	var params [4]uint64
+	for i := range params {
+		v, err := strconv.ParseUint(args[i], 10, 64)
+		if err != nil {
+			return output.NewError(output.ConvertError, "invalid parameter "+args[i], err)
+		}
+		params[i] = v
+	}
+	if params[3] > 100 {
+		return output.NewError(output.ValidationError, "probation intensity rate should not be larger than 100", nil)
+	}
+	data, err := poll.ProposeDelegateConfigData(params[0], params[1], params[2], uint32(params[3]))
+	if err != nil {
+		return output.NewError(output.SerializationError, "failed to encode the proposal", err)
+	}
+	return sendDelegateConfigCall(data)
+}
+
+func delegateConfigApprove(args []string) error {
+	id, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return output.NewError(output.ConvertError, "invalid proposal ID", err)
+	}
+	data, err := poll.ApproveDelegateConfigData(id)
+	if err != nil {
+		return output.NewError(output.SerializationError, "failed to encode the approval", err)
+	}
+	return sendDelegateConfigCall(data)
+}
+
+func sendDelegateConfigCall(data []byte) error {
+	sender, err := Signer()
+	if err != nil {
+		return output.NewError(output.AddressError, "failed to get signed address", err)
+	}
+	gasLimit := _gasLimitFlag.Value().(uint64)
+	if gasLimit == 0 {
+		if gasLimit, err = action.CalculateIntrinsicGas(action.ExecutionBaseIntrinsicGas, action.ExecutionDataGas, uint64(len(data))); err != nil {
+			return output.NewError(0, "failed to calculate gas limit", err)
+		}
+	}
+	gasPriceRau, err := gasPriceInRau()
+	if err != nil {
+		return output.NewError(0, "failed to get gas price", err)
+	}
+	nonce, err := nonce(sender)
+	if err != nil {
+		return output.NewError(0, "failed to get nonce ", err)
+	}
+	exec, err := action.NewExecution(poll.DelegateConfigProtocolAddr().String(), nonce, big.NewInt(0), gasLimit, gasPriceRau, data)
+	if err != nil {
+		return output.NewError(output.InstantiationError, "failed to make a delegate config call", err)
+	}
+	return SendAction(
+		(&action.EnvelopeBuilder{}).
+			SetNonce(nonce).
+			SetGasPrice(gasPriceRau).
+			SetGasLimit(gasLimit).
+			SetAction(exec).Build(),
+		sender)
+}
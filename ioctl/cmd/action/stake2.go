@@ -54,6 +54,7 @@ func init() {
 	Stake2Cmd.AddCommand(_stake2ReleaseCmd)
 	Stake2Cmd.AddCommand(_stake2RegisterCmd)
 	Stake2Cmd.AddCommand(_stake2ChangeCmd)
+	Stake2Cmd.AddCommand(_stake2UpdateBLSKeyCmd)
 	Stake2Cmd.PersistentFlags().StringVar(&config.ReadConfig.Endpoint, "endpoint", config.ReadConfig.Endpoint, config.TranslateInLang(_stake2FlagEndpointUsages, config.UILanguage))
 	Stake2Cmd.PersistentFlags().BoolVar(&config.Insecure, "insecure", config.Insecure, config.TranslateInLang(_stake2FlagInsecureUsages, config.UILanguage))
 }
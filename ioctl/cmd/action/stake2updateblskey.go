@@ -0,0 +1,95 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/spf13/cobra"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/staking"
+	"github.com/iotexproject/iotex-core/crypto/bls"
+	"github.com/iotexproject/iotex-core/ioctl/config"
+	"github.com/iotexproject/iotex-core/ioctl/output"
+)
+
+// Multi-language support
+var (
+	_stake2UpdateBLSKeyCmdUses = map[config.Language]string{
+		config.English: "updateblskey BLS_PRIVATE_KEY" +
+			" [-s SIGNER] [-n NONCE] [-l GAS_LIMIT] [-p GAS_PRICE] [-P PASSWORD] [-y]",
+		config.Chinese: "updateblskey BLS私钥" +
+			" [-s 签署人] [-n NONCE] [-l GAS限制] [-p GAS价格] [-P 密码] [-y]",
+	}
+	_stake2UpdateBLSKeyCmdShorts = map[config.Language]string{
+		config.English: "Register the BLS public key of the candidate, proving the possession of the private key",
+		config.Chinese: "注册候选人的BLS公钥，并证明持有私钥",
+	}
+)
+
+var _stake2UpdateBLSKeyCmd = &cobra.Command{
+	Use:   config.TranslateInLang(_stake2UpdateBLSKeyCmdUses, config.UILanguage),
+	Short: config.TranslateInLang(_stake2UpdateBLSKeyCmdShorts, config.UILanguage),
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		err := stake2UpdateBLSKey(args)
+		return output.PrintError(err)
+	},
+}
+
+func init() {
+	RegisterWriteCommand(_stake2UpdateBLSKeyCmd)
+}
+
+func stake2UpdateBLSKey(args []string) error {
+	sk, err := bls.HexStringToPrivateKey(args[0])
+	if err != nil {
+		return output.NewError(output.ValidationError, "invalid BLS private key", err)
+	}
+	update, err := staking.NewBLSKeyUpdate(sk)
+	if err != nil {
+		return output.NewError(output.CryptoError, "failed to prove the possession of BLS key", err)
+	}
+	data, err := update.EncodeABIBinary()
+	if err != nil {
+		return output.NewError(output.SerializationError, "failed to encode BLS key update", err)
+	}
+
+	sender, err := Signer()
+	if err != nil {
+		return output.NewError(output.AddressError, "failed to get signed address", err)
+	}
+
+	gasLimit := _gasLimitFlag.Value().(uint64)
+	if gasLimit == 0 {
+		if gasLimit, err = action.CalculateIntrinsicGas(action.ExecutionBaseIntrinsicGas, action.ExecutionDataGas, uint64(len(data))); err != nil {
+			return output.NewError(0, "failed to calculate gas limit", err)
+		}
+	}
+
+	gasPriceRau, err := gasPriceInRau()
+	if err != nil {
+		return output.NewError(0, "failed to get gas price", err)
+	}
+	nonce, err := nonce(sender)
+	if err != nil {
+		return output.NewError(0, "failed to get nonce ", err)
+	}
+
+	exec, err := action.NewExecution(staking.ProtocolAddr().String(), nonce, big.NewInt(0), gasLimit, gasPriceRau, data)
+	if err != nil {
+		return output.NewError(output.InstantiationError, "failed to make a BLS key update instance", err)
+	}
+	return SendAction(
+		(&action.EnvelopeBuilder{}).
+			SetNonce(nonce).
+			SetGasPrice(gasPriceRau).
+			SetGasLimit(gasLimit).
+			SetAction(exec).Build(),
+		sender)
+}
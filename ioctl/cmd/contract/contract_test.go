@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"fmt"
 	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -197,3 +198,70 @@ func TestParseOutputArgument(t *testing.T) {
 		r.Equal(test.expect, result)
 	}
 }
+
+func TestEventTopics(t *testing.T) {
+	r := require.New(t)
+	contractABI, err := abi.JSON(strings.NewReader(`[{"anonymous":false,"inputs":[
+{"indexed":true,"name":"from","type":"address"},
+{"indexed":true,"name":"to","type":"address"},
+{"indexed":false,"name":"value","type":"uint256"}],
+"name":"Transfer","type":"event"},
+{"anonymous":false,"inputs":[{"indexed":false,"name":"value","type":"uint256"}],
+"name":"Burn","type":"event"}]`))
+	r.NoError(err)
+	transferID := contractABI.Events["Transfer"].ID.Bytes()
+
+	topics, err := eventTopics(&contractABI, "", "")
+	r.NoError(err)
+	r.Len(topics, 1)
+	r.Len(topics[0].Topic, 2)
+
+	_, err = eventTopics(&contractABI, "", `{"from":"io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms"}`)
+	r.Error(err)
+	_, err = eventTopics(&contractABI, "Approval", "")
+	r.Error(err)
+
+	topics, err = eventTopics(&contractABI, "Transfer", "")
+	r.NoError(err)
+	r.Len(topics, 1)
+	r.Equal(transferID, topics[0].Topic[0])
+
+	topics, err = eventTopics(&contractABI, "Transfer", `{"to":"0xDa7e12Ef57c236a06117c5e0d04a228e7181CF36"}`)
+	r.NoError(err)
+	r.Len(topics, 3)
+	r.Equal(transferID, topics[0].Topic[0])
+	r.Empty(topics[1].Topic)
+	r.Equal(common.HexToHash("0xDa7e12Ef57c236a06117c5e0d04a228e7181CF36").Bytes(), topics[2].Topic[0])
+
+	topics, err = eventTopics(&contractABI, "Transfer", `{"from":"io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms"}`)
+	r.NoError(err)
+	r.Len(topics, 2)
+	r.Equal(common.HexToHash("0xDa7e12Ef57c236a06117c5e0d04a228e7181CF36").Bytes(), topics[1].Topic[0])
+
+	_, err = eventTopics(&contractABI, "Transfer", `{"value":1}`)
+	r.Error(err)
+}
+
+func TestLogsRange(t *testing.T) {
+	r := require.New(t)
+	for _, test := range []struct {
+		from, to, tip        uint64
+		expectFrom, expectTo uint64
+	}{
+		{0, 0, 100, 100, 100},
+		{10, 0, 100, 10, 100},
+		{10, 20, 0, 10, 20},
+		// --to alone starts from the end height if it is lower than the tip
+		{0, 50, 100, 50, 50},
+		{0, 200, 100, 100, 200},
+	} {
+		from, to, err := logsRange(test.from, test.to, test.tip)
+		r.NoError(err)
+		r.Equal(test.expectFrom, from)
+		r.Equal(test.expectTo, to)
+	}
+	_, _, err := logsRange(20, 10, 0)
+	r.Error(err)
+	_, _, err = logsRange(200, 0, 100)
+	r.Error(err)
+}
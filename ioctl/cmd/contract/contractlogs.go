@@ -0,0 +1,312 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package contract
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/ioctl/config"
+	"github.com/iotexproject/iotex-core/ioctl/flag"
+	"github.com/iotexproject/iotex-core/ioctl/output"
+	"github.com/iotexproject/iotex-core/ioctl/util"
+)
+
+// Multi-language support
+var (
+	_logsCmdUses = map[config.Language]string{
+		config.English: "logs --abi ABI_PATH --address (CONTRACT_ADDRESS|ALIAS) [--event EVENT_NAME] " +
+			"[--from START_HEIGHT] [--to END_HEIGHT] [--with-arguments INDEXED_ARGUMENTS]",
+		config.Chinese: "logs --abi ABI文件路径 --address (合约地址|别名) [--event 事件名] " +
+			"[--from 起始高度] [--to 结束高度] [--with-arguments 索引参数]",
+	}
+	_logsCmdShorts = map[config.Language]string{
+		config.English: "query and decode event logs of smart contract on IoTeX blockchain",
+		config.Chinese: "查询并解析IoTeX区块链上智能合约的事件日志",
+	}
+	_flagLogsABIUsage = map[config.Language]string{
+		config.English: "ABI file of the contract",
+		config.Chinese: "合约的ABI文件",
+	}
+	_flagLogsAddressUsage = map[config.Language]string{
+		config.English: "contract address or alias",
+		config.Chinese: "合约地址或别名",
+	}
+	_flagLogsEventUsage = map[config.Language]string{
+		config.English: "event name, default to all events in the ABI",
+		config.Chinese: "事件名，默认为ABI中的所有事件",
+	}
+	_flagLogsFromUsage = map[config.Language]string{
+		config.English: "start block height, default to tip height or end height, whichever is lower",
+		config.Chinese: "起始区块高度，默认为最新高度与结束高度中较低者",
+	}
+	_flagLogsToUsage = map[config.Language]string{
+		config.English: "end block height, default to tip height",
+		config.Chinese: "结束区块高度，默认为最新高度",
+	}
+)
+
+// _logsPaginationSize is the number of blocks queried in one GetLogs call
+const _logsPaginationSize = 1000
+
+// Flags
+var (
+	_logsABIFlag     = flag.NewStringVar("abi", "", config.TranslateInLang(_flagLogsABIUsage, config.UILanguage))
+	_logsAddressFlag = flag.NewStringVar("address", "", config.TranslateInLang(_flagLogsAddressUsage, config.UILanguage))
+	_logsEventFlag   = flag.NewStringVar("event", "", config.TranslateInLang(_flagLogsEventUsage, config.UILanguage))
+	_logsFromFlag    = flag.NewUint64VarP("from", "", 0, config.TranslateInLang(_flagLogsFromUsage, config.UILanguage))
+	_logsToFlag      = flag.NewUint64VarP("to", "", 0, config.TranslateInLang(_flagLogsToUsage, config.UILanguage))
+)
+
+// _contractLogsCmd represents the contract logs command
+var _contractLogsCmd = &cobra.Command{
+	Use:   config.TranslateInLang(_logsCmdUses, config.UILanguage),
+	Short: config.TranslateInLang(_logsCmdShorts, config.UILanguage),
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		err := contractLogs()
+		return output.PrintError(err)
+	},
+}
+
+type (
+	contractLog struct {
+		BlockHeight uint64                `json:"blockHeight"`
+		ActionHash  string                `json:"actionHash"`
+		Index       uint32                `json:"index"`
+		Contract    string                `json:"contract"`
+		Event       string                `json:"event"`
+		Args        []*util.DecodedLogArg `json:"args"`
+	}
+
+	contractLogsMessage struct {
+		Logs []*contractLog `json:"logs"`
+	}
+)
+
+func (m *contractLogsMessage) String() string {
+	if output.Format == "" {
+		var sb strings.Builder
+		w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "HEIGHT\tACTION HASH\tINDEX\tEVENT\tARGUMENTS")
+		for _, l := range m.Logs {
+			args := make([]string, 0, len(l.Args))
+			for _, arg := range l.Args {
+				args = append(args, arg.Name+"="+arg.Value)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%d\t%s\t%s\n", l.BlockHeight, l.ActionHash, l.Index, l.Event, strings.Join(args, " "))
+		}
+		w.Flush()
+		return strings.TrimSuffix(sb.String(), "\n")
+	}
+	return output.FormatString(output.Result, m)
+}
+
+func init() {
+	_logsABIFlag.RegisterCommand(_contractLogsCmd)
+	_logsAddressFlag.RegisterCommand(_contractLogsCmd)
+	_logsEventFlag.RegisterCommand(_contractLogsCmd)
+	_logsFromFlag.RegisterCommand(_contractLogsCmd)
+	_logsToFlag.RegisterCommand(_contractLogsCmd)
+	flag.WithArgumentsFlag.RegisterCommand(_contractLogsCmd)
+	_logsABIFlag.MarkFlagRequired(_contractLogsCmd)
+	_logsAddressFlag.MarkFlagRequired(_contractLogsCmd)
+}
+
+func contractLogs() error {
+	contract, err := util.Address(_logsAddressFlag.Value().(string))
+	if err != nil {
+		return output.NewError(output.AddressError, "failed to get contract address", err)
+	}
+	abiFile := _logsABIFlag.Value().(string)
+	contractABI, err := readAbiFile(abiFile)
+	if err != nil {
+		return output.NewError(output.ReadFileError, "failed to read abi file "+abiFile, err)
+	}
+	topics, err := eventTopics(contractABI, _logsEventFlag.Value().(string), flag.WithArgumentsFlag.Value().(string))
+	if err != nil {
+		return err
+	}
+	from, to := _logsFromFlag.Value().(uint64), _logsToFlag.Value().(uint64)
+
+	conn, err := util.ConnectToEndpoint(config.ReadConfig.SecureConnect && !config.Insecure)
+	if err != nil {
+		return output.NewError(output.NetworkError, "failed to connect to endpoint", err)
+	}
+	defer conn.Close()
+	cli := iotexapi.NewAPIServiceClient(conn)
+	ctx := context.Background()
+	if jwtMD, err := util.JwtAuth(); err == nil {
+		ctx = metautils.NiceMD(jwtMD).ToOutgoing(ctx)
+	}
+	var tip uint64
+	if from == 0 || to == 0 {
+		chainMeta, err := cli.GetChainMeta(ctx, &iotexapi.GetChainMetaRequest{})
+		if err != nil {
+			return logsAPIError(err, "failed to invoke GetChainMeta api")
+		}
+		tip = chainMeta.GetChainMeta().GetHeight()
+	}
+	if from, to, err = logsRange(from, to, tip); err != nil {
+		return err
+	}
+
+	filter := &iotexapi.LogsFilter{
+		Address: []string{contract},
+		Topics:  topics,
+	}
+	message := contractLogsMessage{Logs: []*contractLog{}}
+	// query in windows of _logsPaginationSize blocks so that no block is left out by pagination
+	for start := from; start <= to; start += _logsPaginationSize {
+		end := start + _logsPaginationSize - 1
+		if end > to {
+			end = to
+		}
+		resp, err := cli.GetLogs(ctx, &iotexapi.GetLogsRequest{
+			Filter: filter,
+			Lookup: &iotexapi.GetLogsRequest_ByRange{
+				ByRange: &iotexapi.GetLogsByRange{
+					FromBlock:      start,
+					ToBlock:        end,
+					PaginationSize: _logsPaginationSize,
+				},
+			},
+		})
+		if err != nil {
+			return logsAPIError(err, "failed to invoke GetLogs api")
+		}
+		for _, l := range resp.GetLogs() {
+			decoded, err := decodeContractLog(contractABI, l)
+			if err != nil {
+				return err
+			}
+			message.Logs = append(message.Logs, decoded)
+		}
+	}
+	fmt.Println(message.String())
+	return nil
+}
+
+// logsRange applies the defaults to the unset heights of the range and validates it. The end height defaults to the
+// tip, and the start height defaults to the tip or the end height, whichever is lower.
+func logsRange(from, to, tip uint64) (uint64, uint64, error) {
+	if to == 0 {
+		to = tip
+	}
+	if from == 0 {
+		from = tip
+		if from > to {
+			from = to
+		}
+	}
+	if from > to {
+		return 0, 0, output.NewError(output.FlagError, "start height is larger than end height", nil)
+	}
+	return from, to, nil
+}
+
+// eventTopics returns the topics filter of the event, with the indexed arguments in rowInput if any
+func eventTopics(contractABI *abi.ABI, eventName, rowInput string) ([]*iotexapi.Topics, error) {
+	if eventName == "" {
+		if rowInput != "" {
+			return nil, output.NewError(output.FlagError, "event name is required to filter by arguments", nil)
+		}
+		ids := &iotexapi.Topics{}
+		for _, event := range contractABI.Events {
+			ids.Topic = append(ids.Topic, event.ID.Bytes())
+		}
+		return []*iotexapi.Topics{ids}, nil
+	}
+	event, ok := contractABI.Events[eventName]
+	if !ok {
+		return nil, output.NewError(output.InputError, "event "+eventName+" is not found in abi", nil)
+	}
+	topics := []*iotexapi.Topics{{Topic: [][]byte{event.ID.Bytes()}}}
+	if rowInput == "" {
+		return topics, nil
+	}
+	input, err := parseInput(rowInput)
+	if err != nil {
+		return nil, err
+	}
+	var query [][]interface{}
+	for _, arg := range event.Inputs {
+		if !arg.Indexed {
+			if _, ok := input[arg.Name]; ok {
+				return nil, output.NewError(output.InputError, "argument "+arg.Name+" is not indexed", nil)
+			}
+			continue
+		}
+		v, ok := input[arg.Name]
+		if !ok {
+			query = append(query, nil)
+			continue
+		}
+		parsed, err := parseInputArgument(&arg.Type, v)
+		if err != nil {
+			return nil, output.NewError(output.InputError, "invalid argument "+arg.Name, err)
+		}
+		query = append(query, []interface{}{parsed})
+	}
+	hashes, err := abi.MakeTopics(query...)
+	if err != nil {
+		return nil, output.NewError(output.ConvertError, "failed to make topics from arguments", err)
+	}
+	for _, h := range hashes {
+		t := &iotexapi.Topics{}
+		for _, topic := range h {
+			t.Topic = append(t.Topic, topic.Bytes())
+		}
+		topics = append(topics, t)
+	}
+	// trailing wildcards are redundant
+	for len(topics) > 1 && len(topics[len(topics)-1].Topic) == 0 {
+		topics = topics[:len(topics)-1]
+	}
+	return topics, nil
+}
+
+func decodeContractLog(contractABI *abi.ABI, l *iotextypes.Log) (*contractLog, error) {
+	decoded, err := util.DecodeLog(contractABI, l.Topics, l.Data)
+	if err != nil {
+		return nil, output.NewError(output.SerializationError, "failed to decode log", err)
+	}
+	ret := &contractLog{
+		BlockHeight: l.BlkHeight,
+		ActionHash:  hex.EncodeToString(l.ActHash),
+		Index:       l.Index,
+		Contract:    l.ContractAddress,
+	}
+	if decoded == nil {
+		ret.Event = "unknown"
+		if len(l.Topics) > 0 {
+			ret.Event += "(" + common.BytesToHash(l.Topics[0]).Hex() + ")"
+		}
+		return ret, nil
+	}
+	ret.Event = decoded.Event
+	ret.Args = decoded.Args
+	return ret, nil
+}
+
+func logsAPIError(err error, msg string) error {
+	if sta, ok := status.FromError(err); ok {
+		return output.NewError(output.APIError, sta.Message(), nil)
+	}
+	return output.NewError(output.NetworkError, msg, err)
+}
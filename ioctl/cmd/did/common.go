@@ -119,12 +119,19 @@ func loadPrivateKey() (*ecdsa.PrivateKey, string, error) {
 	if err != nil {
 		return nil, "", output.NewError(output.InputError, "failed to decrypt key", err)
 	}
+	if account.IsExternalKey(pri) {
+		return nil, "", output.NewError(output.InputError, "failed to load private key", account.ErrExternalKey)
+	}
+	key, ok := pri.EcdsaPrivateKey().(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, "", output.NewError(output.CryptoError, "private key is not a secp256k1 key", nil)
+	}
 	ethAddress, err := addrutil.IoAddrToEvmAddr(signer)
 	if err != nil {
 		return nil, "", output.NewError(output.AddressError, "", err)
 	}
 
-	return pri.EcdsaPrivateKey().(*ecdsa.PrivateKey), ethAddress.String(), nil
+	return key, ethAddress.String(), nil
 }
 
 // loadPublicKey load public key by private key
@@ -74,6 +74,10 @@ func jwtSign() error {
 	if err != nil {
 		return err
 	}
+	// the JWT is signed with the raw ecdsa key, which an external signer never reveals
+	if account.IsExternalKey(prvKey) {
+		return output.NewError(output.CryptoError, "failed to sign JWT token", account.ErrExternalKey)
+	}
 	pubKey := prvKey.PublicKey()
 	addr := pubKey.Address()
 
@@ -38,7 +38,10 @@ var NodeCmd = &cobra.Command{
 func init() {
 	NodeCmd.AddCommand(_nodeDelegateCmd)
 	NodeCmd.AddCommand(_nodeRewardCmd)
+	_nodeRewardCmd.AddCommand(_nodeRewardReportCmd)
+	_nodeRewardCmd.AddCommand(_nodeRewardAutoclaimCmd)
 	NodeCmd.AddCommand(_nodeProbationlistCmd)
+	NodeCmd.AddCommand(_nodeConsensusCmd)
 	NodeCmd.PersistentFlags().StringVar(&config.ReadConfig.Endpoint, "endpoint",
 		config.ReadConfig.Endpoint, config.TranslateInLang(_flagEndpointUsages, config.UILanguage))
 	NodeCmd.PersistentFlags().BoolVar(&config.Insecure, "insecure", config.Insecure,
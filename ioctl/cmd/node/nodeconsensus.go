@@ -0,0 +1,122 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/api/apipb"
+	"github.com/iotexproject/iotex-core/ioctl/config"
+	"github.com/iotexproject/iotex-core/ioctl/output"
+	"github.com/iotexproject/iotex-core/ioctl/util"
+)
+
+// Multi-language support
+var (
+	_consensusCmdUses = map[config.Language]string{
+		config.English: "consensus HEIGHT|pending|latest",
+		config.Chinese: "consensus 高度|pending|latest",
+	}
+	_consensusCmdShorts = map[config.Language]string{
+		config.English: "Print the consensus timeline of a block height",
+		config.Chinese: "打印区块高度的共识时间线",
+	}
+)
+
+// _nodeConsensusCmd represents the node consensus command
+var _nodeConsensusCmd = &cobra.Command{
+	Use:   config.TranslateInLang(_consensusCmdUses, config.UILanguage),
+	Short: config.TranslateInLang(_consensusCmdShorts, config.UILanguage),
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		err := consensusTimeline(args[0])
+		return output.PrintError(err)
+	},
+}
+
+type consensusTimelineMessage struct {
+	*apipb.ConsensusTimeline
+}
+
+func (m *consensusTimelineMessage) String() string {
+	if output.Format != "" {
+		return output.FormatString(output.Result, m)
+	}
+	lines := []string{fmt.Sprintf("Height: %d, FinalState: %s", m.Height, m.FinalState)}
+	for _, r := range m.Rounds {
+		lines = append(lines, fmt.Sprintf("Round %d: epoch %d, proposer %s, start %s, next round %s",
+			r.Round, r.EpochNum, r.Proposer, formatTimestamp(r.StartTime.AsTime()), formatTimestamp(r.NextRoundStartTime.AsTime())))
+	}
+	for _, p := range m.Proposals {
+		lines = append(lines, fmt.Sprintf("Proposal %s from %s, received at %s",
+			p.BlockHash, p.Endorser, formatTimestamp(p.ReceivedAt.AsTime())))
+	}
+	for _, e := range m.Endorsements {
+		lines = append(lines, fmt.Sprintf("Endorsement %s of %s from %s in round %d, received at %s",
+			e.Topic, e.BlockHash, e.Endorser, e.Round, formatTimestamp(e.ReceivedAt.AsTime())))
+	}
+	for _, t := range m.Transitions {
+		lines = append(lines, fmt.Sprintf("%s: %s -> %s (%s)",
+			formatTimestamp(t.Time.AsTime()), t.From, t.To, t.Event))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func formatTimestamp(t time.Time) string {
+	return t.Format(time.RFC3339Nano)
+}
+
+func consensusTimeline(arg string) error {
+	conn, err := util.ConnectToEndpoint(config.ReadConfig.SecureConnect && !config.Insecure)
+	if err != nil {
+		return output.NewError(output.NetworkError, "failed to connect to endpoint", err)
+	}
+	defer conn.Close()
+	ctx := context.Background()
+	jwtMD, err := util.JwtAuth()
+	if err == nil {
+		ctx = metautils.NiceMD(jwtMD).ToOutgoing(ctx)
+	}
+
+	// zero height asks for the height in consensus
+	var height uint64
+	switch arg {
+	case "pending":
+	case "latest":
+		chainMeta, err := iotexapi.NewAPIServiceClient(conn).GetChainMeta(ctx, &iotexapi.GetChainMetaRequest{})
+		if err != nil {
+			return apiError(err, "failed to invoke GetChainMeta api")
+		}
+		height = chainMeta.ChainMeta.Height
+	default:
+		if height, err = strconv.ParseUint(arg, 10, 64); err != nil {
+			return output.NewError(output.ValidationError, "invalid height", err)
+		}
+	}
+	response, err := apipb.NewConsensusServiceClient(conn).GetConsensusTimeline(ctx, &apipb.GetConsensusTimelineRequest{Height: height})
+	if err != nil {
+		return apiError(err, "failed to invoke GetConsensusTimeline api")
+	}
+	fmt.Println((&consensusTimelineMessage{response.Timeline}).String())
+	return nil
+}
+
+func apiError(err error, msg string) error {
+	if sta, ok := status.FromError(err); ok {
+		return output.NewError(output.APIError, sta.Message(), nil)
+	}
+	return output.NewError(output.NetworkError, msg, err)
+}
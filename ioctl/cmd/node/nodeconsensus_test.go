@@ -0,0 +1,32 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package node
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/iotexproject/iotex-core/api/apipb"
+)
+
+func TestConsensusTimelineMessage(t *testing.T) {
+	require := require.New(t)
+	ts := timestamppb.New(time.Unix(1700000000, 0).UTC())
+	msg := &consensusTimelineMessage{&apipb.ConsensusTimeline{
+		Height:       10,
+		FinalState:   "S_PREPARE",
+		Rounds:       []*apipb.RoundRecord{{Round: 1, EpochNum: 2, Proposer: "io1a", StartTime: ts, NextRoundStartTime: ts}},
+		Endorsements: []*apipb.ConsensusMessageRecord{{Topic: "LOCK", BlockHash: "0102", Endorser: "io1b", Round: 1, ReceivedAt: ts}},
+		Transitions:  []*apipb.StateTransition{{Event: "E_PREPARE", From: "S_EPOCH_START", To: "S_PREPARE", Time: ts}},
+	}}
+	require.Equal("Height: 10, FinalState: S_PREPARE\n"+
+		"Round 1: epoch 2, proposer io1a, start 2023-11-14T22:13:20Z, next round 2023-11-14T22:13:20Z\n"+
+		"Endorsement LOCK of 0102 from io1b in round 1, received at 2023-11-14T22:13:20Z\n"+
+		"2023-11-14T22:13:20Z: S_EPOCH_START -> S_PREPARE (E_PREPARE)", msg.String())
+}
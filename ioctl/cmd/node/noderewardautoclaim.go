@@ -0,0 +1,566 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/staking"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/ioctl/cmd/account"
+	"github.com/iotexproject/iotex-core/ioctl/cmd/bc"
+	"github.com/iotexproject/iotex-core/ioctl/config"
+	"github.com/iotexproject/iotex-core/ioctl/output"
+	"github.com/iotexproject/iotex-core/ioctl/util"
+)
+
+// Multi-language support
+var (
+	_rewardAutoclaimCmdUses = map[config.Language]string{
+		config.English: "autoclaim --threshold AMOUNT_IOTX [--interval DURATION] [--once] " +
+			"[--candidate NAME --redistribute PERCENT] [--progress FILE] [-s SIGNER] [-P PASSWORD] [-p GAS_PRICE]",
+		config.Chinese: "autoclaim --threshold IOTX数量 [--interval 间隔] [--once] " +
+			"[--candidate 名称 --redistribute 百分比] [--progress 文件] [-s 签署人] [-P 密码] [-p GAS价格]",
+	}
+	_rewardAutoclaimCmdShorts = map[config.Language]string{
+		config.English: "Claim rewards whenever the unclaimed balance exceeds a threshold, and optionally redistribute to voters",
+		config.Chinese: "当未支取奖励超过阈值时自动支取，并可按投票权重分配给投票人",
+	}
+	_flagThresholdUsages = map[config.Language]string{
+		config.English: "claim when the unclaimed balance reaches this amount of IOTX",
+		config.Chinese: "当未支取奖励达到该IOTX数量时支取",
+	}
+	_flagIntervalUsages = map[config.Language]string{
+		config.English: "interval between two checks of the unclaimed balance",
+		config.Chinese: "两次检查未支取奖励之间的间隔",
+	}
+	_flagOnceUsages = map[config.Language]string{
+		config.English: "check and claim once, then exit",
+		config.Chinese: "只检查并支取一次后退出",
+	}
+	_flagCandidateUsages = map[config.Language]string{
+		config.English: "candidate name whose voters receive the redistribution",
+		config.Chinese: "接收分配的投票人所投的候选人名称",
+	}
+	_flagRedistributeUsages = map[config.Language]string{
+		config.English: "percentage of the claimed amount redistributed to voters by bucket weight",
+		config.Chinese: "按投票权重分配给投票人的支取数量百分比",
+	}
+	_flagProgressUsages = map[config.Language]string{
+		config.English: "file to persist the progress of a claim and its redistribution, default to autoclaim.json in the config directory",
+		config.Chinese: "保存支取和分配进度的文件，默认为配置目录下的autoclaim.json",
+	}
+	_flagAutoclaimSignerUsages = map[config.Language]string{
+		config.English: "choose a signing account",
+		config.Chinese: "选择要签名的帐户",
+	}
+	_flagAutoclaimPasswordUsages = map[config.Language]string{
+		config.English: "input password for account",
+		config.Chinese: "给账户输入密码",
+	}
+	_flagAutoclaimGasPriceUsages = map[config.Language]string{
+		config.English: "set gas price (unit: 10^(-6)IOTX), use suggested gas price if input is empty",
+		config.Chinese: "设置gas价格（单位：10^(-6)IOTX），如果输入为空，则使用默认gas价格",
+	}
+)
+
+const (
+	// _autoclaimReceiptTimeout is the time to wait for the receipt of a sent action
+	_autoclaimReceiptTimeout = 2 * time.Minute
+	// _autoclaimBucketPageSize is the number of buckets read in one request
+	_autoclaimBucketPageSize = 1000
+)
+
+var (
+	_autoclaimThreshold    string
+	_autoclaimInterval     time.Duration
+	_autoclaimOnce         bool
+	_autoclaimCandidate    string
+	_autoclaimRedistribute uint64
+	_autoclaimProgress     string
+	_autoclaimSigner       string
+	_autoclaimPassword     string
+	_autoclaimGasPrice     string
+)
+
+// _nodeRewardAutoclaimCmd represents the node reward autoclaim command
+var _nodeRewardAutoclaimCmd = &cobra.Command{
+	Use:   config.TranslateInLang(_rewardAutoclaimCmdUses, config.UILanguage),
+	Short: config.TranslateInLang(_rewardAutoclaimCmdShorts, config.UILanguage),
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		err := autoclaim()
+		return output.PrintError(err)
+	},
+}
+
+type (
+	// voterShare is the amount redistributed to a voter
+	voterShare struct {
+		Voter  string `json:"voter"`
+		Weight string `json:"weight"`
+		Amount string `json:"amount"`
+		Hash   string `json:"hash,omitempty"`
+		amount *big.Int
+	}
+
+	autoclaimMessage struct {
+		Time      string        `json:"time"`
+		Unclaimed string        `json:"unclaimed"`
+		Claimed   bool          `json:"claimed"`
+		ClaimHash string        `json:"claimHash,omitempty"`
+		Shares    []*voterShare `json:"shares,omitempty"`
+	}
+
+	// autoclaimProgress is the progress of a claim and its redistribution. It is persisted after each step, so that
+	// the shares of a sent claim are still sent after a failed round or a restart.
+	autoclaimProgress struct {
+		Unclaimed string        `json:"unclaimed"`
+		ClaimHash string        `json:"claimHash"`
+		Shares    []*voterShare `json:"shares,omitempty"`
+	}
+
+	// autoclaimer holds the states shared by the rounds of autoclaim
+	autoclaimer struct {
+		cli          iotexapi.APIServiceClient
+		ctx          context.Context
+		prvKey       crypto.PrivateKey
+		signer       string
+		threshold    *big.Int
+		gasPrice     *big.Int
+		chainID      uint32
+		candidate    string
+		redistribute uint64
+		progressFile string
+		voterWeights func(candidate, exclude string) (map[string]*big.Int, error)
+	}
+)
+
+func (m *autoclaimMessage) String() string {
+	if output.Format == "" {
+		if !m.Claimed {
+			return fmt.Sprintf("%s unclaimed %s IOTX, below threshold", m.Time, m.Unclaimed)
+		}
+		message := fmt.Sprintf("%s claimed %s IOTX in action %s", m.Time, m.Unclaimed, m.ClaimHash)
+		for _, s := range m.Shares {
+			message += fmt.Sprintf("\n  sent %s IOTX to %s in action %s", s.Amount, s.Voter, s.Hash)
+		}
+		return message
+	}
+	return output.FormatString(output.Result, m)
+}
+
+func init() {
+	flags := _nodeRewardAutoclaimCmd.Flags()
+	flags.StringVar(&_autoclaimThreshold, "threshold", "",
+		config.TranslateInLang(_flagThresholdUsages, config.UILanguage))
+	flags.DurationVar(&_autoclaimInterval, "interval", time.Hour,
+		config.TranslateInLang(_flagIntervalUsages, config.UILanguage))
+	flags.BoolVar(&_autoclaimOnce, "once", false,
+		config.TranslateInLang(_flagOnceUsages, config.UILanguage))
+	flags.StringVar(&_autoclaimCandidate, "candidate", "",
+		config.TranslateInLang(_flagCandidateUsages, config.UILanguage))
+	flags.Uint64Var(&_autoclaimRedistribute, "redistribute", 0,
+		config.TranslateInLang(_flagRedistributeUsages, config.UILanguage))
+	flags.StringVar(&_autoclaimProgress, "progress", "",
+		config.TranslateInLang(_flagProgressUsages, config.UILanguage))
+	flags.StringVarP(&_autoclaimSigner, "signer", "s", "",
+		config.TranslateInLang(_flagAutoclaimSignerUsages, config.UILanguage))
+	flags.StringVarP(&_autoclaimPassword, "password", "P", "",
+		config.TranslateInLang(_flagAutoclaimPasswordUsages, config.UILanguage))
+	flags.StringVarP(&_autoclaimGasPrice, "gas-price", "p", "",
+		config.TranslateInLang(_flagAutoclaimGasPriceUsages, config.UILanguage))
+	_ = _nodeRewardAutoclaimCmd.MarkFlagRequired("threshold")
+}
+
+func autoclaim() error {
+	threshold, err := util.StringToRau(_autoclaimThreshold, util.IotxDecimalNum)
+	if err != nil {
+		return output.NewError(output.ConvertError, "invalid threshold", err)
+	}
+	if _autoclaimRedistribute > 100 {
+		return output.NewError(output.FlagError, "redistribution percentage should be no more than 100", nil)
+	}
+	if _autoclaimRedistribute > 0 && _autoclaimCandidate == "" {
+		return output.NewError(output.FlagError, "candidate name is required to redistribute rewards", nil)
+	}
+	if !_autoclaimOnce && _autoclaimInterval <= 0 {
+		return output.NewError(output.FlagError, "interval should be positive", nil)
+	}
+	signer := _autoclaimSigner
+	if signer == "" {
+		if signer, err = config.GetContextAddressOrAlias(); err != nil {
+			return output.NewError(output.AddressError, "failed to get signer address", err)
+		}
+	}
+	if signer, err = util.GetAddress(signer); err != nil {
+		return output.NewError(output.AddressError, "failed to get signer address", err)
+	}
+	prvKey, err := account.PrivateKeyFromSigner(signer, _autoclaimPassword)
+	if err != nil {
+		return err
+	}
+	defer prvKey.Zero()
+
+	conn, err := util.ConnectToEndpoint(config.ReadConfig.SecureConnect && !config.Insecure)
+	if err != nil {
+		return output.NewError(output.NetworkError, "failed to connect to endpoint", err)
+	}
+	defer conn.Close()
+	c := &autoclaimer{
+		cli:          iotexapi.NewAPIServiceClient(conn),
+		ctx:          context.Background(),
+		prvKey:       prvKey,
+		signer:       signer,
+		threshold:    threshold,
+		candidate:    _autoclaimCandidate,
+		redistribute: _autoclaimRedistribute,
+		progressFile: _autoclaimProgress,
+		voterWeights: candidateVoterWeights,
+	}
+	if c.progressFile == "" {
+		c.progressFile = filepath.Join(config.ConfigDir, "autoclaim.json")
+	}
+	if jwtMD, err := util.JwtAuth(); err == nil {
+		c.ctx = metautils.NiceMD(jwtMD).ToOutgoing(c.ctx)
+	}
+	chainMeta, err := c.cli.GetChainMeta(c.ctx, &iotexapi.GetChainMetaRequest{})
+	if err != nil {
+		return rewardAPIError(err, "failed to invoke GetChainMeta api")
+	}
+	c.chainID = chainMeta.GetChainMeta().GetChainID()
+	if _autoclaimGasPrice != "" {
+		if c.gasPrice, err = util.StringToRau(_autoclaimGasPrice, util.GasPriceDecimalNum); err != nil {
+			return output.NewError(output.ConvertError, "invalid gas price", err)
+		}
+	}
+
+	for {
+		message, err := c.round()
+		switch {
+		case err != nil && _autoclaimOnce:
+			return err
+		case err != nil:
+			// a failed round is retried in the next interval rather than stopping the daemon
+			output.PrintResult(time.Now().Format(time.RFC3339) + " autoclaim failed: " + err.Error())
+		default:
+			fmt.Println(message.String())
+		}
+		if _autoclaimOnce {
+			return nil
+		}
+		time.Sleep(_autoclaimInterval)
+	}
+}
+
+// round claims the unclaimed balance if it reaches the threshold, and redistributes part of it if enabled. A claim
+// left unfinished by a previous round is resumed first.
+func (c *autoclaimer) round() (*autoclaimMessage, error) {
+	progress, err := c.loadProgress()
+	if err != nil {
+		return nil, err
+	}
+	if progress != nil {
+		return c.finish(progress)
+	}
+
+	response, err := c.cli.ReadState(c.ctx, &iotexapi.ReadStateRequest{
+		ProtocolID: []byte("rewarding"),
+		MethodName: []byte("UnclaimedBalance"),
+		Arguments:  [][]byte{[]byte(c.signer)},
+	})
+	if err != nil {
+		return nil, rewardAPIError(err, "failed to invoke ReadState api")
+	}
+	unclaimed, ok := new(big.Int).SetString(string(response.Data), 10)
+	if !ok {
+		return nil, output.NewError(output.ConvertError, "failed to convert string into big int", nil)
+	}
+	message := &autoclaimMessage{
+		Time:      time.Now().Format(time.RFC3339),
+		Unclaimed: util.RauToString(unclaimed, util.IotxDecimalNum),
+	}
+	if unclaimed.Sign() == 0 || unclaimed.Cmp(c.threshold) < 0 {
+		return message, nil
+	}
+
+	// compute the shares before claiming, so that a failure of reading buckets does not leave rewards undistributed
+	var shares []*voterShare
+	if c.redistribute > 0 {
+		weights, err := c.voterWeights(c.candidate, c.signer)
+		if err != nil {
+			return nil, err
+		}
+		total := new(big.Int).Mul(unclaimed, new(big.Int).SetUint64(c.redistribute))
+		shares = splitByWeight(total.Div(total, big.NewInt(100)), weights)
+	}
+
+	nonce, err := c.pendingNonce()
+	if err != nil {
+		return nil, err
+	}
+	eb, err := c.envelope(nonce, action.ClaimFromRewardingFundBaseGas)
+	if err != nil {
+		return nil, err
+	}
+	claim := (&action.ClaimFromRewardingFundBuilder{}).SetAmount(unclaimed).Build()
+	hash, err := c.send(eb.SetAction(&claim).Build())
+	if err != nil {
+		return nil, err
+	}
+	progress = &autoclaimProgress{
+		Unclaimed: message.Unclaimed,
+		ClaimHash: hash,
+		Shares:    shares,
+	}
+	if err := c.saveProgress(progress); err != nil {
+		return nil, err
+	}
+	return c.finish(progress)
+}
+
+// finish waits for the claim of the progress and sends the shares not sent yet, persisting the progress after each
+// share. The progress is removed once all shares are sent, or if the claim fails.
+func (c *autoclaimer) finish(progress *autoclaimProgress) (*autoclaimMessage, error) {
+	receipt, err := c.waitReceipt(progress.ClaimHash)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != uint64(iotextypes.ReceiptStatus_Success) {
+		if err := c.removeProgress(); err != nil {
+			return nil, err
+		}
+		return nil, output.NewError(output.RuntimeError, fmt.Sprintf("action %s failed with status %d", progress.ClaimHash, receipt.Status), nil)
+	}
+	var nonce uint64
+	for _, s := range progress.Shares {
+		if s.Hash != "" {
+			continue
+		}
+		if nonce == 0 {
+			if nonce, err = c.pendingNonce(); err != nil {
+				return nil, err
+			}
+		}
+		tx, err := action.NewTransfer(nonce, s.amount, s.Voter, nil, action.TransferBaseIntrinsicGas, nil)
+		if err != nil {
+			return nil, output.NewError(output.InstantiationError, "failed to create transfer", err)
+		}
+		eb, err := c.envelope(nonce, action.TransferBaseIntrinsicGas)
+		if err != nil {
+			return nil, err
+		}
+		if s.Hash, err = c.send(eb.SetAction(tx).Build()); err != nil {
+			return nil, err
+		}
+		if err := c.saveProgress(progress); err != nil {
+			return nil, err
+		}
+		nonce++
+	}
+	if err := c.removeProgress(); err != nil {
+		return nil, err
+	}
+	return &autoclaimMessage{
+		Time:      time.Now().Format(time.RFC3339),
+		Unclaimed: progress.Unclaimed,
+		Claimed:   true,
+		ClaimHash: progress.ClaimHash,
+		Shares:    progress.Shares,
+	}, nil
+}
+
+func (c *autoclaimer) loadProgress() (*autoclaimProgress, error) {
+	data, err := os.ReadFile(filepath.Clean(c.progressFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, output.NewError(output.ReadFileError, "failed to read progress file "+c.progressFile, err)
+	}
+	progress := &autoclaimProgress{}
+	if err := json.Unmarshal(data, progress); err != nil {
+		return nil, output.NewError(output.SerializationError, "failed to unmarshal progress file "+c.progressFile, err)
+	}
+	for _, s := range progress.Shares {
+		if s.amount, err = util.StringToRau(s.Amount, util.IotxDecimalNum); err != nil {
+			return nil, output.NewError(output.ConvertError, "invalid amount of share to "+s.Voter, err)
+		}
+	}
+	return progress, nil
+}
+
+// saveProgress writes the progress to a temporary file and renames it, so that the file is never left half written
+func (c *autoclaimer) saveProgress(progress *autoclaimProgress) error {
+	data, err := json.MarshalIndent(progress, "", "  ")
+	if err != nil {
+		return output.NewError(output.SerializationError, "failed to marshal progress", err)
+	}
+	tmp := c.progressFile + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return output.NewError(output.WriteFileError, "failed to write progress file "+tmp, err)
+	}
+	if err := os.Rename(tmp, c.progressFile); err != nil {
+		return output.NewError(output.WriteFileError, "failed to write progress file "+c.progressFile, err)
+	}
+	return nil
+}
+
+func (c *autoclaimer) removeProgress() error {
+	if err := os.Remove(c.progressFile); err != nil && !os.IsNotExist(err) {
+		return output.NewError(output.WriteFileError, "failed to remove progress file "+c.progressFile, err)
+	}
+	return nil
+}
+
+func (c *autoclaimer) pendingNonce() (uint64, error) {
+	resp, err := c.cli.GetAccount(c.ctx, &iotexapi.GetAccountRequest{Address: c.signer})
+	if err != nil {
+		return 0, rewardAPIError(err, "failed to invoke GetAccount api")
+	}
+	return resp.GetAccountMeta().GetPendingNonce(), nil
+}
+
+// envelope returns the envelope builder with the common fields set
+func (c *autoclaimer) envelope(nonce, gasLimit uint64) (*action.EnvelopeBuilder, error) {
+	gasPrice := c.gasPrice
+	if gasPrice == nil {
+		resp, err := c.cli.SuggestGasPrice(c.ctx, &iotexapi.SuggestGasPriceRequest{})
+		if err != nil {
+			return nil, rewardAPIError(err, "failed to invoke SuggestGasPrice api")
+		}
+		gasPrice = new(big.Int).SetUint64(resp.GasPrice)
+	}
+	return (&action.EnvelopeBuilder{}).
+		SetNonce(nonce).
+		SetGasLimit(gasLimit).
+		SetGasPrice(gasPrice).
+		SetChainID(c.chainID), nil
+}
+
+func (c *autoclaimer) send(elp action.Envelope) (string, error) {
+	sealed, err := action.Sign(elp, c.prvKey)
+	if err != nil {
+		return "", output.NewError(output.CryptoError, "failed to sign action", err)
+	}
+	resp, err := c.cli.SendAction(c.ctx, &iotexapi.SendActionRequest{Action: sealed.Proto()})
+	if err != nil {
+		return "", rewardAPIError(err, "failed to invoke SendAction api")
+	}
+	return resp.ActionHash, nil
+}
+
+func (c *autoclaimer) waitReceipt(hash string) (*iotextypes.Receipt, error) {
+	for deadline := time.Now().Add(_autoclaimReceiptTimeout); time.Now().Before(deadline); time.Sleep(time.Second) {
+		resp, err := c.cli.GetReceiptByAction(c.ctx, &iotexapi.GetReceiptByActionRequest{ActionHash: hash})
+		if status.Code(err) == codes.NotFound {
+			continue
+		}
+		if err != nil {
+			return nil, rewardAPIError(err, "failed to invoke GetReceiptByAction api")
+		}
+		return resp.GetReceiptInfo().GetReceipt(), nil
+	}
+	return nil, output.NewError(output.NetworkError, "timeout waiting for receipt of action "+hash, nil)
+}
+
+// candidateVoterWeights returns the total vote weight of each voter of the candidate, excluding unstaked buckets
+// and the buckets owned by exclude
+func candidateVoterWeights(candidate, exclude string) (map[string]*big.Int, error) {
+	weights := make(map[string]*big.Int)
+	for offset := uint32(0); ; offset += _autoclaimBucketPageSize {
+		bl, err := bc.GetBucketList(iotexapi.ReadStakingDataMethod_BUCKETS_BY_CANDIDATE, &iotexapi.ReadStakingDataRequest{
+			Request: &iotexapi.ReadStakingDataRequest_BucketsByCandidate{
+				BucketsByCandidate: &iotexapi.ReadStakingDataRequest_VoteBucketsByCandidate{
+					CandName: candidate,
+					Pagination: &iotexapi.PaginationParam{
+						Offset: offset,
+						Limit:  _autoclaimBucketPageSize,
+					},
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range bl.GetBuckets() {
+			if b.Owner == exclude || b.UnstakeStartTime.AsTime().After(b.StakeStartTime.AsTime()) {
+				continue
+			}
+			weight, err := bucketWeight(b)
+			if err != nil {
+				return nil, output.NewError(output.ConvertError, fmt.Sprintf("invalid bucket %d", b.Index), err)
+			}
+			if w, ok := weights[b.Owner]; ok {
+				w.Add(w, weight)
+			} else {
+				weights[b.Owner] = weight
+			}
+		}
+		if len(bl.GetBuckets()) < _autoclaimBucketPageSize {
+			return weights, nil
+		}
+	}
+}
+
+func bucketWeight(b *iotextypes.VoteBucket) (*big.Int, error) {
+	amount, ok := new(big.Int).SetString(b.StakedAmount, 10)
+	if !ok {
+		return nil, errors.Errorf("invalid staked amount %s", b.StakedAmount)
+	}
+	return staking.CalculateVoteWeight(genesis.Default.VoteWeightCalConsts, &staking.VoteBucket{
+		StakedAmount:   amount,
+		StakedDuration: time.Duration(b.StakedDuration) * 24 * time.Hour,
+		AutoStake:      b.AutoStake,
+	}, false), nil
+}
+
+// splitByWeight splits the amount among voters in proportion to their weights, voters whose share rounds
+// down to zero are omitted
+func splitByWeight(amount *big.Int, weights map[string]*big.Int) []*voterShare {
+	total := new(big.Int)
+	for _, w := range weights {
+		total.Add(total, w)
+	}
+	if total.Sign() == 0 || amount.Sign() == 0 {
+		return nil
+	}
+	shares := make([]*voterShare, 0, len(weights))
+	for voter, w := range weights {
+		share := new(big.Int).Mul(amount, w)
+		if share.Div(share, total).Sign() == 0 {
+			continue
+		}
+		shares = append(shares, &voterShare{
+			Voter:  voter,
+			Weight: w.String(),
+			Amount: util.RauToString(share, util.IotxDecimalNum),
+			amount: share,
+		})
+	}
+	sort.Slice(shares, func(i, j int) bool {
+		return shares[i].Voter < shares[j].Voter
+	})
+	return shares
+}
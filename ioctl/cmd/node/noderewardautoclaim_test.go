@@ -0,0 +1,136 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package node
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi/mock_iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/pkg/unit"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestAutoclaimRound(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	cli := mock_iotexapi.NewMockAPIServiceClient(ctrl)
+	prvKey, err := crypto.GenerateKey()
+	require.NoError(err)
+	c := &autoclaimer{
+		cli:          cli,
+		ctx:          context.Background(),
+		prvKey:       prvKey,
+		signer:       identityset.Address(0).String(),
+		threshold:    unit.ConvertIotxToRau(10),
+		gasPrice:     big.NewInt(unit.Qev),
+		candidate:    "delegate",
+		redistribute: 50,
+		progressFile: filepath.Join(t.TempDir(), "autoclaim.json"),
+		voterWeights: func(candidate, exclude string) (map[string]*big.Int, error) {
+			return map[string]*big.Int{
+				identityset.Address(1).String(): big.NewInt(1),
+				identityset.Address(2).String(): big.NewInt(3),
+			}, nil
+		},
+	}
+	var (
+		sent     []string
+		failSend = true
+	)
+	cli.EXPECT().ReadState(gomock.Any(), gomock.Any()).Return(&iotexapi.ReadStateResponse{
+		Data: []byte(unit.ConvertIotxToRau(100).String()),
+	}, nil).Times(1)
+	cli.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(&iotexapi.GetAccountResponse{
+		AccountMeta: &iotextypes.AccountMeta{PendingNonce: 1},
+	}, nil).AnyTimes()
+	cli.EXPECT().SendAction(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *iotexapi.SendActionRequest, _ ...interface{}) (*iotexapi.SendActionResponse, error) {
+			// the second share fails to be sent in the first round
+			if len(sent) == 2 && failSend {
+				failSend = false
+				return nil, status.Error(codes.Unavailable, "endpoint unavailable")
+			}
+			hash := string(rune('a' + len(sent)))
+			sent = append(sent, hash)
+			return &iotexapi.SendActionResponse{ActionHash: hash}, nil
+		}).AnyTimes()
+	cli.EXPECT().GetReceiptByAction(gomock.Any(), &iotexapi.GetReceiptByActionRequest{ActionHash: "a"}).Return(
+		&iotexapi.GetReceiptByActionResponse{
+			ReceiptInfo: &iotexapi.ReceiptInfo{
+				Receipt: &iotextypes.Receipt{Status: uint64(iotextypes.ReceiptStatus_Success)},
+			},
+		}, nil).Times(2)
+
+	// the claim and the first share are sent, and the progress is persisted
+	_, err = c.round()
+	require.Error(err)
+	require.Len(sent, 2)
+	progress, err := c.loadProgress()
+	require.NoError(err)
+	require.Equal("a", progress.ClaimHash)
+	require.Equal("100", progress.Unclaimed)
+	require.Len(progress.Shares, 2)
+	require.Equal("b", progress.Shares[0].Hash)
+	require.ElementsMatch([]string{"12.5", "37.5"}, []string{progress.Shares[0].Amount, progress.Shares[1].Amount})
+	require.Empty(progress.Shares[1].Hash)
+
+	// the next round resumes the progress instead of claiming again
+	message, err := c.round()
+	require.NoError(err)
+	require.Len(sent, 3)
+	require.True(message.Claimed)
+	require.Equal("a", message.ClaimHash)
+	require.Equal("b", message.Shares[0].Hash)
+	require.Equal("c", message.Shares[1].Hash)
+	require.Equal(progress.Shares[1].Amount, message.Shares[1].Amount)
+	progress, err = c.loadProgress()
+	require.NoError(err)
+	require.Nil(progress)
+}
+
+func TestAutoclaimRoundFailedClaim(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	cli := mock_iotexapi.NewMockAPIServiceClient(ctrl)
+	c := &autoclaimer{
+		cli:          cli,
+		ctx:          context.Background(),
+		progressFile: filepath.Join(t.TempDir(), "autoclaim.json"),
+	}
+	require.NoError(c.saveProgress(&autoclaimProgress{Unclaimed: "100", ClaimHash: "a"}))
+	cli.EXPECT().GetReceiptByAction(gomock.Any(), gomock.Any()).Return(&iotexapi.GetReceiptByActionResponse{
+		ReceiptInfo: &iotexapi.ReceiptInfo{
+			Receipt: &iotextypes.Receipt{Status: uint64(iotextypes.ReceiptStatus_Failure)},
+		},
+	}, nil).Times(1)
+
+	// a failed claim drops the progress, so that the next round claims again
+	_, err := c.round()
+	require.Error(err)
+	progress, err := c.loadProgress()
+	require.NoError(err)
+	require.Nil(progress)
+
+	cli.EXPECT().GetReceiptByAction(gomock.Any(), gomock.Any()).Return(nil, errors.New("network error")).Times(1)
+	require.NoError(c.saveProgress(&autoclaimProgress{Unclaimed: "100", ClaimHash: "a"}))
+	_, err = c.round()
+	require.Error(err)
+	progress, err = c.loadProgress()
+	require.NoError(err)
+	require.Equal("a", progress.ClaimHash)
+}
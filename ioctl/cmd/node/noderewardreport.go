@@ -0,0 +1,334 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+
+	"github.com/iotexproject/iotex-core/action/protocol/poll"
+	"github.com/iotexproject/iotex-core/action/protocol/rewarding"
+	"github.com/iotexproject/iotex-core/ioctl/config"
+	"github.com/iotexproject/iotex-core/ioctl/output"
+	"github.com/iotexproject/iotex-core/ioctl/util"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+// Multi-language support
+var (
+	_rewardReportCmdUses = map[config.Language]string{
+		config.English: "report (ALIAS|DELEGATE_ADDRESS|NAME) [--from-epoch START_EPOCH] [--to-epoch END_EPOCH]",
+		config.Chinese: "report (别名|代表地址|名称) [--from-epoch 起始epoch] [--to-epoch 结束epoch]",
+	}
+	_rewardReportCmdShorts = map[config.Language]string{
+		config.English: "Report block, epoch and foundation rewards of a delegate per epoch",
+		config.Chinese: "按epoch报告代表的出块奖励、epoch奖励和基金会奖励",
+	}
+	_flagFromEpochUsages = map[config.Language]string{
+		config.English: "start epoch of the report, default to the end epoch",
+		config.Chinese: "报告的起始epoch，默认为结束epoch",
+	}
+	_flagToEpochUsages = map[config.Language]string{
+		config.English: "end epoch of the report, default to the last finished epoch",
+		config.Chinese: "报告的结束epoch，默认为上一个已结束的epoch",
+	}
+)
+
+var (
+	_rewardReportFromEpoch uint64
+	_rewardReportToEpoch   uint64
+)
+
+// _nodeRewardReportCmd represents the node reward report command
+var _nodeRewardReportCmd = &cobra.Command{
+	Use:   config.TranslateInLang(_rewardReportCmdUses, config.UILanguage),
+	Short: config.TranslateInLang(_rewardReportCmdShorts, config.UILanguage),
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		err := rewardReport(args[0])
+		return output.PrintError(err)
+	},
+}
+
+type (
+	// rewardParams are the rewarding parameters read from the rewarding protocol
+	rewardParams struct {
+		BlockReward                    *big.Int
+		EpochReward                    *big.Int
+		FoundationBonus                *big.Int
+		FoundationBonusLastEpoch       uint64
+		NumDelegatesForEpochReward     uint64
+		NumDelegatesForFoundationBonus uint64
+		ProductivityThreshold          uint64
+	}
+
+	epochRewardReport struct {
+		Epoch           uint64 `json:"epoch"`
+		Rank            int    `json:"rank"`
+		Production      uint64 `json:"production"`
+		Productivity    uint64 `json:"productivity"`
+		BlockReward     string `json:"blockReward"`
+		EpochReward     string `json:"epochReward"`
+		FoundationBonus string `json:"foundationBonus"`
+		Total           string `json:"total"`
+	}
+
+	rewardReportMessage struct {
+		Delegate string               `json:"delegate"`
+		Epochs   []*epochRewardReport `json:"epochs"`
+		Total    string               `json:"total"`
+	}
+)
+
+func (m *rewardReportMessage) String() string {
+	if output.Format == "" {
+		lines := []string{
+			fmt.Sprintf("Delegate: %s (estimated with current rewarding parameters)\n", m.Delegate),
+			fmt.Sprintf("%-8s   %-4s   %-6s   %-12s   %-24s   %-24s   %-24s   %s",
+				"Epoch", "Rank", "Blocks", "Productivity", "BlockReward", "EpochReward", "FoundationBonus", "Total"),
+		}
+		for _, e := range m.Epochs {
+			rank := "-"
+			if e.Rank > 0 {
+				rank = strconv.Itoa(e.Rank)
+			}
+			lines = append(lines, fmt.Sprintf("%-8d   %-4s   %-6d   %-12s   %-24s   %-24s   %-24s   %s",
+				e.Epoch, rank, e.Production, strconv.FormatUint(e.Productivity, 10)+"%",
+				e.BlockReward, e.EpochReward, e.FoundationBonus, e.Total))
+		}
+		lines = append(lines, fmt.Sprintf("\nTotal: %s IOTX", m.Total))
+		return strings.Join(lines, "\n")
+	}
+	return output.FormatString(output.Result, m)
+}
+
+func init() {
+	_nodeRewardReportCmd.Flags().Uint64Var(&_rewardReportFromEpoch, "from-epoch", 0,
+		config.TranslateInLang(_flagFromEpochUsages, config.UILanguage))
+	_nodeRewardReportCmd.Flags().Uint64Var(&_rewardReportToEpoch, "to-epoch", 0,
+		config.TranslateInLang(_flagToEpochUsages, config.UILanguage))
+}
+
+func rewardReport(arg string) error {
+	conn, err := util.ConnectToEndpoint(config.ReadConfig.SecureConnect && !config.Insecure)
+	if err != nil {
+		return output.NewError(output.NetworkError, "failed to connect to endpoint", err)
+	}
+	defer conn.Close()
+	cli := iotexapi.NewAPIServiceClient(conn)
+	ctx := context.Background()
+	if jwtMD, err := util.JwtAuth(); err == nil {
+		ctx = metautils.NiceMD(jwtMD).ToOutgoing(ctx)
+	}
+
+	delegate, err := getCandidateOperatorAddressByAddressOrName(cli, arg)
+	if err != nil {
+		return output.NewError(output.AddressError, "failed to get delegate address", err)
+	}
+	params, err := readRewardParams(ctx, cli)
+	if err != nil {
+		return err
+	}
+	toEpoch, fromEpoch := _rewardReportToEpoch, _rewardReportFromEpoch
+	if toEpoch == 0 {
+		chainMeta, err := cli.GetChainMeta(ctx, &iotexapi.GetChainMetaRequest{})
+		if err != nil {
+			return rewardAPIError(err, "failed to invoke GetChainMeta api")
+		}
+		if chainMeta.GetChainMeta().GetEpoch() == nil {
+			return output.NewError(0, "ROLLDPOS is not registered", nil)
+		}
+		if toEpoch = chainMeta.GetChainMeta().GetEpoch().GetNum(); toEpoch > 1 {
+			toEpoch--
+		}
+	}
+	if fromEpoch == 0 {
+		fromEpoch = toEpoch
+	}
+	if fromEpoch > toEpoch {
+		return output.NewError(output.FlagError, "start epoch is larger than end epoch", nil)
+	}
+
+	var (
+		total   = new(big.Int)
+		message = rewardReportMessage{Delegate: delegate}
+	)
+	for epoch := fromEpoch; epoch <= toEpoch; epoch++ {
+		meta, err := cli.GetEpochMeta(ctx, &iotexapi.GetEpochMetaRequest{EpochNumber: epoch})
+		if err != nil {
+			return rewardAPIError(err, "failed to invoke GetEpochMeta api")
+		}
+		candidates, err := readEpochCandidates(ctx, cli, epoch, meta.GetEpochData().GetHeight())
+		if err != nil {
+			return err
+		}
+		report, sum := epochRewardBreakdown(params, epoch, meta, candidates, delegate)
+		total.Add(total, sum)
+		message.Epochs = append(message.Epochs, report)
+	}
+	message.Total = util.RauToString(total, util.IotxDecimalNum)
+	fmt.Println(message.String())
+	return nil
+}
+
+// epochRewardBreakdown estimates the rewards of the delegate in the epoch with the split rules of the rewarding
+// protocol: block reward per block produced, epoch reward shared by the top candidates in proportion to their votes
+// after probation unless unproductive, and foundation bonus for the top candidates until the last bonus epoch
+func epochRewardBreakdown(params *rewardParams, epoch uint64, meta *iotexapi.GetEpochMetaResponse, candidates state.CandidateList, delegate string) (*epochRewardReport, *big.Int) {
+	var (
+		report  = &epochRewardReport{Epoch: epoch}
+		produce = make(map[string]uint64)
+		self    *iotexapi.BlockProducerInfo
+	)
+	for _, bp := range meta.GetBlockProducersInfo() {
+		if bp.Active {
+			produce[bp.Address] = bp.Production
+		}
+		if bp.Address == delegate {
+			self = bp
+		}
+	}
+	uqd := make(map[string]bool)
+	if numActive := uint64(len(produce)); numActive > 0 && meta.GetTotalBlocks() >= numActive {
+		for _, addr := range poll.UnproductiveDelegates(produce, meta.GetTotalBlocks(), params.ProductivityThreshold) {
+			uqd[addr] = true
+		}
+		if production, ok := produce[delegate]; ok {
+			report.Productivity = production * 100 / (meta.GetTotalBlocks() / numActive)
+		}
+	}
+
+	blockReward, epochReward, bonus := new(big.Int), new(big.Int), new(big.Int)
+	if self != nil {
+		report.Production = self.Production
+		blockReward.Mul(params.BlockReward, new(big.Int).SetUint64(self.Production))
+	}
+	for i, c := range candidates {
+		if c.Address == delegate {
+			report.Rank = i + 1
+			break
+		}
+	}
+	shared, amounts := rewarding.SplitEpochReward(candidates, params.EpochReward, params.NumDelegatesForEpochReward, nil, uqd)
+	for i, c := range shared {
+		if c.Address == delegate {
+			epochReward.Set(amounts[i])
+			break
+		}
+	}
+	if epoch <= params.FoundationBonusLastEpoch {
+		for _, c := range rewarding.FoundationBonusRecipients(candidates, params.NumDelegatesForFoundationBonus, nil) {
+			if c.Address == delegate {
+				bonus.Set(params.FoundationBonus)
+				break
+			}
+		}
+	}
+	sum := new(big.Int).Add(blockReward, epochReward)
+	sum.Add(sum, bonus)
+	report.BlockReward = util.RauToString(blockReward, util.IotxDecimalNum)
+	report.EpochReward = util.RauToString(epochReward, util.IotxDecimalNum)
+	report.FoundationBonus = util.RauToString(bonus, util.IotxDecimalNum)
+	report.Total = util.RauToString(sum, util.IotxDecimalNum)
+	return report, sum
+}
+
+// readEpochCandidates reads the candidates of the epoch from the poll protocol, with the votes of the delegates on
+// probation reduced and sorted by the reduced votes
+func readEpochCandidates(ctx context.Context, cli iotexapi.APIServiceClient, epoch, epochStartHeight uint64) (state.CandidateList, error) {
+	response, err := cli.ReadState(ctx, &iotexapi.ReadStateRequest{
+		ProtocolID: []byte("poll"),
+		MethodName: []byte("CandidatesByEpoch"),
+		Arguments:  [][]byte{[]byte(strconv.FormatUint(epoch, 10))},
+		Height:     strconv.FormatUint(epochStartHeight, 10),
+	})
+	if err != nil {
+		return nil, rewardAPIError(err, "failed to invoke ReadState api")
+	}
+	var candidates state.CandidateList
+	if err := candidates.Deserialize(response.Data); err != nil {
+		return nil, output.NewError(output.SerializationError, "failed to deserialize candidates", err)
+	}
+	return candidates, nil
+}
+
+func readRewardParams(ctx context.Context, cli iotexapi.APIServiceClient) (*rewardParams, error) {
+	read := func(method string) (string, error) {
+		response, err := cli.ReadState(ctx, &iotexapi.ReadStateRequest{
+			ProtocolID: []byte("rewarding"),
+			MethodName: []byte(method),
+		})
+		if err != nil {
+			return "", rewardAPIError(err, "failed to invoke ReadState api")
+		}
+		return string(response.Data), nil
+	}
+	params := rewardParams{}
+	for method, value := range map[string]**big.Int{
+		"BlockReward":     &params.BlockReward,
+		"EpochReward":     &params.EpochReward,
+		"FoundationBonus": &params.FoundationBonus,
+	} {
+		data, err := read(method)
+		if err != nil {
+			return nil, err
+		}
+		amount, ok := new(big.Int).SetString(data, 10)
+		if !ok {
+			return nil, output.NewError(output.ConvertError, "failed to convert string into big int", nil)
+		}
+		*value = amount
+	}
+	for method, value := range map[string]*uint64{
+		"FoundationBonusLastEpoch":       &params.FoundationBonusLastEpoch,
+		"NumDelegatesForEpochReward":     &params.NumDelegatesForEpochReward,
+		"NumDelegatesForFoundationBonus": &params.NumDelegatesForFoundationBonus,
+		"ProductivityThreshold":          &params.ProductivityThreshold,
+	} {
+		data, err := read(method)
+		if err != nil {
+			return nil, err
+		}
+		if *value, err = strconv.ParseUint(data, 10, 64); err != nil {
+			return nil, output.NewError(output.ConvertError, "failed to convert string into uint64", err)
+		}
+	}
+	return &params, nil
+}
+
+func getCandidateOperatorAddressByAddressOrName(cli iotexapi.APIServiceClient, name string) (string, error) {
+	address, err1 := util.Address(name)
+	if err1 == nil {
+		return address, nil
+	}
+	cl, err := getAllStakingCandidates(cli)
+	if err != nil {
+		return "", err
+	}
+	for _, candidate := range cl.Candidates {
+		if candidate.Name == name {
+			return candidate.OperatorAddress, nil
+		}
+	}
+	return "", err1
+}
+
+func rewardAPIError(err error, msg string) error {
+	if sta, ok := status.FromError(err); ok {
+		return output.NewError(output.APIError, sta.Message(), nil)
+	}
+	return output.NewError(output.NetworkError, msg, err)
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package node
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/ioctl/util"
+	"github.com/iotexproject/iotex-core/pkg/unit"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+func TestEpochRewardBreakdown(t *testing.T) {
+	require := require.New(t)
+	params := &rewardParams{
+		BlockReward:                    big.NewInt(unit.Iotx),
+		EpochReward:                    unit.ConvertIotxToRau(100),
+		FoundationBonus:                unit.ConvertIotxToRau(10),
+		FoundationBonusLastEpoch:       5,
+		NumDelegatesForEpochReward:     3,
+		NumDelegatesForFoundationBonus: 1,
+		ProductivityThreshold:          85,
+	}
+	meta := &iotexapi.GetEpochMetaResponse{
+		TotalBlocks: 30,
+		BlockProducersInfo: []*iotexapi.BlockProducerInfo{
+			{Address: "a", Votes: "100", Active: true, Production: 15},
+			{Address: "b", Votes: "300", Active: true, Production: 15},
+			{Address: "c", Votes: "50", Active: false, Production: 0},
+		},
+	}
+	// the votes of d on probation are reduced, so that it ranks below c, and d is not a block producer
+	candidates := state.CandidateList{
+		{Address: "b", Votes: big.NewInt(300)},
+		{Address: "a", Votes: big.NewInt(100)},
+		{Address: "c", Votes: big.NewInt(50)},
+		{Address: "d", Votes: big.NewInt(50)},
+	}
+
+	report, sum := epochRewardBreakdown(params, 5, meta, candidates, "b")
+	require.Equal(1, report.Rank)
+	require.EqualValues(100, report.Productivity)
+	require.Equal("15", report.BlockReward)
+	require.Equal("66.666666666666666666", report.EpochReward)
+	require.Equal("10", report.FoundationBonus)
+	require.Equal("91.666666666666666666", report.Total)
+	require.Equal(report.Total, util.RauToString(sum, util.IotxDecimalNum))
+
+	report, _ = epochRewardBreakdown(params, 6, meta, candidates, "a")
+	require.Equal(2, report.Rank)
+	require.Equal("22.222222222222222222", report.EpochReward)
+	require.Equal("0", report.FoundationBonus)
+
+	// inactive delegate is not unproductive
+	report, _ = epochRewardBreakdown(params, 6, meta, candidates, "c")
+	require.Equal(3, report.Rank)
+	require.Zero(report.Productivity)
+	require.Equal("11.111111111111111111", report.EpochReward)
+
+	// delegate out of the epoch reward list after probation
+	report, sum = epochRewardBreakdown(params, 6, meta, candidates, "d")
+	require.Equal(4, report.Rank)
+	require.Zero(sum.Sign())
+
+	// unproductive delegate gets no epoch reward, but its votes still count in the total weight
+	meta.BlockProducersInfo[0].Production = 10
+	report, _ = epochRewardBreakdown(params, 6, meta, candidates, "a")
+	require.EqualValues(66, report.Productivity)
+	require.Equal("10", report.BlockReward)
+	require.Equal("0", report.EpochReward)
+	report, _ = epochRewardBreakdown(params, 6, meta, candidates, "b")
+	require.Equal("66.666666666666666666", report.EpochReward)
+
+	// delegate on hard probation gets no foundation bonus, which goes to the next one
+	candidates[0].Votes = big.NewInt(0)
+	report, _ = epochRewardBreakdown(params, 5, meta, candidates, "b")
+	require.Equal("0", report.FoundationBonus)
+	report, _ = epochRewardBreakdown(params, 5, meta, candidates, "a")
+	require.Equal("10", report.FoundationBonus)
+}
+
+func TestSplitByWeight(t *testing.T) {
+	require := require.New(t)
+	require.Nil(splitByWeight(big.NewInt(100), nil))
+	shares := splitByWeight(big.NewInt(unit.Iotx), map[string]*big.Int{
+		"b": big.NewInt(3),
+		"a": big.NewInt(1),
+		"c": big.NewInt(0),
+	})
+	require.Len(shares, 2)
+	require.Equal("a", shares[0].Voter)
+	require.Equal("0.25", shares[0].Amount)
+	require.Equal("b", shares[1].Voter)
+	require.Equal("0.75", shares[1].Amount)
+}
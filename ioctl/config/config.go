@@ -75,6 +75,8 @@ type Config struct {
 	IPFSGateway string `json:"ipfsGateway" yaml:"ipfsGateway"`
 	// WsRegisterContract w3bstream project register contract address
 	WsRegisterContract string `json:"wsRegisterContract" yaml:"wsRegisterContract"`
+	// ExternalSigner external signer endpoint, unix:///path/to/signer.ipc or http://host:port
+	ExternalSigner string `json:"externalSigner,omitempty" yaml:"externalSigner,omitempty"`
 }
 
 var (
@@ -40,8 +40,8 @@ const (
 
 var (
 	_supportedLanguage = []string{"English", "中文"}
-	_validArgs         = []string{"endpoint", "wallet", "explorer", "defaultacc", "language", "nsv2height", "wsEndpoint", "ipfsEndpoint", "ipfsGateway", "wsRegisterContract"}
-	_validGetArgs      = []string{"endpoint", "wallet", "explorer", "defaultacc", "language", "nsv2height", "analyserEndpoint", "wsEndpoint", "ipfsEndpoint", "ipfsGateway", "wsRegisterContract", "all"}
+	_validArgs         = []string{"endpoint", "wallet", "explorer", "defaultacc", "language", "nsv2height", "wsEndpoint", "ipfsEndpoint", "ipfsGateway", "wsRegisterContract", "externalSigner"}
+	_validGetArgs      = []string{"endpoint", "wallet", "explorer", "defaultacc", "language", "nsv2height", "analyserEndpoint", "wsEndpoint", "ipfsEndpoint", "ipfsGateway", "wsRegisterContract", "externalSigner", "all"}
 	_validExpl         = []string{"iotexscan", "iotxplorer"}
 	_endpointCompile   = regexp.MustCompile("^" + _endpointPattern + "$")
 )
@@ -165,6 +165,8 @@ func Get(arg string) error {
 		fmt.Println(ReadConfig.IPFSGateway)
 	case "wsRegisterContract":
 		fmt.Println(ReadConfig.WsRegisterContract)
+	case "externalSigner":
+		fmt.Println(ReadConfig.ExternalSigner)
 	case "all":
 		fmt.Println(ReadConfig.String())
 	}
@@ -301,6 +303,8 @@ func set(args []string) error {
 		ReadConfig.IPFSGateway = args[1]
 	case "wsRegisterContract":
 		ReadConfig.WsRegisterContract = args[1]
+	case "externalSigner":
+		ReadConfig.ExternalSigner = args[1]
 	}
 	err := writeConfig()
 	if err != nil {
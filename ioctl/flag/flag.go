@@ -7,6 +7,7 @@ package flag
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -55,6 +56,12 @@ type (
 		value        bool
 		defaultValue bool
 	}
+
+	durationVarP struct {
+		flagBase
+		value        time.Duration
+		defaultValue time.Duration
+	}
 )
 
 func (f *flagBase) MarkFlagRequired(cmd *cobra.Command) {
@@ -156,3 +163,28 @@ func (f *boolVarP) Value() interface{} {
 func (f *boolVarP) RegisterCommand(cmd *cobra.Command) {
 	cmd.Flags().BoolVarP(&f.value, f.label, f.shortLabel, f.defaultValue, f.description)
 }
+
+// NewDurationVarP creates a new durationVarP flag
+func NewDurationVarP(
+	label string,
+	shortLabel string,
+	defaultValue time.Duration,
+	description string,
+) Flag {
+	return &durationVarP{
+		flagBase: flagBase{
+			label:       label,
+			shortLabel:  shortLabel,
+			description: description,
+		},
+		defaultValue: defaultValue,
+	}
+}
+
+func (f *durationVarP) Value() interface{} {
+	return f.value
+}
+
+func (f *durationVarP) RegisterCommand(cmd *cobra.Command) {
+	cmd.Flags().DurationVarP(&f.value, f.label, f.shortLabel, f.defaultValue, f.description)
+}
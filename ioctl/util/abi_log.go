@@ -0,0 +1,141 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package util
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+)
+
+type (
+	// DecodedLogArg is an argument of a decoded event log
+	DecodedLogArg struct {
+		Name    string `json:"name"`
+		Type    string `json:"type"`
+		Indexed bool   `json:"indexed"`
+		Value   string `json:"value"`
+	}
+
+	// DecodedLog is an event log decoded by ABI
+	DecodedLog struct {
+		Event string           `json:"event"`
+		Args  []*DecodedLogArg `json:"args"`
+	}
+)
+
+// ReadABIFile reads and parses an ABI json file
+func ReadABIFile(abiFile string) (*abi.ABI, error) {
+	abiBytes, err := os.ReadFile(filepath.Clean(abiFile))
+	if err != nil {
+		return nil, err
+	}
+	parsedABI, err := abi.JSON(strings.NewReader(string(abiBytes)))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal abi")
+	}
+	return &parsedABI, nil
+}
+
+// DecodeLog decodes an event log with the ABI, returns nil if no event of the ABI matches the log
+func DecodeLog(contractABI *abi.ABI, topics [][]byte, data []byte) (*DecodedLog, error) {
+	if len(topics) == 0 {
+		return nil, nil
+	}
+	event, err := contractABI.EventByID(common.BytesToHash(topics[0]))
+	if err != nil {
+		return nil, nil
+	}
+	var (
+		indexed abi.Arguments
+		values  = make(map[string]interface{})
+	)
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if len(topics)-1 != len(indexed) {
+		return nil, errors.Errorf("event %s expects %d indexed arguments, got %d", event.Name, len(indexed), len(topics)-1)
+	}
+	hashes := make([]common.Hash, 0, len(indexed))
+	for _, topic := range topics[1:] {
+		hashes = append(hashes, common.BytesToHash(topic))
+	}
+	if err := abi.ParseTopicsIntoMap(values, indexed, hashes); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode indexed arguments of event %s", event.Name)
+	}
+	if len(data) > 0 {
+		if err := event.Inputs.UnpackIntoMap(values, data); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode data of event %s", event.Name)
+		}
+	}
+	decoded := &DecodedLog{
+		Event: event.Name,
+		Args:  make([]*DecodedLogArg, 0, len(event.Inputs)),
+	}
+	for _, arg := range event.Inputs {
+		decoded.Args = append(decoded.Args, &DecodedLogArg{
+			Name:    arg.Name,
+			Type:    arg.Type.String(),
+			Indexed: arg.Indexed,
+			Value:   FormatABIValue(values[arg.Name]),
+		})
+	}
+	return decoded, nil
+}
+
+// FormatABIValue formats an ABI decoded value into a readable string,
+// addresses are formatted as IoTeX addresses and bytes as hex string
+func FormatABIValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case common.Address:
+		addr, err := address.FromBytes(val.Bytes())
+		if err != nil {
+			return val.Hex()
+		}
+		return addr.String()
+	case common.Hash:
+		return val.Hex()
+	case *big.Int:
+		return val.String()
+	case []byte:
+		return "0x" + hex.EncodeToString(val)
+	case string:
+		return val
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
+			return "0x" + hex.EncodeToString(b)
+		}
+		fallthrough
+	case reflect.Slice:
+		s := "["
+		for i := 0; i < rv.Len(); i++ {
+			if i > 0 {
+				s += " "
+			}
+			s += FormatABIValue(rv.Index(i).Interface())
+		}
+		return s + "]"
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package util
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+const _testTransferABI = `[{"anonymous":false,"inputs":[
+{"indexed":true,"name":"from","type":"address"},
+{"indexed":true,"name":"to","type":"address"},
+{"indexed":false,"name":"value","type":"uint256"}],
+"name":"Transfer","type":"event"}]`
+
+func TestDecodeLog(t *testing.T) {
+	r := require.New(t)
+	contractABI, err := abi.JSON(strings.NewReader(_testTransferABI))
+	r.NoError(err)
+
+	from := common.HexToAddress("0xDa7e12Ef57c236a06117c5e0d04a228e7181CF36")
+	to := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	data, err := contractABI.Events["Transfer"].Inputs.NonIndexed().Pack(big.NewInt(1000))
+	r.NoError(err)
+	topics := [][]byte{
+		contractABI.Events["Transfer"].ID.Bytes(),
+		common.BytesToHash(from.Bytes()).Bytes(),
+		common.BytesToHash(to.Bytes()).Bytes(),
+	}
+
+	decoded, err := DecodeLog(&contractABI, topics, data)
+	r.NoError(err)
+	r.Equal("Transfer", decoded.Event)
+	r.Len(decoded.Args, 3)
+	r.Equal(&DecodedLogArg{Name: "from", Type: "address", Indexed: true, Value: "io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms"}, decoded.Args[0])
+	r.Equal("io1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqps833xv", decoded.Args[1].Value)
+	r.Equal(&DecodedLogArg{Name: "value", Type: "uint256", Value: "1000"}, decoded.Args[2])
+
+	// unknown event
+	decoded, err = DecodeLog(&contractABI, [][]byte{common.Hash{}.Bytes()}, nil)
+	r.NoError(err)
+	r.Nil(decoded)
+
+	// mismatched indexed topics
+	_, err = DecodeLog(&contractABI, topics[:2], data)
+	r.Error(err)
+
+	r.Equal("0x0102", FormatABIValue([2]byte{1, 2}))
+	r.Equal("[1 2]", FormatABIValue([]*big.Int{big.NewInt(1), big.NewInt(2)}))
+	r.Equal("true", FormatABIValue(true))
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package routine
+
+import (
+	"context"
+
+	"github.com/iotexproject/iotex-core/pkg/lifecycle"
+)
+
+var _ lifecycle.StartStopper = (*TriggeredTask)(nil)
+
+// TriggeredTask represents a task running in background on every trigger. The triggers received while the
+// task is running are merged into one run.
+type TriggeredTask struct {
+	lifecycle.Readiness
+	t       Task
+	trigger chan struct{}
+	done    chan struct{}
+}
+
+// NewTriggeredTask creates an instance of TriggeredTask
+func NewTriggeredTask(t Task) *TriggeredTask {
+	return &TriggeredTask{
+		t:       t,
+		trigger: make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+}
+
+// Start starts the triggered task
+func (t *TriggeredTask) Start(_ context.Context) error {
+	ready := make(chan struct{})
+	go func() {
+		close(ready)
+		for {
+			select {
+			case <-t.done:
+				return
+			case <-t.trigger:
+				t.t()
+			}
+		}
+	}()
+	// ensure the goroutine has been running
+	<-ready
+	return t.TurnOn()
+}
+
+// Stop stops the triggered task
+func (t *TriggeredTask) Stop(_ context.Context) error {
+	// prevent stop is called before start.
+	if err := t.TurnOff(); err != nil {
+		return err
+	}
+	close(t.done)
+	return nil
+}
+
+// Trigger requests a run of the task without waiting for it
+func (t *TriggeredTask) Trigger() {
+	select {
+	case t.trigger <- struct{}{}:
+	default:
+		// a run is pending already
+	}
+}
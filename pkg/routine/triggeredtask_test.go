@@ -0,0 +1,47 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package routine_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/pkg/routine"
+)
+
+func TestTriggeredTask(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	started, release := make(chan struct{}), make(chan struct{})
+	task := routine.NewTriggeredTask(func() {
+		started <- struct{}{}
+		<-release
+	})
+	require.Error(task.Stop(ctx))
+	require.NoError(task.Start(ctx))
+
+	wait := func() bool {
+		select {
+		case <-started:
+			return true
+		case <-time.After(100 * time.Millisecond):
+			return false
+		}
+	}
+	task.Trigger()
+	require.True(wait())
+	// the triggers during a run are merged into one
+	task.Trigger()
+	task.Trigger()
+	release <- struct{}{}
+	require.True(wait())
+	release <- struct{}{}
+	require.False(wait())
+	require.NoError(task.Stop(ctx))
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
 )
 
 func TestTracer(t *testing.T) {
@@ -12,6 +13,7 @@ func TestTracer(t *testing.T) {
 	prv, err := NewProvider()
 	require.NoError(err)
 	require.Nil(prv)
+	require.Contains(otel.GetTextMapPropagator().Fields(), "traceparent")
 
 	_, err = NewProvider(
 		WithEndpoint("http://aa"),
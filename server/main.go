@@ -91,12 +91,6 @@ func main() {
 	if genesis.Timestamp() == 0 {
 		glog.Fatalln("Genesis timestamp is not set, call genesis.New() first")
 	}
-	// load genesis block's hash
-	block.LoadGenesisHash(&genesisCfg)
-	if block.GenesisHash() == hash.ZeroHash256 {
-		glog.Fatalln("Genesis hash is not set, call block.LoadGenesisHash() first")
-	}
-
 	cfg, err := config.New([]string{_overwritePath, _secretPath}, _plugins)
 	if err != nil {
 		glog.Fatalln("Failed to new config.", zap.Error(err))
@@ -104,6 +98,16 @@ func main() {
 	if err = initLogger(cfg); err != nil {
 		glog.Fatalln("Cannot config global logger, use default one: ", zap.Error(err))
 	}
+	if cfg.Consensus.Dev.Enabled {
+		if err = prefundDevAccounts(cfg, &genesisCfg); err != nil {
+			glog.Fatalln("Failed to prefund dev accounts.", zap.Error(err))
+		}
+	}
+	// load genesis block's hash
+	block.LoadGenesisHash(&genesisCfg)
+	if block.GenesisHash() == hash.ZeroHash256 {
+		glog.Fatalln("Genesis hash is not set, call block.LoadGenesisHash() first")
+	}
 
 	if err = recovery.SetCrashlogDir(cfg.System.SystemLogDBPath); err != nil {
 		glog.Fatalln("Failed to set directory of crashlog: ", zap.Error(err))
@@ -179,6 +183,29 @@ func main() {
 	<-livenessCtx.Done()
 }
 
+// prefundDevAccounts adds the prefunded accounts of dev chain to the genesis balances
+func prefundDevAccounts(cfg config.Config, g *genesis.Genesis) error {
+	keys, err := cfg.Consensus.Dev.Accounts()
+	if err != nil {
+		return err
+	}
+	balance, err := cfg.Consensus.Dev.Balance()
+	if err != nil {
+		return err
+	}
+	balances := make(map[string]string, len(g.InitBalanceMap)+len(keys))
+	for addr, amount := range g.InitBalanceMap {
+		balances[addr] = amount
+	}
+	for i, sk := range keys {
+		addr := sk.PublicKey().Address()
+		balances[addr.String()] = balance.String()
+		log.S().Infof("Dev account %d: %s (%s), private key: %s", i, addr.String(), addr.Hex(), sk.HexString())
+	}
+	g.InitBalanceMap = balances
+	return nil
+}
+
 func initLogger(cfg config.Config) error {
 	addr := cfg.Chain.ProducerAddress()
 	return log.InitLoggers(cfg.Log, cfg.SubLogs, zap.AddCaller(), zap.Fields(
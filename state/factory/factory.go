@@ -87,11 +87,13 @@ type (
 		// NewBlockBuilder creates block builder
 		NewBlockBuilder(context.Context, actpool.ActPool, func(action.Envelope) (*action.SealedEnvelope, error)) (*block.Builder, error)
 		SimulateExecution(context.Context, address.Address, *action.Execution) ([]byte, *action.Receipt, error)
+		SimulateExecutions(context.Context, []address.Address, []*action.Execution) ([][]byte, []*action.Receipt, error)
 		ReadContractStorage(context.Context, address.Address, []byte) ([]byte, error)
 		PutBlock(context.Context, *block.Block) error
 		DeleteTipBlock(context.Context, *block.Block) error
 		StateAtHeight(uint64, interface{}, ...protocol.StateOption) error
 		StatesAtHeight(uint64, ...protocol.StateOption) (state.Iterator, error)
+		ReadContractStorageAtHeight(context.Context, uint64, address.Address, []byte) ([]byte, error)
 	}
 
 	// factory implements StateFactory interface, tracks changes to account/contract and batch-commits to DB
@@ -109,6 +111,8 @@ type (
 		protocolView             protocol.View
 		skipBlockValidationOnPut bool
 		ps                       *patchStore
+		undo                     *undoStore
+		fork                     ForkReader
 	}
 
 	// Config contains the config for factory
@@ -153,6 +157,30 @@ func DefaultTriePatchOption() Option {
 	}
 }
 
+// RevertibleOption keeps an undo log of the states written by every block in memory, so that the tip blocks
+// can be deleted. It requires archive mode, in which the history of the state trie is kept.
+func RevertibleOption() Option {
+	return func(sf *factory, cfg *Config) error {
+		if !cfg.Chain.EnableArchiveMode {
+			return errors.Wrap(ErrNotSupported, "reverting states requires archive mode")
+		}
+		sf.undo = newUndoStore(sf.dao)
+		sf.dao = sf.undo
+		return nil
+	}
+}
+
+// ForkOption forks the local chain from a remote one, of which the accounts, codes, contract storage and the states
+// of the protocols missing locally are read with the reader when running actions. The protocols implementing
+// protocol.StateForker create their genesis states from the remote chain too, and the other confirmed states read
+// directly from the factory are the local ones only
+func ForkOption(reader ForkReader) Option {
+	return func(sf *factory, cfg *Config) error {
+		sf.fork = reader
+		return nil
+	}
+}
+
 // NewFactory creates a new state factory
 func NewFactory(cfg Config, dao db.KVStore, opts ...Option) (Factory, error) {
 	sf := &factory{
@@ -287,7 +315,14 @@ func (sf *factory) newWorkingSet(ctx context.Context, height uint64) (*workingSe
 		}
 	}
 
-	return newWorkingSet(height, store), nil
+	// the genesis states are created locally, except the ones of the protocols forked
+	if sf.fork != nil && height > 0 {
+		store = newForkWorkingSetStore(store, sf.fork, sf.registry)
+	}
+	ws := newWorkingSet(height, store)
+	ws.parallelWorkers = parallelWorkers(sf.cfg.Chain)
+	ws.fork = sf.fork
+	return ws, nil
 }
 
 func (sf *factory) flusherOptions(preEaster bool) []db.KVStoreFlusherOption {
@@ -401,6 +436,26 @@ func (sf *factory) SimulateExecution(
 	return evm.SimulateExecution(ctx, ws, caller, ex)
 }
 
+// SimulateExecutions simulates a sequence of smart contract operations in one working set, this is done off the
+// network since it does not cause any state change
+func (sf *factory) SimulateExecutions(
+	ctx context.Context,
+	callers []address.Address,
+	exs []*action.Execution,
+) ([][]byte, []*action.Receipt, error) {
+	ctx, span := tracer.NewSpan(ctx, "factory.SimulateExecutions")
+	defer span.End()
+
+	sf.mutex.Lock()
+	ws, err := sf.newWorkingSet(ctx, sf.currentChainHeight+1)
+	sf.mutex.Unlock()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to obtain working set from state factory")
+	}
+
+	return evm.SimulateExecutions(ctx, ws, callers, exs)
+}
+
 // ReadContractStorage reads contract's storage
 func (sf *factory) ReadContractStorage(ctx context.Context, contract address.Address, key []byte) ([]byte, error) {
 	sf.mutex.Lock()
@@ -481,8 +536,40 @@ func (sf *factory) PutBlock(ctx context.Context, blk *block.Block) error {
 	return nil
 }
 
-func (sf *factory) DeleteTipBlock(_ context.Context, _ *block.Block) error {
-	return errors.Wrap(ErrNotSupported, "cannot delete tip block from factory")
+// DeleteTipBlock reverts the states to the previous height, which is only supported with RevertibleOption
+func (sf *factory) DeleteTipBlock(ctx context.Context, blk *block.Block) error {
+	if sf.undo == nil {
+		return errors.Wrap(ErrNotSupported, "cannot delete tip block from factory")
+	}
+	sf.mutex.Lock()
+	if blk.Height() != sf.currentChainHeight || blk.Height() == 0 {
+		sf.mutex.Unlock()
+		return errors.Errorf("cannot delete block %d from factory at height %d", blk.Height(), sf.currentChainHeight)
+	}
+	if err := sf.undo.Undo(); err != nil {
+		sf.mutex.Unlock()
+		return errors.Wrapf(err, "failed to revert states of block %d", blk.Height())
+	}
+	rh, err := sf.dao.Get(ArchiveTrieNamespace, []byte(ArchiveTrieRootKey))
+	if err != nil {
+		sf.mutex.Unlock()
+		return err
+	}
+	if err := sf.twoLayerTrie.SetRootHash(rh); err != nil {
+		sf.mutex.Unlock()
+		return err
+	}
+	sf.currentChainHeight--
+	sf.workingsets.Clear()
+	sf.mutex.Unlock()
+
+	// the protocol views are rebuilt from the reverted states
+	view, err := sf.registry.StartAll(protocol.WithRegistry(ctx, sf.registry), sf)
+	if err != nil {
+		return err
+	}
+	sf.protocolView = view
+	return nil
 }
 
 // StateAtHeight returns a confirmed state at height -- archive mode
@@ -512,6 +599,31 @@ func (sf *factory) StatesAtHeight(height uint64, opts ...protocol.StateOption) (
 	return nil, errors.Wrap(ErrNotSupported, "Read historical states has not been implemented yet")
 }
 
+// ReadContractStorageAtHeight reads contract's storage at height -- archive mode
+func (sf *factory) ReadContractStorageAtHeight(ctx context.Context, height uint64, contract address.Address, key []byte) ([]byte, error) {
+	sf.mutex.RLock()
+	defer sf.mutex.RUnlock()
+	if !sf.saveHistory {
+		return nil, ErrNoArchiveData
+	}
+	if height > sf.currentChainHeight {
+		return nil, errors.Errorf("query height %d is higher than tip height %d", height, sf.currentChainHeight)
+	}
+	flusher, err := db.NewKVStoreFlusher(sf.dao, batch.NewCachedBatch())
+	if err != nil {
+		return nil, err
+	}
+	store, err := newFactoryWorkingSetStoreAtHeight(sf.protocolView, flusher, height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to generate trie for %d", height)
+	}
+	if err := store.Start(ctx); err != nil {
+		return nil, err
+	}
+	defer store.Stop(ctx)
+	return evm.ReadContractStorage(ctx, newWorkingSet(height, store), contract, key)
+}
+
 // State returns a confirmed state in the state factory
 func (sf *factory) State(s interface{}, opts ...protocol.StateOption) (uint64, error) {
 	sf.mutex.RLock()
@@ -16,6 +16,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -25,6 +26,7 @@ import (
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/iotexproject/iotex-election/test/mock/mock_committee"
 	"github.com/iotexproject/iotex-election/types"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
@@ -405,6 +407,85 @@ func TestHistoryState(t *testing.T) {
 	}()
 }
 
+func TestDeleteTipBlock(t *testing.T) {
+	r := require.New(t)
+	cfg := DefaultConfig
+	_, err := NewFactory(cfg, db.NewMemKVStore(), RevertibleOption())
+	r.ErrorIs(err, ErrNotSupported)
+	sf, err := NewFactory(cfg, db.NewMemKVStore(), SkipBlockValidationOption())
+	r.NoError(err)
+	r.ErrorIs(sf.DeleteTipBlock(context.Background(), &block.Block{}), ErrNotSupported)
+
+	cfg.Chain.EnableArchiveMode = true
+	sf, err = NewFactory(cfg, db.NewMemKVStore(), SkipBlockValidationOption(), RevertibleOption())
+	r.NoError(err)
+	r.NoError(sf.Register(account.NewProtocol(rewarding.DepositGas)))
+	a, b := identityset.Address(28), identityset.Address(31)
+	ge := genesis.Default
+	ge.InitBalanceMap[a.String()] = "100"
+	ctx := genesis.WithGenesisContext(protocol.WithBlockchainCtx(protocol.WithBlockCtx(
+		context.Background(),
+		protocol.BlockCtx{
+			BlockHeight: 0,
+			Producer:    identityset.Address(27),
+			GasLimit:    1000000,
+		},
+	), protocol.BlockchainCtx{
+		ChainID: 1,
+	}), ge)
+	r.NoError(sf.Start(ctx))
+	defer func() {
+		r.NoError(sf.Stop(ctx))
+	}()
+
+	putBlock := func(height uint64) *block.Block {
+		tsf, err := action.NewTransfer(height, big.NewInt(10), b.String(), nil, uint64(20000), big.NewInt(0))
+		r.NoError(err)
+		elp := (&action.EnvelopeBuilder{}).SetAction(tsf).SetGasLimit(20000).SetNonce(height).Build()
+		selp, err := action.Sign(elp, identityset.PrivateKey(28))
+		r.NoError(err)
+		blk, err := block.NewTestingBuilder().
+			SetHeight(height).
+			SetPrevBlockHash(hash.ZeroHash256).
+			SetTimeStamp(testutil.TimestampNow()).
+			AddActions(selp).
+			SignAndBuild(identityset.PrivateKey(27))
+		r.NoError(err)
+		r.NoError(sf.PutBlock(protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+			BlockHeight: height,
+			Producer:    identityset.Address(27),
+			GasLimit:    1000000,
+		}), &blk))
+		return &blk
+	}
+	balance := func(addr address.Address) *big.Int {
+		acc, err := accountutil.AccountState(ctx, sf, addr)
+		r.NoError(err)
+		return acc.Balance
+	}
+	blk1 := putBlock(1)
+	blk2 := putBlock(2)
+	r.Equal(big.NewInt(80), balance(a))
+	r.Equal(big.NewInt(20), balance(b))
+
+	r.Error(sf.DeleteTipBlock(ctx, blk1))
+	r.NoError(sf.DeleteTipBlock(ctx, blk2))
+	h, err := sf.Height()
+	r.NoError(err)
+	r.EqualValues(1, h)
+	r.Equal(big.NewInt(90), balance(a))
+	r.NoError(sf.DeleteTipBlock(ctx, blk1))
+	r.Equal(big.NewInt(100), balance(a))
+	r.Equal(big.NewInt(0), balance(b))
+	// the genesis states cannot be deleted
+	r.Error(sf.DeleteTipBlock(ctx, &block.Block{}))
+
+	// the chain goes on from the reverted height
+	putBlock(1)
+	r.Equal(big.NewInt(90), balance(a))
+	r.Equal(big.NewInt(10), balance(b))
+}
+
 func TestFactoryStates(t *testing.T) {
 	r := require.New(t)
 	var err error
@@ -1234,6 +1315,89 @@ func testSimulateExecution(ctx context.Context, sf Factory, t *testing.T) {
 	})
 	_, _, err = sf.SimulateExecution(ctx, addr, ex)
 	require.NoError(err)
+
+	// the calls of a bundle run on the overridden state, and each of them on the state left by the previous ones
+	var (
+		contract    = identityset.Address(30)
+		evmContract = common.BytesToAddress(contract.Bytes())
+		get, _      = hex.DecodeString("6d4ce63c")
+		set, _      = hex.DecodeString("60fe47b10000000000000000000000000000000000000000000000000000000000000005")
+		callers     []address.Address
+		exs         []*action.Execution
+	)
+	for _, input := range [][]byte{get, set, get} {
+		ex, err := action.NewExecution(contract.String(), 1, big.NewInt(0), uint64(100000), big.NewInt(0), input)
+		require.NoError(err)
+		callers = append(callers, addr)
+		exs = append(exs, ex)
+	}
+	height := uint64(100)
+	retvals, receipts, err := sf.SimulateExecutions(evm.WithSimulationCtx(ctx, evm.SimulationContext{
+		StateOverride: evm.StateOverride{
+			evmContract: &evm.AccountOverride{
+				// the runtime code of the contract deployed above
+				Code:      data[0x1f:],
+				StateDiff: map[common.Hash]common.Hash{{}: common.BigToHash(big.NewInt(7))},
+			},
+		},
+		BlockOverride: &evm.BlockOverride{Number: &height},
+	}), callers, exs)
+	require.NoError(err)
+	require.Len(receipts, 3)
+	for _, r := range receipts {
+		require.EqualValues(iotextypes.ReceiptStatus_Success, r.Status)
+		require.Equal(height, r.BlockHeight)
+	}
+	require.Equal(common.BigToHash(big.NewInt(7)).Bytes(), retvals[0])
+	require.Equal(common.BigToHash(big.NewInt(5)).Bytes(), retvals[2])
+
+	// the calls split into blocks run in their own blocks, still on the state left by the previous ones
+	next := height + 1
+	retvals, receipts, err = sf.SimulateExecutions(evm.WithSimulationCtx(ctx, evm.SimulationContext{
+		StateOverride: evm.StateOverride{
+			evmContract: &evm.AccountOverride{Code: data[0x1f:]},
+		},
+		Blocks: []evm.SimulationBlock{
+			{Size: 2, Override: &evm.BlockOverride{Number: &height}},
+			{Size: 1, Override: &evm.BlockOverride{Number: &next}},
+		},
+	}), callers, exs)
+	require.NoError(err)
+	require.Equal([]uint64{height, height, next}, []uint64{receipts[0].BlockHeight, receipts[1].BlockHeight, receipts[2].BlockHeight})
+	require.Equal(common.BigToHash(big.NewInt(5)).Bytes(), retvals[2])
+	_, _, err = sf.SimulateExecutions(evm.WithSimulationCtx(ctx, evm.SimulationContext{
+		Blocks: []evm.SimulationBlock{{Size: 2}},
+	}), callers, exs)
+	require.ErrorContains(err, "2 executions in the blocks of 3 executions")
+
+	// the overrides are not kept
+	retval, _, err := sf.SimulateExecution(ctx, addr, exs[0])
+	require.NoError(err)
+	require.Empty(retval)
+
+	// a single execution runs on the overridden state as well
+	caller := identityset.Address(31)
+	ex, err = action.NewExecution(contract.String(), 1, big.NewInt(0), uint64(100000), big.NewInt(1), get)
+	require.NoError(err)
+	_, _, err = sf.SimulateExecution(ctx, caller, ex)
+	require.ErrorIs(err, action.ErrInsufficientFunds)
+	nonce := uint64(3)
+	retval, receipt, err := sf.SimulateExecution(evm.WithSimulationCtx(ctx, evm.SimulationContext{
+		StateOverride: evm.StateOverride{
+			common.BytesToAddress(caller.Bytes()): &evm.AccountOverride{
+				Balance: big.NewInt(100000),
+				Nonce:   &nonce,
+			},
+			evmContract: &evm.AccountOverride{
+				Code:      data[0x1f:],
+				StateDiff: map[common.Hash]common.Hash{{}: common.BigToHash(big.NewInt(7))},
+			},
+		},
+	}), caller, ex)
+	require.NoError(err)
+	require.EqualValues(iotextypes.ReceiptStatus_Success, receipt.Status)
+	require.Equal(common.BigToHash(big.NewInt(7)).Bytes(), retval)
+	require.Equal(nonce, ex.Nonce())
 }
 
 func TestCachedBatch(t *testing.T) {
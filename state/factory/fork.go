@@ -0,0 +1,170 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"context"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
+	"github.com/iotexproject/iotex-core/db/trie"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+type (
+	// ForkReader reads the states of the remote chain which the local chain is forked from
+	ForkReader interface {
+		protocol.ForkStateReader
+		// Account returns the account, or state.ErrStateNotExist if it does not exist
+		Account(address.Address) (*state.Account, error)
+		// Code returns the code of a contract by its hash
+		Code(hash.Hash256) ([]byte, error)
+		// Storage returns the value in the storage of a contract
+		Storage(address.Address, hash.Hash256) ([]byte, error)
+	}
+
+	// forkWorkingSetStore reads the states missing in the working set from the fork
+	forkWorkingSetStore struct {
+		workingSetStore
+		fork    ForkReader
+		forkers []protocol.StateForker
+	}
+)
+
+// _forkDeletedNamespace keeps the keys of the states deleted locally, so that they are not read from the fork again
+const _forkDeletedNamespace = "ForkDeleted"
+
+func newForkWorkingSetStore(store workingSetStore, fork ForkReader, reg *protocol.Registry) *forkWorkingSetStore {
+	var forkers []protocol.StateForker
+	for _, p := range reg.All() {
+		if forker, ok := p.(protocol.StateForker); ok {
+			forkers = append(forkers, forker)
+		}
+	}
+	return &forkWorkingSetStore{workingSetStore: store, fork: fork, forkers: forkers}
+}
+
+// readFork reads the states of the protocols, e.g. staking, rewarding and poll, with their ReadState, and the account
+// and code states from the fork
+func (store *forkWorkingSetStore) readFork(ns string, key []byte) ([]byte, error) {
+	for _, forker := range store.forkers {
+		value, err := forker.ForkState(store.fork, ns, key)
+		if errors.Cause(err) != state.ErrStateNotExist {
+			return value, err
+		}
+	}
+	switch ns {
+	case AccountKVNamespace:
+		if len(key) != legacyKeyLen() {
+			return nil, state.ErrStateNotExist
+		}
+		addr, err := address.FromBytes(key)
+		if err != nil {
+			return nil, err
+		}
+		acct, err := store.fork.Account(addr)
+		if err != nil {
+			return nil, err
+		}
+		return acct.Serialize()
+	case evm.CodeKVNameSpace:
+		code, err := store.fork.Code(hash.BytesToHash256(key))
+		if err != nil {
+			return nil, err
+		}
+		return protocol.SerializableBytes(code).Serialize()
+	default:
+		return nil, state.ErrStateNotExist
+	}
+}
+
+// Get reads the state from the fork if it does not exist in the working set, and has not been deleted locally
+func (store *forkWorkingSetStore) Get(ns string, key []byte) ([]byte, error) {
+	value, err := store.workingSetStore.Get(ns, key)
+	if errors.Cause(err) != state.ErrStateNotExist {
+		return value, err
+	}
+	if _, derr := store.workingSetStore.Get(_forkDeletedNamespace, forkDeletedKey(ns, key)); derr == nil {
+		return nil, err
+	}
+	value, ferr := store.readFork(ns, key)
+	if errors.Cause(ferr) == state.ErrStateNotExist {
+		return nil, err
+	}
+	return value, ferr
+}
+
+// Delete deletes the state, which may exist in the fork only, and keeps its key to hide the one in the fork
+func (store *forkWorkingSetStore) Delete(ns string, key []byte) error {
+	err := store.workingSetStore.Delete(ns, key)
+	switch errors.Cause(err) {
+	case nil:
+	case state.ErrStateNotExist:
+		if _, ferr := store.Get(ns, key); ferr != nil {
+			return err
+		}
+	default:
+		return err
+	}
+	return store.workingSetStore.Put(_forkDeletedNamespace, forkDeletedKey(ns, key), []byte{1})
+}
+
+// States reads the states of the keys with the fork, while the states iterated are the local ones only
+func (store *forkWorkingSetStore) States(ns string, keys [][]byte) ([][]byte, error) {
+	if keys == nil {
+		return store.workingSetStore.States(ns, keys)
+	}
+	values := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		value, err := store.Get(ns, key)
+		switch errors.Cause(err) {
+		case state.ErrStateNotExist:
+			values = append(values, nil)
+		case nil:
+			values = append(values, value)
+		default:
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+func forkDeletedKey(ns string, key []byte) []byte {
+	nsHash := hash.Hash160b([]byte(ns))
+	return append(nsHash[:], key...)
+}
+
+// createForkedGenesisStates creates the genesis states of the protocols forked from the remote chain, on top of
+// the states of the remote chain
+func (ws *workingSet) createForkedGenesisStates(ctx context.Context, reg *protocol.Registry) error {
+	store := newForkWorkingSetStore(ws.store, ws.fork, reg)
+	ws.store = store
+	defer func() {
+		ws.store = store.workingSetStore
+	}()
+	for _, forker := range store.forkers {
+		if err := forker.CreateForkedGenesisStates(ctx, ws, ws.fork); err != nil {
+			return errors.Wrap(err, "failed to create forked genesis states for protocol")
+		}
+	}
+	return nil
+}
+
+// ForkedStorage reads the storage of a contract from the fork
+func (ws *workingSet) ForkedStorage(addr hash.Hash160, key hash.Hash256) ([]byte, error) {
+	if ws.fork == nil {
+		return nil, trie.ErrNotExist
+	}
+	contract, err := address.FromBytes(addr[:])
+	if err != nil {
+		return nil, err
+	}
+	return ws.fork.Storage(contract, key)
+}
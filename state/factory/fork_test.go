@@ -0,0 +1,222 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/account"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
+	"github.com/iotexproject/iotex-core/action/protocol/rewarding"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+type (
+	testForkReader struct {
+		accounts map[string]*state.Account
+		codes    map[hash.Hash256][]byte
+		storage  map[hash.Hash256][]byte
+		states   map[string][]byte
+	}
+
+	// testForkProtocol forks the states in its namespace with the ReadState of the fork
+	testForkProtocol struct{}
+)
+
+const _testForkNamespace = "testFork"
+
+func (r *testForkReader) ReadState(protocolID string, method []byte, _ ...[]byte) ([]byte, error) {
+	return r.states[protocolID+string(method)], nil
+}
+
+func (r *testForkReader) Account(addr address.Address) (*state.Account, error) {
+	acct, ok := r.accounts[addr.String()]
+	if !ok {
+		return nil, state.ErrStateNotExist
+	}
+	return acct.Clone(), nil
+}
+
+func (r *testForkReader) Code(codeHash hash.Hash256) ([]byte, error) {
+	code, ok := r.codes[codeHash]
+	if !ok {
+		return nil, state.ErrStateNotExist
+	}
+	return code, nil
+}
+
+func (r *testForkReader) Storage(_ address.Address, key hash.Hash256) ([]byte, error) {
+	return r.storage[key], nil
+}
+
+func (p *testForkProtocol) Handle(context.Context, action.Action, protocol.StateManager) (*action.Receipt, error) {
+	return nil, nil
+}
+
+func (p *testForkProtocol) ReadState(context.Context, protocol.StateReader, []byte, ...[]byte) ([]byte, uint64, error) {
+	return nil, 0, nil
+}
+
+func (p *testForkProtocol) Register(r *protocol.Registry) error {
+	return r.Register(_testForkNamespace, p)
+}
+
+func (p *testForkProtocol) ForceRegister(r *protocol.Registry) error {
+	return r.ForceRegister(_testForkNamespace, p)
+}
+
+func (p *testForkProtocol) Name() string {
+	return _testForkNamespace
+}
+
+func (p *testForkProtocol) CreateGenesisStates(context.Context, protocol.StateManager) error {
+	return errors.New("the genesis states are forked")
+}
+
+func (p *testForkProtocol) CreateForkedGenesisStates(_ context.Context, sm protocol.StateManager, fsr protocol.ForkStateReader) error {
+	v, err := fsr.ReadState(_testForkNamespace, []byte("genesis"))
+	if err != nil {
+		return err
+	}
+	_, err = sm.PutState(protocol.SerializableBytes(v), protocol.NamespaceOption(_testForkNamespace), protocol.KeyOption([]byte("genesis")))
+	return err
+}
+
+func (p *testForkProtocol) ForkState(fsr protocol.ForkStateReader, ns string, key []byte) ([]byte, error) {
+	if ns != _testForkNamespace {
+		return nil, state.ErrStateNotExist
+	}
+	v, err := fsr.ReadState(_testForkNamespace, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(v) == 0 {
+		return nil, state.ErrStateNotExist
+	}
+	return protocol.SerializableBytes(v).Serialize()
+}
+
+func TestFork(t *testing.T) {
+	r := require.New(t)
+
+	a, b, c := identityset.Address(28), identityset.Address(29), identityset.Address(30)
+	acct, err := state.NewAccount(state.LegacyNonceAccountTypeOption())
+	r.NoError(err)
+	r.NoError(acct.AddBalance(big.NewInt(100)))
+	code := []byte{0x60, 0x80, 0x60, 0x40}
+	codeHash := hash.Hash256b(code)
+	contract, err := state.NewAccount()
+	r.NoError(err)
+	contract.CodeHash = codeHash[:]
+	key, value := hash.Hash256b([]byte("slot")), hash.Hash256b([]byte("value"))
+	fork := &testForkReader{
+		accounts: map[string]*state.Account{a.String(): acct, c.String(): contract},
+		codes:    map[hash.Hash256][]byte{codeHash: code},
+		storage:  map[hash.Hash256][]byte{key: value[:]},
+		states: map[string][]byte{
+			_testForkNamespace + "genesis": []byte("genesis"),
+			_testForkNamespace + "state":   []byte("state"),
+		},
+	}
+
+	sf, err := NewFactory(DefaultConfig, db.NewMemKVStore(), SkipBlockValidationOption(), ForkOption(fork))
+	r.NoError(err)
+	r.NoError(sf.Register(account.NewProtocol(rewarding.DepositGas)))
+	r.NoError(sf.Register(&testForkProtocol{}))
+	ge := genesis.Default
+	ge.InitBalanceMap = map[string]string{}
+	ctx := genesis.WithGenesisContext(protocol.WithBlockchainCtx(protocol.WithBlockCtx(
+		context.Background(),
+		protocol.BlockCtx{
+			BlockHeight: 0,
+			Producer:    identityset.Address(27),
+			GasLimit:    1000000,
+		},
+	), protocol.BlockchainCtx{
+		ChainID: 1,
+	}), ge)
+	r.NoError(sf.Start(ctx))
+	defer func() {
+		r.NoError(sf.Stop(ctx))
+	}()
+
+	balance := func(addr address.Address) *big.Int {
+		acc, err := accountutil.AccountState(ctx, sf, addr)
+		r.NoError(err)
+		return acc.Balance
+	}
+	// the confirmed states are the local ones only, including the genesis states forked
+	r.Equal(big.NewInt(0), balance(a))
+	var v protocol.SerializableBytes
+	_, err = sf.State(&v, protocol.NamespaceOption(evm.CodeKVNameSpace), protocol.KeyOption(codeHash[:]))
+	r.Equal(state.ErrStateNotExist, errors.Cause(err))
+	_, err = sf.State(&v, protocol.NamespaceOption(_testForkNamespace), protocol.KeyOption([]byte("genesis")))
+	r.NoError(err)
+	r.Equal([]byte("genesis"), []byte(v))
+	_, err = sf.State(&v, protocol.NamespaceOption(_testForkNamespace), protocol.KeyOption([]byte("state")))
+	r.Equal(state.ErrStateNotExist, errors.Cause(err))
+	// the states missing locally are read from the fork when running actions
+	storage, err := sf.ReadContractStorage(ctx, c, key[:])
+	r.NoError(err)
+	r.Equal(value[:], storage)
+
+	// an account existing only in the fork sends a transfer
+	tsf, err := action.NewTransfer(1, big.NewInt(10), b.String(), nil, uint64(20000), big.NewInt(0))
+	r.NoError(err)
+	elp := (&action.EnvelopeBuilder{}).SetAction(tsf).SetGasLimit(20000).SetNonce(1).Build()
+	selp, err := action.Sign(elp, identityset.PrivateKey(28))
+	r.NoError(err)
+	blk, err := block.NewTestingBuilder().
+		SetHeight(1).
+		SetPrevBlockHash(hash.ZeroHash256).
+		SetTimeStamp(testutil.TimestampNow()).
+		AddActions(selp).
+		SignAndBuild(identityset.PrivateKey(27))
+	r.NoError(err)
+	r.NoError(sf.PutBlock(protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+		BlockHeight: 1,
+		Producer:    identityset.Address(27),
+		GasLimit:    1000000,
+	}), &blk))
+	r.Equal(big.NewInt(90), balance(a))
+	r.Equal(big.NewInt(10), balance(b))
+	// the states written locally take over the fork
+	fork.accounts[a.String()].Balance = big.NewInt(1000)
+	r.Equal(big.NewInt(90), balance(a))
+	acc, err := accountutil.AccountState(ctx, sf, a)
+	r.NoError(err)
+	r.Equal(uint64(2), acc.PendingNonce())
+
+	// the states of the protocols are read from the fork, and the ones deleted locally are not read again
+	ws, err := sf.(*factory).newWorkingSet(ctx, 2)
+	r.NoError(err)
+	_, err = ws.State(&v, protocol.NamespaceOption(_testForkNamespace), protocol.KeyOption([]byte("state")))
+	r.NoError(err)
+	r.Equal([]byte("state"), []byte(v))
+	_, err = ws.DelState(protocol.NamespaceOption(_testForkNamespace), protocol.KeyOption([]byte("state")))
+	r.NoError(err)
+	_, err = ws.State(&v, protocol.NamespaceOption(_testForkNamespace), protocol.KeyOption([]byte("state")))
+	r.Equal(state.ErrStateNotExist, errors.Cause(err))
+	_, err = ws.DelState(protocol.NamespaceOption(_testForkNamespace), protocol.KeyOption([]byte("state")))
+	r.Equal(state.ErrStateNotExist, errors.Cause(err))
+	_, err = ws.DelState(protocol.NamespaceOption(_testForkNamespace), protocol.KeyOption([]byte("missing")))
+	r.Equal(state.ErrStateNotExist, errors.Cause(err))
+}
@@ -0,0 +1,638 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/actpool/actioniterator"
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+var (
+	_parallelExecutionMtc = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "iotex_parallel_execution",
+			Help: "IoTeX parallel execution of actions",
+		},
+		[]string{"type"},
+	)
+
+	errSpeculationAborted = errors.New("speculative execution aborted")
+)
+
+// _speculationRounds is the number of rounds of workers the speculations run ahead of the block production
+const _speculationRounds = 4
+
+func init() {
+	prometheus.MustRegister(_parallelExecutionMtc)
+}
+
+type (
+	speculativeKey struct {
+		ns  string
+		key string
+	}
+
+	speculativeRead struct {
+		value []byte
+		exist bool
+	}
+
+	speculativeWrite struct {
+		ns       string
+		key      []byte
+		value    []byte
+		deleted  bool
+		snapshot bool
+		// accumulate adds to the state, it reads the latest state when the write is applied
+		accumulate func(protocol.StateManager) error
+	}
+
+	// speculativeStore runs on top of the working set store of the block, it buffers the writes of
+	// an action, and records the values read from the underlying store, so that the result can be
+	// validated against the latest state and applied later on
+	speculativeStore struct {
+		base         workingSetStore
+		lock         *parallelLock
+		strictDelete bool
+		reads        map[speculativeKey]*speculativeRead
+		writes       map[speculativeKey]*speculativeWrite
+		journal      []*speculativeWrite
+		shots        []int
+		viewRead     bool
+		viewVersion  uint64
+		aborted      error
+	}
+
+	// speculativeDock allows reading the dock of the block, any change to it aborts the speculation
+	speculativeDock struct {
+		base  protocol.Dock
+		store *speculativeStore
+	}
+
+	// parallelLock guards the working set of the block, and versions the protocol views and dock
+	// which are not tracked by read set
+	parallelLock struct {
+		sync.Mutex
+		viewVersion uint64
+	}
+
+	speculation struct {
+		store   *speculativeStore
+		receipt *action.Receipt
+		err     error
+	}
+
+	pendingSpeculation struct {
+		spec *speculation
+		done chan struct{}
+	}
+
+	// speculator speculates the pending actions ahead of the block production, in the order they are
+	// picked assuming that no account is popped, the block production then commits the speculations of
+	// the actions it actually picks
+	speculator struct {
+		ws    *workingSet
+		lock  *parallelLock
+		mu    sync.Mutex
+		specs map[hash.Hash256]*pendingSpeculation
+		taken map[hash.Hash256]struct{}
+		slots chan struct{}
+		quit  chan struct{}
+		once  sync.Once
+		wg    sync.WaitGroup
+	}
+)
+
+func newSpeculativeStore(base workingSetStore, lock *parallelLock) *speculativeStore {
+	_, strictDelete := base.(*factoryWorkingSetStore)
+	return &speculativeStore{
+		base:         base,
+		lock:         lock,
+		strictDelete: strictDelete,
+		reads:        make(map[speculativeKey]*speculativeRead),
+		writes:       make(map[speculativeKey]*speculativeWrite),
+	}
+}
+
+func (store *speculativeStore) Start(context.Context) error {
+	return nil
+}
+
+func (store *speculativeStore) Stop(context.Context) error {
+	return nil
+}
+
+func (store *speculativeStore) abort(msg string) error {
+	if store.aborted == nil {
+		store.aborted = errors.Wrap(errSpeculationAborted, msg)
+	}
+	return store.aborted
+}
+
+func (store *speculativeStore) Get(ns string, key []byte) ([]byte, error) {
+	k := speculativeKey{ns, string(key)}
+	if w, ok := store.writes[k]; ok {
+		if w.accumulate != nil {
+			return nil, store.abort("reading accumulated state is not supported")
+		}
+		if w.deleted {
+			return nil, errors.Wrapf(state.ErrStateNotExist, "failed to get state of ns = %x and key = %x", ns, key)
+		}
+		return w.value, nil
+	}
+	r, ok := store.reads[k]
+	if !ok {
+		store.lock.Lock()
+		value, err := store.base.Get(ns, key)
+		store.lock.Unlock()
+		switch errors.Cause(err) {
+		case nil:
+			r = &speculativeRead{value: value, exist: true}
+		case state.ErrStateNotExist:
+			r = &speculativeRead{}
+		default:
+			return nil, err
+		}
+		store.reads[k] = r
+	}
+	if !r.exist {
+		return nil, errors.Wrapf(state.ErrStateNotExist, "failed to get state of ns = %x and key = %x", ns, key)
+	}
+	return r.value, nil
+}
+
+func (store *speculativeStore) Put(ns string, key []byte, value []byte) error {
+	store.write(&speculativeWrite{ns: ns, key: key, value: value})
+	return nil
+}
+
+func (store *speculativeStore) Delete(ns string, key []byte) error {
+	var exist bool
+	if store.strictDelete {
+		// deleting a non-existing key fails in the trie, which is part of the result
+		_, err := store.Get(ns, key)
+		switch errors.Cause(err) {
+		case nil:
+			exist = true
+		case state.ErrStateNotExist:
+		default:
+			return err
+		}
+	}
+	store.write(&speculativeWrite{ns: ns, key: key, deleted: true})
+	if store.strictDelete && !exist {
+		return errors.Wrapf(state.ErrStateNotExist, "key %x doesn't exist in namespace %s", key, ns)
+	}
+	return nil
+}
+
+// accumulate defers the addition to the state until the writes are applied, so that it does not read the state
+func (store *speculativeStore) accumulate(ns string, key []byte, apply func(protocol.StateManager) error) {
+	store.write(&speculativeWrite{ns: ns, key: key, accumulate: apply})
+}
+
+func (store *speculativeStore) write(w *speculativeWrite) {
+	store.journal = append(store.journal, w)
+	store.writes[speculativeKey{w.ns, string(w.key)}] = w
+}
+
+func (store *speculativeStore) States(string, [][]byte) ([][]byte, error) {
+	return nil, store.abort("reading states is not supported")
+}
+
+func (store *speculativeStore) Commit() error {
+	return errors.Wrap(ErrNotSupported, "cannot commit speculative store")
+}
+
+func (store *speculativeStore) Digest() hash.Hash256 {
+	return hash.ZeroHash256
+}
+
+func (store *speculativeStore) Finalize(uint64) error {
+	return errors.Wrap(ErrNotSupported, "cannot finalize speculative store")
+}
+
+func (store *speculativeStore) Snapshot() int {
+	store.journal = append(store.journal, &speculativeWrite{snapshot: true})
+	store.shots = append(store.shots, len(store.journal))
+	return len(store.shots) - 1
+}
+
+func (store *speculativeStore) RevertSnapshot(snapshot int) error {
+	if snapshot < 0 || snapshot >= len(store.shots) {
+		return errors.Errorf("invalid snapshot number = %d", snapshot)
+	}
+	store.journal = store.journal[:store.shots[snapshot]]
+	store.shots = store.shots[:snapshot+1]
+	store.writes = make(map[speculativeKey]*speculativeWrite)
+	for _, w := range store.journal {
+		if !w.snapshot {
+			store.writes[speculativeKey{w.ns, string(w.key)}] = w
+		}
+	}
+	return nil
+}
+
+func (store *speculativeStore) ResetSnapshots() {
+	store.shots = nil
+}
+
+func (store *speculativeStore) ReadView(name string) (interface{}, error) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	store.readView()
+	return store.base.ReadView(name)
+}
+
+func (store *speculativeStore) WriteView(string, interface{}) error {
+	return store.abort("writing view is not supported")
+}
+
+// readView records the version of views and dock being read, must be called with lock held
+func (store *speculativeStore) readView() {
+	if !store.viewRead {
+		store.viewRead = true
+		store.viewVersion = store.lock.viewVersion
+		return
+	}
+	if store.viewVersion != store.lock.viewVersion {
+		store.abort("views changed during execution")
+	}
+}
+
+// validate checks whether the values read are still the same as the latest state, must be called with lock held
+func (store *speculativeStore) validate() (bool, error) {
+	if store.viewRead && store.viewVersion != store.lock.viewVersion {
+		return false, nil
+	}
+	for k, r := range store.reads {
+		value, err := store.base.Get(k.ns, []byte(k.key))
+		switch errors.Cause(err) {
+		case nil:
+			if !r.exist || !bytes.Equal(value, r.value) {
+				return false, nil
+			}
+		case state.ErrStateNotExist:
+			if r.exist {
+				return false, nil
+			}
+		default:
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// apply writes the changes to the underlying store in the same order as they were made, the accumulations are
+// applied to the state manager of the store in place, must be called with lock held
+func (store *speculativeStore) apply(sm protocol.StateManager) error {
+	for _, w := range store.journal {
+		switch {
+		case w.snapshot:
+			store.base.Snapshot()
+		case w.accumulate != nil:
+			if err := w.accumulate(sm); err != nil {
+				return err
+			}
+		case w.deleted:
+			if err := store.base.Delete(w.ns, w.key); err != nil && errors.Cause(err) != state.ErrStateNotExist {
+				return err
+			}
+		default:
+			if err := store.base.Put(w.ns, w.key, w.value); err != nil {
+				return err
+			}
+		}
+	}
+	store.base.ResetSnapshots()
+	return nil
+}
+
+func (d *speculativeDock) ProtocolDirty(name string) bool {
+	d.store.lock.Lock()
+	defer d.store.lock.Unlock()
+	d.store.readView()
+	return d.base.ProtocolDirty(name)
+}
+
+func (d *speculativeDock) Load(string, string, interface{}) error {
+	return d.store.abort("writing dock is not supported")
+}
+
+func (d *speculativeDock) Unload(ns, key string, v interface{}) error {
+	d.store.lock.Lock()
+	defer d.store.lock.Unlock()
+	d.store.readView()
+	return d.base.Unload(ns, key, v)
+}
+
+func (d *speculativeDock) Reset() {
+	d.store.abort("resetting dock is not supported")
+}
+
+func parallelWorkers(cfg blockchain.Config) int {
+	if !cfg.EnableParallelExecution {
+		return 0
+	}
+	if cfg.ParallelExecutionWorkers > 0 {
+		return cfg.ParallelExecutionWorkers
+	}
+	return runtime.NumCPU()
+}
+
+// speculate runs the action against a speculative store of the working set
+func (ws *workingSet) speculate(ctx context.Context, selp *action.SealedEnvelope, lock *parallelLock) (spec *speculation) {
+	store := newSpeculativeStore(ws.store, lock)
+	spec = &speculation{store: store}
+	defer func() {
+		// a speculation reading inconsistent states may panic, leave it to the sequential execution
+		if r := recover(); r != nil {
+			spec.err = errors.Wrap(errSpeculationAborted, fmt.Sprintf("panic: %v", r))
+		}
+	}()
+	specWs := &workingSet{
+		height: ws.height,
+		store:  store,
+		dock:   &speculativeDock{base: ws.dock, store: store},
+		fork:   ws.fork,
+	}
+	spec.receipt, spec.err = specWs.runAction(ctx, selp)
+	if store.aborted != nil {
+		spec.err = store.aborted
+	}
+	return spec
+}
+
+// runActionsInParallel runs the actions optimistically in parallel, each against a speculative store
+// of the working set. The results are then validated and applied in the order of actions, an action
+// that read stale states is re-executed against the latest state, and an action that cannot be run
+// speculatively falls back to the sequential execution. Therefore the receipts and the resulting state
+// are the same as running the actions sequentially.
+func (ws *workingSet) runActionsInParallel(
+	ctx context.Context,
+	elps []*action.SealedEnvelope,
+) ([]*action.Receipt, error) {
+	ctxs := make([]context.Context, len(elps))
+	for i, elp := range elps {
+		ctxWithActionContext, err := withActionCtx(ctx, elp)
+		if err != nil {
+			return nil, err
+		}
+		ctxs[i] = ctxWithActionContext
+	}
+	var (
+		lock  = &parallelLock{}
+		specs = make([]*speculation, len(elps))
+		done  = make([]chan struct{}, len(elps))
+		jobs  = make(chan int)
+		quit  = make(chan struct{})
+		wg    sync.WaitGroup
+	)
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+	go func() {
+		defer close(jobs)
+		for i := range elps {
+			select {
+			case jobs <- i:
+			case <-quit:
+				return
+			}
+		}
+	}()
+	for w := 0; w < ws.parallelWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				specs[i] = ws.speculate(ctxs[i], elps[i], lock)
+				close(done[i])
+			}
+		}()
+	}
+	// stop the workers before returning, so that no one reads the working set afterwards
+	defer func() {
+		close(quit)
+		wg.Wait()
+	}()
+
+	receipts := make([]*action.Receipt, 0, len(elps))
+	for i, elp := range elps {
+		<-done[i]
+		receipt, err := ws.commitSpeculation(ctxs[i], elp, specs[i], lock)
+		if err != nil {
+			return nil, errors.Wrap(err, "error when run action")
+		}
+		receipts = append(receipts, receipt)
+	}
+	return receipts, nil
+}
+
+func (ws *workingSet) commitSpeculation(
+	ctx context.Context,
+	selp *action.SealedEnvelope,
+	spec *speculation,
+	lock *parallelLock,
+) (*action.Receipt, error) {
+	if spec.err == nil {
+		applied, err := ws.applySpeculation(spec, lock)
+		if err != nil {
+			return nil, err
+		}
+		if applied {
+			_parallelExecutionMtc.WithLabelValues("speculated").Inc()
+			return spec.receipt, nil
+		}
+	}
+	if errors.Cause(spec.err) != errSpeculationAborted {
+		// re-execute against the latest state
+		if spec = ws.speculate(ctx, selp, lock); spec.err == nil {
+			applied, err := ws.applySpeculation(spec, lock)
+			if err != nil {
+				return nil, err
+			}
+			if applied {
+				_parallelExecutionMtc.WithLabelValues("reexecuted").Inc()
+				return spec.receipt, nil
+			}
+		}
+	}
+	// the action cannot be run speculatively, or it fails, run it on the working set directly
+	return ws.runActionExclusively(ctx, selp, lock)
+}
+
+// runActionExclusively runs the action on the working set directly, with the speculations held off
+func (ws *workingSet) runActionExclusively(
+	ctx context.Context,
+	selp *action.SealedEnvelope,
+	lock *parallelLock,
+) (*action.Receipt, error) {
+	_parallelExecutionMtc.WithLabelValues("sequential").Inc()
+	lock.Lock()
+	defer lock.Unlock()
+	lock.viewVersion++
+	return ws.runAction(ctx, selp)
+}
+
+func (ws *workingSet) applySpeculation(spec *speculation, lock *parallelLock) (bool, error) {
+	lock.Lock()
+	defer lock.Unlock()
+	valid, err := spec.store.validate()
+	if err != nil || !valid {
+		return false, err
+	}
+	return true, spec.store.apply(ws)
+}
+
+// speculatePendingActions starts speculating the pending actions with the block context of ctx. The remaining
+// gas of the block only decides whether an action fails with ErrGasLimit, so the speculations run with the
+// gas of the whole block, and are only used when the remaining gas covers the action.
+func (ws *workingSet) speculatePendingActions(
+	ctx context.Context,
+	actionMap map[string][]*action.SealedEnvelope,
+) *speculator {
+	type job struct {
+		ctx     context.Context
+		selp    *action.SealedEnvelope
+		pending *pendingSpeculation
+	}
+	var (
+		s = &speculator{
+			ws:    ws,
+			lock:  &parallelLock{},
+			specs: make(map[hash.Hash256]*pendingSpeculation),
+			taken: make(map[hash.Hash256]struct{}),
+			slots: make(chan struct{}, _speculationRounds*ws.parallelWorkers),
+			quit:  make(chan struct{}),
+		}
+		jobs = make(chan job)
+		// the action iterator modifies the map of actions
+		actions = make(map[string][]*action.SealedEnvelope, len(actionMap))
+		ctxs    = make(map[*action.SealedEnvelope]context.Context)
+		hashes  = make(map[*action.SealedEnvelope]hash.Hash256)
+	)
+	for sender, acts := range actionMap {
+		actions[sender] = acts
+		// the hash and sender of an action are cached at the first access, which must not race
+		for _, selp := range acts {
+			h, err := selp.Hash()
+			if err != nil {
+				continue
+			}
+			actionCtx, err := withActionCtx(ctx, selp)
+			if err != nil {
+				continue
+			}
+			ctxs[selp], hashes[selp] = actionCtx, h
+		}
+	}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer close(jobs)
+		iter := actioniterator.NewActionIterator(actions)
+		for {
+			select {
+			case s.slots <- struct{}{}:
+			case <-s.quit:
+				return
+			}
+			selp, ok := iter.Next()
+			if !ok {
+				return
+			}
+			actionCtx, ok := ctxs[selp]
+			if !ok {
+				s.release()
+				continue
+			}
+			h := hashes[selp]
+			pending := &pendingSpeculation{done: make(chan struct{})}
+			s.mu.Lock()
+			if _, ok := s.taken[h]; ok {
+				// the action has been run by the block production
+				s.mu.Unlock()
+				s.release()
+				continue
+			}
+			s.specs[h] = pending
+			s.mu.Unlock()
+			select {
+			case jobs <- job{actionCtx, selp, pending}:
+			case <-s.quit:
+				return
+			}
+		}
+	}()
+	for w := 0; w < ws.parallelWorkers; w++ {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			for j := range jobs {
+				j.pending.spec = ws.speculate(j.ctx, j.selp, s.lock)
+				close(j.pending.done)
+			}
+		}()
+	}
+	return s
+}
+
+// validate validates the picked action against the working set
+func (s *speculator) validate(ctx context.Context, selp *action.SealedEnvelope) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.ws.validateAction(ctx, selp)
+}
+
+// run runs the picked action on the working set, committing its speculation if it is still valid
+func (s *speculator) run(ctx context.Context, selp *action.SealedEnvelope) (*action.Receipt, error) {
+	h, err := selp.Hash()
+	if err != nil {
+		return nil, err
+	}
+	// let the speculations move on as the block production does
+	s.release()
+	s.mu.Lock()
+	pending, ok := s.specs[h]
+	delete(s.specs, h)
+	s.taken[h] = struct{}{}
+	s.mu.Unlock()
+	gasLimit := protocol.MustGetBlockCtx(ctx).GasLimit
+	if !ok || gasLimit < selp.GasLimit() || gasLimit < protocol.MustGetActionCtx(ctx).IntrinsicGas {
+		return s.ws.runActionExclusively(ctx, selp, s.lock)
+	}
+	<-pending.done
+	return s.ws.commitSpeculation(ctx, selp, pending.spec, s.lock)
+}
+
+func (s *speculator) release() {
+	select {
+	case <-s.slots:
+	default:
+	}
+}
+
+// stop stops the speculations, so that no one reads the working set afterwards
+func (s *speculator) stop() {
+	s.once.Do(func() {
+		close(s.quit)
+		s.wg.Wait()
+	})
+}
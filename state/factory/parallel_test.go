@@ -0,0 +1,345 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/action/protocol/execution"
+	"github.com/iotexproject/iotex-core/action/protocol/rewarding"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/unit"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_actpool"
+)
+
+func TestSpeculativeStore(t *testing.T) {
+	r := require.New(t)
+	for _, ws := range []*workingSet{
+		newFactoryWorkingSet(t),
+		newStateDBWorkingSet(t),
+	} {
+		r.NoError(ws.store.Put("ns", []byte("a"), []byte("1")))
+		r.NoError(ws.store.Put("ns", []byte("b"), []byte("2")))
+		lock := &parallelLock{}
+		store := newSpeculativeStore(ws.store, lock)
+
+		// reads are recorded, writes are buffered
+		v, err := store.Get("ns", []byte("a"))
+		r.NoError(err)
+		r.Equal([]byte("1"), v)
+		_, err = store.Get("ns", []byte("c"))
+		r.ErrorIs(err, state.ErrStateNotExist)
+		r.NoError(store.Put("ns", []byte("a"), []byte("3")))
+		s := store.Snapshot()
+		r.NoError(store.Put("ns", []byte("c"), []byte("4")))
+		r.NoError(store.Delete("ns", []byte("b")))
+		_, err = store.Get("ns", []byte("b"))
+		r.ErrorIs(err, state.ErrStateNotExist)
+		r.NoError(store.RevertSnapshot(s))
+		v, err = store.Get("ns", []byte("b"))
+		r.NoError(err)
+		r.Equal([]byte("2"), v)
+		_, err = store.Get("ns", []byte("c"))
+		r.ErrorIs(err, state.ErrStateNotExist)
+		v, err = ws.store.Get("ns", []byte("a"))
+		r.NoError(err)
+		r.Equal([]byte("1"), v)
+		r.Len(store.reads, 3)
+
+		// unsupported operations abort the speculation
+		_, err = store.States("ns", nil)
+		r.ErrorIs(err, errSpeculationAborted)
+		r.ErrorIs(store.aborted, errSpeculationAborted)
+
+		// stale read fails the validation
+		valid, err := store.validate()
+		r.NoError(err)
+		r.True(valid)
+		r.NoError(ws.store.Put("ns", []byte("c"), []byte("5")))
+		valid, err = store.validate()
+		r.NoError(err)
+		r.False(valid)
+		r.NoError(ws.store.Delete("ns", []byte("c")))
+		valid, err = store.validate()
+		r.NoError(err)
+		r.True(valid)
+		_, err = store.ReadView("view")
+		r.Error(err)
+		lock.viewVersion++
+		valid, err = store.validate()
+		r.NoError(err)
+		r.False(valid)
+
+		// accumulation is deferred, reading it aborts the speculation
+		store = newSpeculativeStore(ws.store, lock)
+		r.NoError(store.Put("ns", []byte("a"), []byte("3")))
+		accumulate := func(sm protocol.StateManager) error {
+			v, err := ws.store.Get("ns", []byte("a"))
+			if err != nil {
+				return err
+			}
+			return ws.store.Put("ns", []byte("a"), append(v, '0'))
+		}
+		store.accumulate("ns", []byte("a"), accumulate)
+		store.accumulate("ns", []byte("a"), accumulate)
+		r.Empty(store.reads)
+		_, err = store.Get("ns", []byte("a"))
+		r.ErrorIs(err, errSpeculationAborted)
+
+		// apply the writes
+		r.NoError(store.apply(ws))
+		v, err = ws.store.Get("ns", []byte("a"))
+		r.NoError(err)
+		r.Equal([]byte("300"), v)
+		_, err = ws.store.Get("ns", []byte("c"))
+		r.ErrorIs(err, state.ErrStateNotExist)
+	}
+}
+
+func TestParallelExecution(t *testing.T) {
+	r := require.New(t)
+	const (
+		numAccounts = 24
+		numBlocks   = 4
+	)
+	g := genesis.Default
+	g.InitBalanceMap = map[string]string{}
+	for i := 0; i < numAccounts; i++ {
+		g.InitBalanceMap[identityset.Address(i).String()] = "100000000000000000000"
+	}
+	// record the blocks in which each account sends a transfer paying gas, a quarter of them are sent to the
+	// other senders and depend on the previous ones, the others are sent to new accounts
+	rnd := rand.New(rand.NewSource(1))
+	nonces := make([]uint64, numAccounts)
+	blocks := make([][]*action.SealedEnvelope, numBlocks)
+	for i := range blocks {
+		for _, sender := range rnd.Perm(numAccounts) {
+			recipient := identityset.Address(rnd.Intn(numAccounts)).String()
+			if rnd.Intn(4) > 0 {
+				addrHash := hash.Hash160b([]byte{byte(i), byte(sender)})
+				addr, err := address.FromBytes(addrHash[:])
+				r.NoError(err)
+				recipient = addr.String()
+			}
+			nonces[sender]++
+			gasPrice := new(big.Int).Mul(big.NewInt(rnd.Int63n(10)+1), big.NewInt(unit.Qev))
+			tx, err := action.NewTransfer(nonces[sender], big.NewInt(rnd.Int63n(1000000)), recipient, nil, 10000, gasPrice)
+			r.NoError(err)
+			elp := (&action.EnvelopeBuilder{}).SetNonce(nonces[sender]).SetGasLimit(10000).SetGasPrice(tx.GasPrice()).SetAction(tx).Build()
+			selp, err := action.Sign(elp, identityset.PrivateKey(sender))
+			r.NoError(err)
+			blocks[i] = append(blocks[i], selp)
+		}
+	}
+
+	speculatedCount := func() float64 {
+		metric := &dto.Metric{}
+		r.NoError(_parallelExecutionMtc.WithLabelValues("speculated").Write(metric))
+		return metric.GetCounter().GetValue()
+	}
+	for _, newFactory := range []func(Config, *protocol.Registry) (Factory, error){
+		func(cfg Config, reg *protocol.Registry) (Factory, error) {
+			return NewFactory(cfg, db.NewMemKVStore(), RegistryOption(reg))
+		},
+		func(cfg Config, reg *protocol.Registry) (Factory, error) {
+			return NewStateDB(cfg, db.NewMemKVStore(), RegistryStateDBOption(reg))
+		},
+	} {
+		var (
+			sfs  = make([]Factory, 2)
+			ctxs = make([]context.Context, 2)
+		)
+		for i := range sfs {
+			cfg := DefaultConfig
+			cfg.Genesis = g
+			cfg.Chain.EnableParallelExecution = i == 1
+			cfg.Chain.ParallelExecutionWorkers = 4
+			reg := protocol.NewRegistry()
+			r.NoError(account.NewProtocol(rewarding.DepositGas).Register(reg))
+			r.NoError(rewarding.NewProtocol(g.Rewarding).Register(reg))
+			sf, err := newFactory(cfg, reg)
+			r.NoError(err)
+			ctx := genesis.WithGenesisContext(protocol.WithRegistry(context.Background(), reg), g)
+			ctx = protocol.WithBlockchainCtx(ctx, protocol.BlockchainCtx{ChainID: 1})
+			r.NoError(sf.Start(ctx))
+			defer sf.Stop(ctx)
+			sfs[i], ctxs[i] = sf, ctx
+		}
+		for h, acts := range blocks {
+			var (
+				height   = uint64(h + 1)
+				digests  = make([][]byte, 2)
+				receipts = make([][]*action.Receipt, 2)
+			)
+			for i, sf := range sfs {
+				ctx := protocol.WithBlockCtx(ctxs[i], protocol.BlockCtx{
+					BlockHeight: height,
+					Producer:    identityset.Address(27),
+					GasLimit:    g.BlockGasLimit,
+				})
+				ctx = protocol.WithFeatureCtx(ctx)
+				ws, err := sf.(workingSetCreator).newWorkingSet(ctx, height)
+				r.NoError(err)
+				r.Equal(i*4, ws.parallelWorkers)
+				speculated := speculatedCount()
+				r.NoError(ws.Process(ctx, acts))
+				if i == 1 {
+					// the gas deposits do not conflict, the transfers to new accounts are committed in parallel
+					r.GreaterOrEqual(speculatedCount()-speculated, float64(len(acts)/3))
+				}
+				digest, err := ws.digest()
+				r.NoError(err)
+				digests[i] = digest[:]
+				if store, ok := ws.store.(*factoryWorkingSetStore); ok {
+					root, err := store.tlt.RootHash()
+					r.NoError(err)
+					digests[i] = append(digests[i], root...)
+				}
+				receipts[i], err = ws.Receipts()
+				r.NoError(err)
+				r.NoError(ws.Commit(ctx))
+			}
+			r.Equal(digests[0], digests[1])
+			r.Equal(len(receipts[0]), len(receipts[1]))
+			for i := range receipts[0] {
+				r.Equal(receipts[0][i].Hash(), receipts[1][i].Hash())
+				r.Equal(receipts[0][i].Logs(), receipts[1][i].Logs())
+				r.Equal(receipts[0][i].TransactionLogs(), receipts[1][i].TransactionLogs())
+			}
+		}
+	}
+}
+
+func TestParallelPickAndRunActions(t *testing.T) {
+	r := require.New(t)
+	const numSenders = 16
+	g := genesis.TestDefault()
+	// the contract increments the counter in its storage, so that every call conflicts with the previous one
+	counter, err := hex.DecodeString("600a600c600039600a6000f360005460010160005500")
+	r.NoError(err)
+	deploy, err := action.SignedExecution(action.EmptyAddress, identityset.PrivateKey(0), 1, big.NewInt(0), 100000, big.NewInt(0), counter)
+	r.NoError(err)
+
+	for _, newFactory := range []func(Config, *protocol.Registry) (Factory, error){
+		func(cfg Config, reg *protocol.Registry) (Factory, error) {
+			return NewFactory(cfg, db.NewMemKVStore(), RegistryOption(reg))
+		},
+		func(cfg Config, reg *protocol.Registry) (Factory, error) {
+			return NewStateDB(cfg, db.NewMemKVStore(), RegistryStateDBOption(reg))
+		},
+	} {
+		var (
+			contract string
+			executed = make([][]hash.Hash256, 2)
+			digests  = make([][]byte, 2)
+			receipts = make([][]*action.Receipt, 2)
+		)
+		for i := 0; i < 2; i++ {
+			cfg := DefaultConfig
+			cfg.Genesis = g
+			cfg.Chain.EnableParallelExecution = i == 1
+			cfg.Chain.ParallelExecutionWorkers = 4
+			reg := protocol.NewRegistry()
+			r.NoError(account.NewProtocol(rewarding.DepositGas).Register(reg))
+			r.NoError(rewarding.NewProtocol(g.Rewarding).Register(reg))
+			r.NoError(execution.NewProtocol(
+				func(uint64) (hash.Hash256, error) { return hash.ZeroHash256, nil },
+				rewarding.DepositGasWithSGD,
+				nil,
+				func(uint64) (time.Time, error) { return time.Time{}, nil },
+			).Register(reg))
+			sf, err := newFactory(cfg, reg)
+			r.NoError(err)
+			ctx := genesis.WithGenesisContext(protocol.WithRegistry(context.Background(), reg), g)
+			ctx = protocol.WithBlockchainCtx(ctx, protocol.BlockchainCtx{ChainID: 1})
+			r.NoError(sf.Start(ctx))
+			defer sf.Stop(ctx)
+			blockCtx := func(height uint64, gasLimit uint64) context.Context {
+				return protocol.WithFeatureCtx(protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+					BlockHeight: height,
+					Producer:    identityset.Address(27),
+					GasLimit:    gasLimit,
+				}))
+			}
+
+			// deploy the counter in the first block
+			blkCtx := blockCtx(1, g.BlockGasLimit)
+			ws, err := sf.(workingSetCreator).newWorkingSet(blkCtx, 1)
+			r.NoError(err)
+			r.NoError(ws.Process(blkCtx, []*action.SealedEnvelope{deploy}))
+			r.Equal(uint64(iotextypes.ReceiptStatus_Success), ws.receipts[0].Status)
+			contract = ws.receipts[0].ContractAddress
+			r.NoError(ws.Commit(blkCtx))
+
+			// every sender calls the counter twice and then sends a transfer, the block cannot hold all of them
+			rnd := rand.New(rand.NewSource(1))
+			pending := make(map[string][]*action.SealedEnvelope)
+			for sender := 1; sender <= numSenders; sender++ {
+				var (
+					key      = identityset.PrivateKey(sender)
+					gasPrice = big.NewInt(rnd.Int63n(10) + 1)
+					acts     = make([]*action.SealedEnvelope, 3)
+				)
+				for nonce := uint64(1); nonce <= 2; nonce++ {
+					acts[nonce-1], err = action.SignedExecution(contract, key, nonce, big.NewInt(0), 100000, gasPrice, nil)
+					r.NoError(err)
+				}
+				acts[2], err = action.SignedTransfer(identityset.Address(numSenders+sender).String(), key, 3, big.NewInt(1), nil, 10000, gasPrice)
+				r.NoError(err)
+				pending[identityset.Address(sender).String()] = acts
+			}
+			ap := mock_actpool.NewMockActPool(gomock.NewController(t))
+			ap.EXPECT().PendingActionMap().Return(pending).Times(1)
+			blkCtx = blockCtx(2, 500000)
+			ws, err = sf.(workingSetCreator).newWorkingSet(blkCtx, 2)
+			r.NoError(err)
+			r.Equal(i*4, ws.parallelWorkers)
+			acts, err := ws.pickAndRunActions(blkCtx, ap, nil, 0)
+			r.NoError(err)
+			for _, selp := range acts {
+				h, err := selp.Hash()
+				r.NoError(err)
+				executed[i] = append(executed[i], h)
+			}
+			digest, err := ws.digest()
+			r.NoError(err)
+			digests[i] = digest[:]
+			if store, ok := ws.store.(*factoryWorkingSetStore); ok {
+				root, err := store.tlt.RootHash()
+				r.NoError(err)
+				digests[i] = append(digests[i], root...)
+			}
+			receipts[i], err = ws.Receipts()
+			r.NoError(err)
+		}
+		r.Less(len(executed[0]), 3*numSenders)
+		r.Equal(executed[0], executed[1])
+		r.Equal(digests[0], digests[1])
+		r.Equal(len(receipts[0]), len(receipts[1]))
+		for i := range receipts[0] {
+			r.Equal(receipts[0][i].Hash(), receipts[1][i].Hash())
+			r.Equal(receipts[0][i].Logs(), receipts[1][i].Logs())
+		}
+	}
+}
@@ -196,7 +196,9 @@ func (sdb *stateDB) newWorkingSet(ctx context.Context, height uint64) (*workingS
 		return nil, err
 	}
 
-	return newWorkingSet(height, store), nil
+	ws := newWorkingSet(height, store)
+	ws.parallelWorkers = parallelWorkers(sdb.cfg.Chain)
+	return ws, nil
 }
 
 func (sdb *stateDB) Register(p protocol.Protocol) error {
@@ -282,6 +284,27 @@ func (sdb *stateDB) SimulateExecution(
 	return evm.SimulateExecution(ctx, ws, caller, ex)
 }
 
+// SimulateExecutions simulates a sequence of smart contract operations in one working set, this is done off the
+// network since it does not cause any state change
+func (sdb *stateDB) SimulateExecutions(
+	ctx context.Context,
+	callers []address.Address,
+	exs []*action.Execution,
+) ([][]byte, []*action.Receipt, error) {
+	ctx, span := tracer.NewSpan(ctx, "stateDB.SimulateExecutions")
+	defer span.End()
+
+	sdb.mutex.RLock()
+	currHeight := sdb.currentChainHeight
+	sdb.mutex.RUnlock()
+	ws, err := sdb.newWorkingSet(ctx, currHeight+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return evm.SimulateExecutions(ctx, ws, callers, exs)
+}
+
 // ReadContractStorage reads contract's storage
 func (sdb *stateDB) ReadContractStorage(ctx context.Context, contract address.Address, key []byte) ([]byte, error) {
 	sdb.mutex.RLock()
@@ -401,6 +424,11 @@ func (sdb *stateDB) StatesAtHeight(height uint64, opts ...protocol.StateOption)
 	return nil, errors.Wrap(ErrNotSupported, "state db does not support archive mode")
 }
 
+// ReadContractStorageAtHeight reads contract's storage at height -- archive mode
+func (sdb *stateDB) ReadContractStorageAtHeight(context.Context, uint64, address.Address, []byte) ([]byte, error) {
+	return nil, errors.Wrap(ErrNotSupported, "state db does not support archive mode")
+}
+
 // ReadView reads the view
 func (sdb *stateDB) ReadView(name string) (interface{}, error) {
 	return sdb.protocolView.Read(name)
@@ -0,0 +1,76 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/batch"
+)
+
+var errNoUndoLog = errors.New("no undo log")
+
+// undoStore is a KVStore keeping an undo log for every batch written, with which the batches can be reverted
+// one by one from the latest. The nodes of the state trie are not logged, because they are never overwritten
+// or deleted in archive mode.
+type undoStore struct {
+	db.KVStore
+	mutex sync.Mutex
+	logs  []batch.KVStoreBatch
+}
+
+func newUndoStore(kv db.KVStore) *undoStore {
+	return &undoStore{KVStore: kv}
+}
+
+// WriteBatch logs the current values of the keys in the batch, and then writes the batch
+func (s *undoStore) WriteBatch(b batch.KVStoreBatch) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	undo := batch.NewBatch()
+	for i := 0; i < b.Size(); i++ {
+		wi, err := b.Entry(i)
+		if err != nil {
+			return err
+		}
+		ns, key := wi.Namespace(), wi.Key()
+		if ns == ArchiveTrieNamespace && string(key) != ArchiveTrieRootKey {
+			continue
+		}
+		value, err := s.KVStore.Get(ns, key)
+		switch errors.Cause(err) {
+		case nil:
+			undo.Put(ns, key, value, "failed to restore state")
+		case db.ErrNotExist:
+			undo.Delete(ns, key, "failed to restore state")
+		default:
+			return err
+		}
+	}
+	if err := s.KVStore.WriteBatch(b); err != nil {
+		return err
+	}
+	s.logs = append(s.logs, undo)
+	return nil
+}
+
+// Undo reverts the latest batch written
+func (s *undoStore) Undo() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	n := len(s.logs)
+	if n == 0 {
+		return errNoUndoLog
+	}
+	if err := s.KVStore.WriteBatch(s.logs[n-1]); err != nil {
+		return err
+	}
+	s.logs = s.logs[:n-1]
+	return nil
+}
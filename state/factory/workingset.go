@@ -51,6 +51,10 @@ type (
 		finalized bool
 		dock      protocol.Dock
 		receipts  []*action.Receipt
+		// parallelWorkers is the number of workers to run actions in parallel, 0 means sequential execution
+		parallelWorkers int
+		// fork reads the contract storage missing locally, if the chain is forked from a remote one
+		fork ForkReader
 	}
 )
 
@@ -99,6 +103,28 @@ func (ws *workingSet) validate(ctx context.Context) error {
 func (ws *workingSet) runActions(
 	ctx context.Context,
 	elps []*action.SealedEnvelope,
+) ([]*action.Receipt, error) {
+	var (
+		receipts []*action.Receipt
+		err      error
+	)
+	if ws.parallelWorkers > 0 {
+		receipts, err = ws.runActionsInParallel(ctx, elps)
+	} else {
+		receipts, err = ws.runActionsInSequence(ctx, elps)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if protocol.MustGetFeatureCtx(ctx).CorrectTxLogIndex {
+		updateReceiptIndex(receipts)
+	}
+	return receipts, nil
+}
+
+func (ws *workingSet) runActionsInSequence(
+	ctx context.Context,
+	elps []*action.SealedEnvelope,
 ) ([]*action.Receipt, error) {
 	// Handle actions
 	receipts := make([]*action.Receipt, 0)
@@ -113,9 +139,6 @@ func (ws *workingSet) runActions(
 		}
 		receipts = append(receipts, receipt)
 	}
-	if protocol.MustGetFeatureCtx(ctx).CorrectTxLogIndex {
-		updateReceiptIndex(receipts)
-	}
 	return receipts, nil
 }
 
@@ -304,6 +327,20 @@ func (ws *workingSet) PutState(s interface{}, opts ...protocol.StateOption) (uin
 	return ws.height, ws.store.Put(cfg.Namespace, cfg.Key, ss)
 }
 
+// Accumulate adds to a state with the func, the speculative execution defers it until the result is applied
+func (ws *workingSet) Accumulate(apply func(protocol.StateManager) error, opts ...protocol.StateOption) error {
+	store, ok := ws.store.(*speculativeStore)
+	if !ok {
+		return apply(ws)
+	}
+	cfg, err := processOptions(opts...)
+	if err != nil {
+		return err
+	}
+	store.accumulate(cfg.Namespace, cfg.Key, apply)
+	return nil
+}
+
 // DelState deletes a state from DB
 func (ws *workingSet) DelState(opts ...protocol.StateOption) (uint64, error) {
 	_stateDBMtc.WithLabelValues("delete").Inc()
@@ -344,12 +381,20 @@ func (ws *workingSet) Reset() {
 func (ws *workingSet) CreateGenesisStates(ctx context.Context) error {
 	if reg, ok := protocol.GetRegistry(ctx); ok {
 		for _, p := range reg.All() {
+			if _, ok := p.(protocol.StateForker); ok && ws.fork != nil {
+				continue
+			}
 			if gsc, ok := p.(protocol.GenesisStateCreator); ok {
 				if err := gsc.CreateGenesisStates(ctx, ws); err != nil {
 					return errors.Wrap(err, "failed to create genesis states for protocol")
 				}
 			}
 		}
+		if ws.fork != nil {
+			if err := ws.createForkedGenesisStates(ctx, reg); err != nil {
+				return err
+			}
+		}
 	}
 
 	return ws.finalize()
@@ -527,7 +572,15 @@ func (ws *workingSet) pickAndRunActions(
 	blkCtx := protocol.MustGetBlockCtx(ctx)
 	ctxWithBlockContext := ctx
 	if ap != nil {
-		actionIterator := actioniterator.NewActionIterator(ap.PendingActionMap())
+		var (
+			actionMap = ap.PendingActionMap()
+			spec      *speculator
+		)
+		if ws.parallelWorkers > 0 {
+			spec = ws.speculatePendingActions(ctx, actionMap)
+			defer spec.stop()
+		}
+		actionIterator := actioniterator.NewActionIterator(actionMap)
 		for {
 			nextAction, ok := actionIterator.Next()
 			if !ok {
@@ -539,12 +592,10 @@ func (ws *workingSet) pickAndRunActions(
 			}
 			actionCtx, err := withActionCtx(ctxWithBlockContext, nextAction)
 			if err == nil {
-				for _, p := range reg.All() {
-					if validator, ok := p.(protocol.ActionValidator); ok {
-						if err = validator.Validate(actionCtx, nextAction.Action(), ws); err != nil {
-							break
-						}
-					}
+				if spec != nil {
+					err = spec.validate(actionCtx, nextAction)
+				} else {
+					err = ws.validateAction(actionCtx, nextAction)
 				}
 			}
 			if err != nil {
@@ -556,7 +607,12 @@ func (ws *workingSet) pickAndRunActions(
 				actionIterator.PopAccount()
 				continue
 			}
-			receipt, err := ws.runAction(actionCtx, nextAction)
+			var receipt *action.Receipt
+			if spec != nil {
+				receipt, err = spec.run(actionCtx, nextAction)
+			} else {
+				receipt, err = ws.runAction(actionCtx, nextAction)
+			}
 			switch errors.Cause(err) {
 			case nil:
 				// do nothing
@@ -583,6 +639,9 @@ func (ws *workingSet) pickAndRunActions(
 				break
 			}
 		}
+		if spec != nil {
+			spec.stop()
+		}
 	}
 
 	for _, selp := range postSystemActions {
@@ -605,6 +664,17 @@ func (ws *workingSet) pickAndRunActions(
 	return executedActions, ws.finalize()
 }
 
+func (ws *workingSet) validateAction(ctx context.Context, selp *action.SealedEnvelope) error {
+	for _, p := range protocol.MustGetRegistry(ctx).All() {
+		if validator, ok := p.(protocol.ActionValidator); ok {
+			if err := validator.Validate(ctx, selp.Action(), ws); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func updateReceiptIndex(receipts []*action.Receipt) {
 	var txIndex, logIndex uint32
 	for _, r := range receipts {
@@ -0,0 +1,278 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package fork
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/action/protocol/account/accountpb"
+	"github.com/iotexproject/iotex-core/api"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/state/factory"
+)
+
+const _requestTimeout = 30 * time.Second
+
+var _ factory.ForkReader = (*RemoteReader)(nil)
+
+type (
+	// RemoteReader reads the states of a remote node at a pinned height with its gRPC API, which needs the
+	// remote node running in archive mode unless the height is its tip. The states read are cached, since
+	// they never change at the height.
+	RemoteReader struct {
+		conn     *grpc.ClientConn
+		client   iotexapi.APIServiceClient
+		height   uint64
+		mutex    sync.RWMutex
+		accounts map[string]*state.Account
+		codes    map[hash.Hash256][]byte
+		storage  map[storageKey][]byte
+		states   map[hash.Hash256][]byte
+	}
+
+	storageKey struct {
+		contract string
+		key      hash.Hash256
+	}
+)
+
+// NewRemoteReader dials the remote node, and pins the height to read the states at. The remote node reads the
+// states of the protocols at the start heights of the epochs before its tip epoch only, so the start height of the
+// last epoch completed is pinned if height is 0, and the remote node must honor the height to read the accounts at
+func NewRemoteReader(endpoint string, height uint64, insecure bool) (*RemoteReader, error) {
+	var opt grpc.DialOption
+	if insecure {
+		opt = grpc.WithInsecure()
+	} else {
+		opt = grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12}))
+	}
+	conn, err := grpc.Dial(endpoint, opt)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to dial %s", endpoint)
+	}
+	r := &RemoteReader{
+		conn:     conn,
+		client:   iotexapi.NewAPIServiceClient(conn),
+		accounts: make(map[string]*state.Account),
+		codes:    make(map[hash.Hash256][]byte),
+		storage:  make(map[storageKey][]byte),
+		states:   make(map[hash.Hash256][]byte),
+	}
+	if err := r.pinHeight(height); err != nil {
+		conn.Close()
+		return nil, errors.Wrapf(err, "failed to fork %s", endpoint)
+	}
+	return r, nil
+}
+
+// pinHeight pins the height, and checks that the remote node reads the accounts at it
+func (r *RemoteReader) pinHeight(height uint64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), _requestTimeout)
+	defer cancel()
+	res, err := r.client.GetChainMeta(ctx, &iotexapi.GetChainMetaRequest{})
+	if err != nil {
+		return errors.Wrap(err, "failed to get chain meta")
+	}
+	tip := res.GetChainMeta().GetHeight()
+	switch {
+	case height == 0:
+		epoch := res.GetChainMeta().GetEpoch().GetNum()
+		if epoch <= 1 {
+			return errors.Errorf("no epoch is completed at the tip height %d", tip)
+		}
+		meta, err := r.client.GetEpochMeta(ctx, &iotexapi.GetEpochMetaRequest{EpochNumber: epoch - 1})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get the meta of epoch %d", epoch-1)
+		}
+		r.height = meta.GetEpochData().GetHeight()
+	case height > tip:
+		return errors.Errorf("fork height %d is higher than the tip height %d", height, tip)
+	default:
+		r.height = height
+	}
+
+	ctx, cancel = r.context()
+	defer cancel()
+	acct, err := r.client.GetAccount(ctx, &iotexapi.GetAccountRequest{Address: address.StakingProtocolAddr})
+	if err != nil {
+		return errors.Wrap(err, "failed to get account")
+	}
+	if h := acct.GetBlockIdentifier().GetHeight(); h != r.height {
+		return errors.Errorf("the account is read at height %d instead of the fork height %d, the %s metadata is not honored", h, r.height, api.HeightMetadataKey)
+	}
+	return nil
+}
+
+// Height returns the pinned height
+func (r *RemoteReader) Height() uint64 {
+	return r.height
+}
+
+// Close closes the connection to the remote node
+func (r *RemoteReader) Close() error {
+	return r.conn.Close()
+}
+
+// Account returns the account at the pinned height
+func (r *RemoteReader) Account(addr address.Address) (*state.Account, error) {
+	r.mutex.RLock()
+	acct, ok := r.accounts[addr.String()]
+	r.mutex.RUnlock()
+	if !ok {
+		var err error
+		if acct, err = r.fetchAccount(addr); err != nil {
+			return nil, err
+		}
+	}
+	if acct == nil {
+		return nil, state.ErrStateNotExist
+	}
+	return acct.Clone(), nil
+}
+
+// Code returns the code of a contract, which has been read together with the account
+func (r *RemoteReader) Code(codeHash hash.Hash256) ([]byte, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	code, ok := r.codes[codeHash]
+	if !ok {
+		return nil, state.ErrStateNotExist
+	}
+	return code, nil
+}
+
+// Storage returns the value in the storage of a contract at the pinned height
+func (r *RemoteReader) Storage(contract address.Address, key hash.Hash256) ([]byte, error) {
+	k := storageKey{contract: contract.String(), key: key}
+	r.mutex.RLock()
+	value, ok := r.storage[k]
+	r.mutex.RUnlock()
+	if ok {
+		return value, nil
+	}
+	ctx, cancel := r.context()
+	defer cancel()
+	res, err := r.client.ReadContractStorage(ctx, &iotexapi.ReadContractStorageRequest{
+		Contract: contract.String(),
+		Key:      key[:],
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read storage of %s", contract.String())
+	}
+	r.mutex.Lock()
+	r.storage[k] = res.GetData()
+	r.mutex.Unlock()
+	return res.GetData(), nil
+}
+
+// ReadState reads the state of a protocol at the pinned height
+func (r *RemoteReader) ReadState(protocolID string, method []byte, args ...[]byte) ([]byte, error) {
+	k := readStateKey(protocolID, method, args)
+	r.mutex.RLock()
+	value, ok := r.states[k]
+	r.mutex.RUnlock()
+	if ok {
+		return value, nil
+	}
+	ctx, cancel := r.context()
+	defer cancel()
+	res, err := r.client.ReadState(ctx, &iotexapi.ReadStateRequest{
+		ProtocolID: []byte(protocolID),
+		MethodName: method,
+		Arguments:  args,
+		Height:     strconv.FormatUint(r.height, 10),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read state of %s", protocolID)
+	}
+	if h := res.GetBlockIdentifier().GetHeight(); h != r.height {
+		return nil, errors.Errorf("the state of %s is read at height %d instead of the fork height %d, which is not the start height of an epoch before the tip epoch", protocolID, h, r.height)
+	}
+	r.mutex.Lock()
+	r.states[k] = res.GetData()
+	r.mutex.Unlock()
+	return res.GetData(), nil
+}
+
+func (r *RemoteReader) fetchAccount(addr address.Address) (*state.Account, error) {
+	ctx, cancel := r.context()
+	defer cancel()
+	res, err := r.client.GetAccount(ctx, &iotexapi.GetAccountRequest{Address: addr.String()})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return nil, errors.Wrapf(err, "failed to get account %s", addr.String())
+	}
+	acct, code, err := accountFromMeta(res.GetAccountMeta())
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid account %s", addr.String())
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.accounts[addr.String()] = acct
+	if acct != nil && acct.IsContract() {
+		r.codes[hash.BytesToHash256(acct.CodeHash)] = code
+	}
+	return acct, nil
+}
+
+// context returns the context of a request, which carries the pinned height
+func (r *RemoteReader) context() (context.Context, context.CancelFunc) {
+	ctx := metadata.AppendToOutgoingContext(context.Background(), api.HeightMetadataKey, strconv.FormatUint(r.height, 10))
+	return context.WithTimeout(ctx, _requestTimeout)
+}
+
+func readStateKey(protocolID string, method []byte, args [][]byte) hash.Hash256 {
+	var buf []byte
+	for _, v := range append([][]byte{[]byte(protocolID), method}, args...) {
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(v)))
+		buf = append(buf, v...)
+	}
+	return hash.Hash256b(buf)
+}
+
+// accountFromMeta converts the account meta to an account, which is nil if the account has never been used
+func accountFromMeta(meta *iotextypes.AccountMeta) (*state.Account, []byte, error) {
+	if meta == nil {
+		return nil, nil, nil
+	}
+	balance, ok := new(big.Int).SetString(meta.GetBalance(), 10)
+	if !ok || balance.Sign() < 0 {
+		return nil, nil, errors.Errorf("invalid balance %s", meta.GetBalance())
+	}
+	if balance.Sign() == 0 && !meta.GetIsContract() && meta.GetPendingNonce() <= 1 {
+		return nil, nil, nil
+	}
+	acPb := &accountpb.Account{
+		Type:    accountpb.AccountType_ZERO_NONCE,
+		Nonce:   meta.GetPendingNonce(),
+		Balance: balance.String(),
+	}
+	var code []byte
+	if meta.GetIsContract() {
+		code = meta.GetContractByteCode()
+		codeHash := hash.Hash256b(code)
+		acPb.CodeHash = codeHash[:]
+	}
+	acct := &state.Account{}
+	acct.FromProto(acPb)
+	return acct, code, nil
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package fork
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"net"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/api"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+type remoteServer struct {
+	iotexapi.UnimplementedAPIServiceServer
+	tip          uint64
+	epochLength  uint64
+	ignoreHeight bool
+	accounts     map[string]*iotextypes.AccountMeta
+	storage      map[string][]byte
+	states       map[string][]byte
+	calls        int32
+	heights      []string
+}
+
+func (s *remoteServer) GetChainMeta(context.Context, *iotexapi.GetChainMetaRequest) (*iotexapi.GetChainMetaResponse, error) {
+	epoch := (s.tip-1)/s.epochLength + 1
+	return &iotexapi.GetChainMetaResponse{ChainMeta: &iotextypes.ChainMeta{
+		Height: s.tip,
+		Epoch:  &iotextypes.EpochData{Num: epoch, Height: s.epochHeight(epoch)},
+	}}, nil
+}
+
+func (s *remoteServer) GetEpochMeta(_ context.Context, in *iotexapi.GetEpochMetaRequest) (*iotexapi.GetEpochMetaResponse, error) {
+	return &iotexapi.GetEpochMetaResponse{EpochData: &iotextypes.EpochData{
+		Num:    in.GetEpochNumber(),
+		Height: s.epochHeight(in.GetEpochNumber()),
+	}}, nil
+}
+
+func (s *remoteServer) GetAccount(ctx context.Context, in *iotexapi.GetAccountRequest) (*iotexapi.GetAccountResponse, error) {
+	heights := metadata.ValueFromIncomingContext(ctx, api.HeightMetadataKey)
+	res := &iotexapi.GetAccountResponse{
+		AccountMeta:     &iotextypes.AccountMeta{Address: in.GetAddress(), Balance: "0"},
+		BlockIdentifier: &iotextypes.BlockIdentifier{Height: s.tip},
+	}
+	if len(heights) > 0 && !s.ignoreHeight {
+		h, err := strconv.ParseUint(heights[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		res.BlockIdentifier.Height = h
+	}
+	if in.GetAddress() == address.StakingProtocolAddr {
+		return res, nil
+	}
+	atomic.AddInt32(&s.calls, 1)
+	s.heights = append(s.heights, heights...)
+	meta, ok := s.accounts[in.GetAddress()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "account not found")
+	}
+	res.AccountMeta = meta
+	return res, nil
+}
+
+func (s *remoteServer) ReadState(_ context.Context, in *iotexapi.ReadStateRequest) (*iotexapi.ReadStateResponse, error) {
+	atomic.AddInt32(&s.calls, 1)
+	s.heights = append(s.heights, in.GetHeight())
+	h, err := strconv.ParseUint(in.GetHeight(), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	// the states are read at the start height of the epoch, or at the tip in the tip epoch
+	if epoch := (h-1)/s.epochLength + 1; epoch < (s.tip-1)/s.epochLength+1 {
+		h = s.epochHeight(epoch)
+	} else {
+		h = s.tip
+	}
+	return &iotexapi.ReadStateResponse{
+		Data:            s.states[string(in.GetProtocolID())+string(in.GetMethodName())+string(bytes.Join(in.GetArguments(), nil))],
+		BlockIdentifier: &iotextypes.BlockIdentifier{Height: h},
+	}, nil
+}
+
+func (s *remoteServer) epochHeight(epoch uint64) uint64 {
+	return (epoch-1)*s.epochLength + 1
+}
+
+func (s *remoteServer) ReadContractStorage(ctx context.Context, in *iotexapi.ReadContractStorageRequest) (*iotexapi.ReadContractStorageResponse, error) {
+	atomic.AddInt32(&s.calls, 1)
+	s.heights = append(s.heights, metadata.ValueFromIncomingContext(ctx, api.HeightMetadataKey)...)
+	return &iotexapi.ReadContractStorageResponse{Data: s.storage[in.GetContract()+string(in.GetKey())]}, nil
+}
+
+func startRemoteServer(t *testing.T, s *remoteServer) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	svr := grpc.NewServer()
+	iotexapi.RegisterAPIServiceServer(svr, s)
+	go svr.Serve(lis)
+	t.Cleanup(svr.Stop)
+	return lis.Addr().String()
+}
+
+func TestRemoteReader(t *testing.T) {
+	require := require.New(t)
+
+	code := []byte{0x60, 0x80, 0x60, 0x40}
+	key := hash.Hash256b([]byte("slot"))
+	user, contract, unused := identityset.Address(1), identityset.Address(2), identityset.Address(3)
+	s := &remoteServer{
+		tip:         100,
+		epochLength: 10,
+		accounts: map[string]*iotextypes.AccountMeta{
+			user.String():     {Address: user.String(), Balance: "1000", PendingNonce: 5},
+			contract.String(): {Address: contract.String(), Balance: "0", PendingNonce: 1, IsContract: true, ContractByteCode: code},
+			unused.String():   {Address: unused.String(), Balance: "0", PendingNonce: 1},
+		},
+		storage: map[string][]byte{
+			contract.String() + string(key[:]): []byte("value"),
+		},
+		states: map[string][]byte{
+			"stakingmethodarg": []byte("state"),
+		},
+	}
+	endpoint := startRemoteServer(t, s)
+
+	_, err := NewRemoteReader(endpoint, 101, true)
+	require.ErrorContains(err, "fork height 101 is higher than the tip height 100")
+	// the start height of the last epoch completed is pinned by default
+	r, err := NewRemoteReader(endpoint, 0, true)
+	require.NoError(err)
+	require.Equal(uint64(81), r.Height())
+	require.NoError(r.Close())
+	// the remote node must honor the height to read the accounts at
+	s.ignoreHeight = true
+	_, err = NewRemoteReader(endpoint, 51, true)
+	require.ErrorContains(err, "the height metadata is not honored")
+	s.ignoreHeight = false
+	r, err = NewRemoteReader(endpoint, 51, true)
+	require.NoError(err)
+	defer r.Close()
+	require.Equal(uint64(51), r.Height())
+
+	acct, err := r.Account(user)
+	require.NoError(err)
+	require.Equal(big.NewInt(1000), acct.Balance)
+	require.Equal(uint64(5), acct.PendingNonce())
+	require.False(acct.IsContract())
+	// the account read is cached, and a copy is returned
+	acct.Balance = big.NewInt(0)
+	acct, err = r.Account(user)
+	require.NoError(err)
+	require.Equal(big.NewInt(1000), acct.Balance)
+	require.Equal(int32(1), atomic.LoadInt32(&s.calls))
+
+	acct, err = r.Account(contract)
+	require.NoError(err)
+	require.True(acct.IsContract())
+	codeHash := hash.Hash256b(code)
+	require.Equal(codeHash[:], acct.CodeHash)
+	v, err := r.Code(codeHash)
+	require.NoError(err)
+	require.Equal(code, v)
+	_, err = r.Code(hash.ZeroHash256)
+	require.Equal(state.ErrStateNotExist, errors.Cause(err))
+
+	// neither an unused account nor a missing one exists
+	_, err = r.Account(unused)
+	require.Equal(state.ErrStateNotExist, errors.Cause(err))
+	_, err = r.Account(identityset.Address(4))
+	require.Equal(state.ErrStateNotExist, errors.Cause(err))
+
+	for i := 0; i < 2; i++ {
+		v, err = r.Storage(contract, key)
+		require.NoError(err)
+		require.Equal([]byte("value"), v)
+		v, err = r.ReadState("staking", []byte("method"), []byte("arg"))
+		require.NoError(err)
+		require.Equal([]byte("state"), v)
+	}
+	require.Equal(int32(6), atomic.LoadInt32(&s.calls))
+	require.Len(s.heights, 6)
+	for _, h := range s.heights {
+		require.Equal("51", h)
+	}
+
+	// the states of the protocols are read at the start heights of the epochs only
+	r, err = NewRemoteReader(endpoint, 55, true)
+	require.NoError(err)
+	defer r.Close()
+	_, err = r.ReadState("staking", []byte("method"), []byte("arg"))
+	require.ErrorContains(err, "read at height 51 instead of the fork height 55")
+}
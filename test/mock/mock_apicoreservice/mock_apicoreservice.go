@@ -10,15 +10,18 @@ import (
 	reflect "reflect"
 	time "time"
 
+	types "github.com/ethereum/go-ethereum/core/types"
 	tracers "github.com/ethereum/go-ethereum/eth/tracers"
 	gomock "github.com/golang/mock/gomock"
 	hash "github.com/iotexproject/go-pkgs/hash"
 	address "github.com/iotexproject/iotex-address/address"
 	action "github.com/iotexproject/iotex-core/action"
+	evm "github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	logfilter "github.com/iotexproject/iotex-core/api/logfilter"
 	apitypes "github.com/iotexproject/iotex-core/api/types"
 	block "github.com/iotexproject/iotex-core/blockchain/block"
 	genesis "github.com/iotexproject/iotex-core/blockchain/genesis"
+	scheme "github.com/iotexproject/iotex-core/consensus/scheme"
 	iotexapi "github.com/iotexproject/iotex-proto/golang/iotexapi"
 	iotextypes "github.com/iotexproject/iotex-proto/golang/iotextypes"
 )
@@ -62,6 +65,22 @@ func (mr *MockCoreServiceMockRecorder) Account(addr interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Account", reflect.TypeOf((*MockCoreService)(nil).Account), addr)
 }
 
+// AccountAtHeight mocks base method.
+func (m *MockCoreService) AccountAtHeight(addr address.Address, height uint64) (*iotextypes.AccountMeta, *iotextypes.BlockIdentifier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccountAtHeight", addr, height)
+	ret0, _ := ret[0].(*iotextypes.AccountMeta)
+	ret1, _ := ret[1].(*iotextypes.BlockIdentifier)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AccountAtHeight indicates an expected call of AccountAtHeight.
+func (mr *MockCoreServiceMockRecorder) AccountAtHeight(addr, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountAtHeight", reflect.TypeOf((*MockCoreService)(nil).AccountAtHeight), addr, height)
+}
+
 // Action mocks base method.
 func (m *MockCoreService) Action(actionHash string, checkPending bool) (*iotexapi.ActionInfo, error) {
 	m.ctrl.T.Helper()
@@ -125,6 +144,22 @@ func (mr *MockCoreServiceMockRecorder) ActionsByAddress(addr, start, count inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActionsByAddress", reflect.TypeOf((*MockCoreService)(nil).ActionsByAddress), addr, start, count)
 }
 
+// ActionsByAddressWithCursor mocks base method.
+func (m *MockCoreService) ActionsByAddressWithCursor(addr address.Address, query *apitypes.ActionHistoryQuery) ([]*iotexapi.ActionInfo, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActionsByAddressWithCursor", addr, query)
+	ret0, _ := ret[0].([]*iotexapi.ActionInfo)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ActionsByAddressWithCursor indicates an expected call of ActionsByAddressWithCursor.
+func (mr *MockCoreServiceMockRecorder) ActionsByAddressWithCursor(addr, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActionsByAddressWithCursor", reflect.TypeOf((*MockCoreService)(nil).ActionsByAddressWithCursor), addr, query)
+}
+
 // ActionsInActPool mocks base method.
 func (m *MockCoreService) ActionsInActPool(actHashes []string) ([]*action.SealedEnvelope, error) {
 	m.ctrl.T.Helper()
@@ -244,6 +279,97 @@ func (mr *MockCoreServiceMockRecorder) ChainMeta() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainMeta", reflect.TypeOf((*MockCoreService)(nil).ChainMeta))
 }
 
+// ConsensusTimeline mocks base method.
+func (m *MockCoreService) ConsensusTimeline(height uint64) (*scheme.ConsensusTimeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsensusTimeline", height)
+	ret0, _ := ret[0].(*scheme.ConsensusTimeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsensusTimeline indicates an expected call of ConsensusTimeline.
+func (mr *MockCoreServiceMockRecorder) ConsensusTimeline(height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsensusTimeline", reflect.TypeOf((*MockCoreService)(nil).ConsensusTimeline), height)
+}
+
+// CreateAccessList mocks base method.
+func (m *MockCoreService) CreateAccessList(arg0 context.Context, arg1 address.Address, arg2 *action.Execution) (types.AccessList, *action.Receipt, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccessList", arg0, arg1, arg2)
+	ret0, _ := ret[0].(types.AccessList)
+	ret1, _ := ret[1].(*action.Receipt)
+	ret2, _ := ret[2].(uint64)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// CreateAccessList indicates an expected call of CreateAccessList.
+func (mr *MockCoreServiceMockRecorder) CreateAccessList(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessList", reflect.TypeOf((*MockCoreService)(nil).CreateAccessList), arg0, arg1, arg2)
+}
+
+// DevIncreaseTime mocks base method.
+func (m *MockCoreService) DevIncreaseTime(delta time.Duration) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DevIncreaseTime", delta)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DevIncreaseTime indicates an expected call of DevIncreaseTime.
+func (mr *MockCoreServiceMockRecorder) DevIncreaseTime(delta interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DevIncreaseTime", reflect.TypeOf((*MockCoreService)(nil).DevIncreaseTime), delta)
+}
+
+// DevMine mocks base method.
+func (m *MockCoreService) DevMine(timestamp time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DevMine", timestamp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DevMine indicates an expected call of DevMine.
+func (mr *MockCoreServiceMockRecorder) DevMine(timestamp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DevMine", reflect.TypeOf((*MockCoreService)(nil).DevMine), timestamp)
+}
+
+// DevRevert mocks base method.
+func (m *MockCoreService) DevRevert(id uint64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DevRevert", id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DevRevert indicates an expected call of DevRevert.
+func (mr *MockCoreServiceMockRecorder) DevRevert(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DevRevert", reflect.TypeOf((*MockCoreService)(nil).DevRevert), id)
+}
+
+// DevSnapshot mocks base method.
+func (m *MockCoreService) DevSnapshot() (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DevSnapshot")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DevSnapshot indicates an expected call of DevSnapshot.
+func (mr *MockCoreServiceMockRecorder) DevSnapshot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DevSnapshot", reflect.TypeOf((*MockCoreService)(nil).DevSnapshot))
+}
+
 // EVMNetworkID mocks base method.
 func (m *MockCoreService) EVMNetworkID() uint32 {
 	m.ctrl.T.Helper()
@@ -456,6 +582,21 @@ func (mr *MockCoreServiceMockRecorder) ReadContractStorage(ctx, addr, key interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadContractStorage", reflect.TypeOf((*MockCoreService)(nil).ReadContractStorage), ctx, addr, key)
 }
 
+// ReadContractStorageAtHeight mocks base method.
+func (m *MockCoreService) ReadContractStorageAtHeight(ctx context.Context, addr address.Address, key []byte, height uint64) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadContractStorageAtHeight", ctx, addr, key, height)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadContractStorageAtHeight indicates an expected call of ReadContractStorageAtHeight.
+func (mr *MockCoreServiceMockRecorder) ReadContractStorageAtHeight(ctx, addr, key, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadContractStorageAtHeight", reflect.TypeOf((*MockCoreService)(nil).ReadContractStorageAtHeight), ctx, addr, key, height)
+}
+
 // ReadState mocks base method.
 func (m *MockCoreService) ReadState(protocolID, height string, methodName []byte, arguments [][]byte) (*iotexapi.ReadStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -549,6 +690,22 @@ func (mr *MockCoreServiceMockRecorder) SimulateExecution(arg0, arg1, arg2 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateExecution", reflect.TypeOf((*MockCoreService)(nil).SimulateExecution), arg0, arg1, arg2)
 }
 
+// SimulateExecutions mocks base method.
+func (m *MockCoreService) SimulateExecutions(arg0 context.Context, arg1 []address.Address, arg2 []*action.Execution, arg3 evm.StateOverride, arg4 []evm.SimulationBlock) ([][]byte, []*action.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateExecutions", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].([]*action.Receipt)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SimulateExecutions indicates an expected call of SimulateExecutions.
+func (mr *MockCoreServiceMockRecorder) SimulateExecutions(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateExecutions", reflect.TypeOf((*MockCoreService)(nil).SimulateExecutions), arg0, arg1, arg2, arg3, arg4)
+}
+
 // Start mocks base method.
 func (m *MockCoreService) Start(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -577,6 +734,20 @@ func (mr *MockCoreServiceMockRecorder) Stop(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockCoreService)(nil).Stop), ctx)
 }
 
+// StreamLogsInRange mocks base method.
+func (m *MockCoreService) StreamLogsInRange(ctx context.Context, filter *logfilter.LogFilter, start, end uint64, send func([]*action.Log, hash.Hash256, uint64) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamLogsInRange", ctx, filter, start, end, send)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamLogsInRange indicates an expected call of StreamLogsInRange.
+func (mr *MockCoreServiceMockRecorder) StreamLogsInRange(ctx, filter, start, end, send interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamLogsInRange", reflect.TypeOf((*MockCoreService)(nil).StreamLogsInRange), ctx, filter, start, end, send)
+}
+
 // SuggestGasPrice mocks base method.
 func (m *MockCoreService) SuggestGasPrice() (uint64, error) {
 	m.ctrl.T.Helper()
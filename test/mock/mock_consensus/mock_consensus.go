@@ -75,6 +75,21 @@ func (mr *MockConsensusMockRecorder) Calibrate(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Calibrate", reflect.TypeOf((*MockConsensus)(nil).Calibrate), arg0)
 }
 
+// DevChain mocks base method.
+func (m *MockConsensus) DevChain() (*scheme.DevChain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DevChain")
+	ret0, _ := ret[0].(*scheme.DevChain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DevChain indicates an expected call of DevChain.
+func (mr *MockConsensusMockRecorder) DevChain() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DevChain", reflect.TypeOf((*MockConsensus)(nil).DevChain))
+}
+
 // HandleConsensusMsg mocks base method.
 func (m *MockConsensus) HandleConsensusMsg(arg0 *iotextypes.ConsensusMessage) error {
 	m.ctrl.T.Helper()
@@ -132,6 +147,21 @@ func (mr *MockConsensusMockRecorder) Stop(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockConsensus)(nil).Stop), arg0)
 }
 
+// Timeline mocks base method.
+func (m *MockConsensus) Timeline(arg0 uint64) (*scheme.ConsensusTimeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Timeline", arg0)
+	ret0, _ := ret[0].(*scheme.ConsensusTimeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Timeline indicates an expected call of Timeline.
+func (mr *MockConsensusMockRecorder) Timeline(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Timeline", reflect.TypeOf((*MockConsensus)(nil).Timeline), arg0)
+}
+
 // ValidateBlockFooter mocks base method.
 func (m *MockConsensus) ValidateBlockFooter(arg0 *block.Block) error {
 	m.ctrl.T.Helper()
@@ -113,6 +113,21 @@ func (mr *MockFactoryMockRecorder) ReadContractStorage(arg0, arg1, arg2 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadContractStorage", reflect.TypeOf((*MockFactory)(nil).ReadContractStorage), arg0, arg1, arg2)
 }
 
+// ReadContractStorageAtHeight mocks base method.
+func (m *MockFactory) ReadContractStorageAtHeight(arg0 context.Context, arg1 uint64, arg2 address.Address, arg3 []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadContractStorageAtHeight", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadContractStorageAtHeight indicates an expected call of ReadContractStorageAtHeight.
+func (mr *MockFactoryMockRecorder) ReadContractStorageAtHeight(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadContractStorageAtHeight", reflect.TypeOf((*MockFactory)(nil).ReadContractStorageAtHeight), arg0, arg1, arg2, arg3)
+}
+
 // ReadView mocks base method.
 func (m *MockFactory) ReadView(arg0 string) (interface{}, error) {
 	m.ctrl.T.Helper()
@@ -158,6 +173,22 @@ func (mr *MockFactoryMockRecorder) SimulateExecution(arg0, arg1, arg2 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateExecution", reflect.TypeOf((*MockFactory)(nil).SimulateExecution), arg0, arg1, arg2)
 }
 
+// SimulateExecutions mocks base method.
+func (m *MockFactory) SimulateExecutions(arg0 context.Context, arg1 []address.Address, arg2 []*action.Execution) ([][]byte, []*action.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateExecutions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].([]*action.Receipt)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SimulateExecutions indicates an expected call of SimulateExecutions.
+func (mr *MockFactoryMockRecorder) SimulateExecutions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateExecutions", reflect.TypeOf((*MockFactory)(nil).SimulateExecutions), arg0, arg1, arg2)
+}
+
 // Start mocks base method.
 func (m *MockFactory) Start(arg0 context.Context) error {
 	m.ctrl.T.Helper()